@@ -0,0 +1,34 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"erajaya-test/internal/repository"
+	"erajaya-test/internal/usecase"
+
+	"github.com/spf13/viper"
+)
+
+// RunCommand executes a one-off maintenance command instead of serving HTTP.
+func RunCommand(ctx context.Context, db *Database, name string) error {
+
+	productRepository := repository.NewProductRepository(db.Postgres, viper.GetString("search.text_search_config"))
+	productRedis := repository.NewRedisRepository(db.Redis)
+	productAutocomplete := repository.NewAutocompleteRepository(db.Redis)
+	attributeRepository := repository.NewAttributeRepository(db.Postgres)
+	productUsecase := usecase.NewProductUsecase(productRepository, productRedis, productAutocomplete, attributeRepository, InitMediaStorage())
+
+	switch name {
+	case "rebuild-autocomplete":
+		indexed, err := productUsecase.RebuildAutocomplete(ctx)
+		if err != nil {
+			return err
+		}
+		log.Printf("[Command] Autocomplete index rebuilt with %d products", indexed)
+		return nil
+	default:
+		return fmt.Errorf("unknown command %q", name)
+	}
+}
@@ -38,6 +38,19 @@ func InitConfig(path string) (*Config, error) {
 	viper.SetDefault("server.port", "8080")
 	viper.SetDefault("server.timeout", 30)
 	viper.SetDefault("server.debug", false)
+	viper.SetDefault("jobs.purge_interval", "1h")
+	viper.SetDefault("jobs.purge_retention", "720h")
+	viper.SetDefault("jobs.import_interval", "5s")
+	viper.SetDefault("jobs.reservation_interval", "30s")
+	viper.SetDefault("jobs.price_interval", "1m")
+	viper.SetDefault("jobs.publish_interval", "1m")
+	viper.SetDefault("tax.vat_rate", 11)
+	viper.SetDefault("tax.prices_include_vat", true)
+	viper.SetDefault("media.driver", "local")
+	viper.SetDefault("media.local_root", "./uploads")
+	viper.SetDefault("media.base_url", "http://localhost:8080/api/media")
+	viper.SetDefault("media.max_size_mb", 5)
+	viper.SetDefault("media.thumbnail_size", 320)
 
 	viper.AddConfigPath(path)
 	viper.SetConfigName("config")
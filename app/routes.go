@@ -3,11 +3,14 @@ package app
 import (
 	"context"
 	"erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/models/entity"
 	"erajaya-test/internal/repository"
 	"erajaya-test/internal/usecase"
 	"erajaya-test/shared/response"
+	"erajaya-test/shared/storage"
 
 	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
 )
 
 func InitRoutes(ctx context.Context, apiGroup *echo.Group, db *Database) {
@@ -16,15 +19,141 @@ func InitRoutes(ctx context.Context, apiGroup *echo.Group, db *Database) {
 
 	stdResponse := response.NewStdResponse(zapLogger)
 
-	productRepository := repository.NewProductRepository(db.Postgres)
+	productRepository := repository.NewProductRepository(db.Postgres, viper.GetString("search.text_search_config"))
 	productRedis := repository.NewRedisRepository(db.Redis)
-	productUsecase := usecase.NewProductUsecase(productRepository, productRedis)
-	productHandler := http.NewHandler(productUsecase, stdResponse)
+	productAutocomplete := repository.NewAutocompleteRepository(db.Redis)
+	attributeRepository := repository.NewAttributeRepository(db.Postgres)
+	mediaStorage := InitMediaStorage()
+	productUsecase := usecase.NewProductUsecase(productRepository, productRedis, productAutocomplete, attributeRepository, mediaStorage)
+
+	rateRepository := repository.NewExchangeRateRepository(db.Postgres)
+	rateUsecase := usecase.NewExchangeRateUsecase(rateRepository, productRedis, entity.VATRule{
+		Rate:      viper.GetFloat64("tax.vat_rate"),
+		Inclusive: viper.GetBool("tax.prices_include_vat"),
+	})
+	rateHandler := http.NewExchangeRateHandler(rateUsecase, stdResponse)
+
+	productHandler := http.NewHandler(productUsecase, rateUsecase, stdResponse)
+
+	importRepository := repository.NewProductImportRepository(db.Postgres)
+	importUsecase := usecase.NewProductImportUsecase(importRepository, productUsecase)
+	importHandler := http.NewImportHandler(importUsecase, stdResponse)
+
+	categoryRepository := repository.NewCategoryRepository(db.Postgres)
+	categoryUsecase := usecase.NewCategoryUsecase(categoryRepository, productRedis)
+	categoryHandler := http.NewCategoryHandler(categoryUsecase, stdResponse)
+
+	attributeUsecase := usecase.NewAttributeUsecase(attributeRepository, productRedis)
+	attributeHandler := http.NewAttributeHandler(attributeUsecase, stdResponse)
+
+	variantRepository := repository.NewProductVariantRepository(db.Postgres)
+	variantUsecase := usecase.NewProductVariantUsecase(variantRepository, productRedis)
+	variantHandler := http.NewProductVariantHandler(variantUsecase, stdResponse)
+
+	brandRepository := repository.NewBrandRepository(db.Postgres)
+	brandUsecase := usecase.NewBrandUsecase(brandRepository, productRedis)
+	brandHandler := http.NewBrandHandler(brandUsecase, productUsecase, rateUsecase, stdResponse)
+
+	stockRepository := repository.NewStockMovementRepository(db.Postgres)
+	stockUsecase := usecase.NewStockMovementUsecase(stockRepository, productRedis)
+	stockHandler := http.NewStockMovementHandler(stockUsecase, stdResponse)
+
+	reservationRepository := repository.NewStockReservationRepository(db.Postgres)
+	reservationUsecase := usecase.NewStockReservationUsecase(reservationRepository, productRedis)
+	reservationHandler := http.NewStockReservationHandler(reservationUsecase, stdResponse)
+
+	locationRepository := repository.NewLocationRepository(db.Postgres)
+	locationUsecase := usecase.NewLocationUsecase(locationRepository)
+	locationHandler := http.NewLocationHandler(locationUsecase, stdResponse)
+
+	priceRepository := repository.NewProductPriceRepository(db.Postgres)
+	priceUsecase := usecase.NewProductPriceUsecase(priceRepository, productRedis)
+	priceHandler := http.NewProductPriceHandler(priceUsecase, stdResponse)
+
+	mediaRepository := repository.NewProductMediaRepository(db.Postgres)
+	mediaUsecase := usecase.NewProductMediaUsecase(mediaRepository, productRedis, mediaStorage, usecase.MediaConfig{
+		MaxSize:       viper.GetInt64("media.max_size_mb") << 20,
+		ThumbnailSize: viper.GetInt("media.thumbnail_size"),
+	})
+	mediaHandler := http.NewProductMediaHandler(mediaUsecase, stdResponse)
+
+	// Files of the local media storage are served from the API itself.
+	if storage.DriverType(viper.GetString("media.driver")) == storage.Local {
+		apiGroup.Static("/media", viper.GetString("media.local_root"))
+	}
 
 	v1 := apiGroup.Group("/v1")
 
 	v1.POST("/products", productHandler.CreateProduct)
+	v1.POST("/products/bulk", productHandler.CreateProductsBulk)
 	v1.GET("/products", productHandler.ListProducts)
+	v1.GET("/products/export", productHandler.ExportProducts)
+	v1.GET("/products/suggest", productHandler.SuggestProducts)
+	v1.GET("/products/autocomplete", productHandler.AutocompleteProducts)
+	v1.GET("/products/sku/:sku", productHandler.GetProductBySKU)
+	v1.GET("/products/slug/:slug", productHandler.GetProductBySlug)
 	v1.GET("/products/:id", productHandler.GetProductByID)
+	v1.PUT("/products/:id", productHandler.UpdateProduct)
+	v1.PATCH("/products/:id", productHandler.PatchProduct)
+	v1.DELETE("/products/:id", productHandler.DeleteProduct)
+	v1.POST("/products/:id/restore", productHandler.RestoreProduct)
+	v1.POST("/products/:id/status", productHandler.TransitionProductStatus)
+	v1.GET("/products/:id/history", productHandler.ListProductHistory)
+	v1.GET("/products/:id/categories", categoryHandler.GetProductCategories)
+	v1.PUT("/products/:id/categories", categoryHandler.SetProductCategories)
+	v1.PUT("/products/:id/attributes", attributeHandler.SetProductAttributes)
+	v1.GET("/products/:id/variants", variantHandler.ListVariants)
+	v1.POST("/products/:id/variants", variantHandler.CreateVariant)
+	v1.PUT("/products/:id/variants/:variant_id", variantHandler.UpdateVariant)
+	v1.DELETE("/products/:id/variants/:variant_id", variantHandler.DeleteVariant)
+	v1.GET("/products/:id/stock-movements", stockHandler.ListMovements)
+	v1.POST("/products/:id/stock-movements", stockHandler.PostMovement)
+	v1.POST("/products/:id/reservations", reservationHandler.Reserve)
+	v1.GET("/products/:id/stocks", locationHandler.ListProductStocks)
+	v1.POST("/products/:id/prices", priceHandler.SchedulePrice)
+	v1.GET("/products/:id/price-history", priceHandler.ListPriceHistory)
+	v1.GET("/products/:id/media", mediaHandler.ListMedia)
+	v1.POST("/products/:id/media", mediaHandler.UploadMedia)
+	v1.PUT("/products/:id/media/order", mediaHandler.ReorderMedia)
+	v1.POST("/products/:id/media/:media_id/primary", mediaHandler.SetPrimaryMedia)
+	v1.DELETE("/products/:id/media/:media_id", mediaHandler.DeleteMedia)
+
+	v1.POST("/products/imports", importHandler.CreateImport)
+	v1.GET("/imports/:id", importHandler.GetImport)
+	v1.GET("/imports/:id/errors", importHandler.GetImportErrors)
+
+	v1.GET("/reservations/:id", reservationHandler.GetReservation)
+	v1.POST("/reservations/:id/commit", reservationHandler.CommitReservation)
+	v1.POST("/reservations/:id/release", reservationHandler.ReleaseReservation)
+
+	v1.POST("/categories", categoryHandler.CreateCategory)
+	v1.GET("/categories", categoryHandler.ListCategories)
+	v1.GET("/categories/:id", categoryHandler.GetCategory)
+	v1.PUT("/categories/:id", categoryHandler.UpdateCategory)
+	v1.DELETE("/categories/:id", categoryHandler.DeleteCategory)
+	v1.GET("/categories/:id/attributes", attributeHandler.GetCategoryAttributes)
+	v1.PUT("/categories/:id/attributes", attributeHandler.SetCategoryAttributes)
+
+	v1.POST("/attributes", attributeHandler.CreateAttribute)
+	v1.GET("/attributes", attributeHandler.ListAttributes)
+	v1.GET("/attributes/:id", attributeHandler.GetAttribute)
+	v1.PUT("/attributes/:id", attributeHandler.UpdateAttribute)
+	v1.DELETE("/attributes/:id", attributeHandler.DeleteAttribute)
+
+	v1.POST("/brands", brandHandler.CreateBrand)
+	v1.GET("/brands", brandHandler.ListBrands)
+	v1.GET("/brands/:id", brandHandler.GetBrand)
+	v1.PUT("/brands/:id", brandHandler.UpdateBrand)
+	v1.DELETE("/brands/:id", brandHandler.DeleteBrand)
+	v1.GET("/brands/:id/products", brandHandler.ListBrandProducts)
+
+	v1.POST("/locations", locationHandler.CreateLocation)
+	v1.GET("/locations", locationHandler.ListLocations)
+	v1.GET("/locations/:id", locationHandler.GetLocation)
+	v1.PUT("/locations/:id", locationHandler.UpdateLocation)
+	v1.DELETE("/locations/:id", locationHandler.DeleteLocation)
+
+	v1.GET("/exchange-rates", rateHandler.ListRates)
+	v1.PUT("/exchange-rates", rateHandler.LoadRates)
 
 }
@@ -0,0 +1,29 @@
+package app
+
+import (
+	"log"
+
+	"erajaya-test/shared/storage"
+
+	"github.com/spf13/viper"
+)
+
+// InitMediaStorage opens the storage of the product media. The API writes to
+// it and the purge job removes the files of the purged products.
+func InitMediaStorage() storage.Storage {
+
+	mediaStorage, err := storage.NewStorage(storage.DriverType(viper.GetString("media.driver")), storage.Config{
+		Root:      viper.GetString("media.local_root"),
+		BaseURL:   viper.GetString("media.base_url"),
+		Endpoint:  viper.GetString("media.s3.endpoint"),
+		Region:    viper.GetString("media.s3.region"),
+		Bucket:    viper.GetString("media.s3.bucket"),
+		AccessKey: viper.GetString("media.s3.access_key"),
+		SecretKey: viper.GetString("media.s3.secret_key"),
+	})
+	if err != nil {
+		log.Fatalf("Media storage fail: %v", err)
+	}
+
+	return mediaStorage
+}
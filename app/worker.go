@@ -0,0 +1,178 @@
+package app
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/repository"
+	"erajaya-test/internal/usecase"
+
+	"github.com/spf13/viper"
+)
+
+func InitWorkers(ctx context.Context, db *Database) {
+
+	productRepository := repository.NewProductRepository(db.Postgres, viper.GetString("search.text_search_config"))
+	productRedis := repository.NewRedisRepository(db.Redis)
+	productAutocomplete := repository.NewAutocompleteRepository(db.Redis)
+	attributeRepository := repository.NewAttributeRepository(db.Postgres)
+	productUsecase := usecase.NewProductUsecase(productRepository, productRedis, productAutocomplete, attributeRepository, InitMediaStorage())
+	importUsecase := usecase.NewProductImportUsecase(repository.NewProductImportRepository(db.Postgres), productUsecase)
+	reservationUsecase := usecase.NewStockReservationUsecase(repository.NewStockReservationRepository(db.Postgres), productRedis)
+	priceUsecase := usecase.NewProductPriceUsecase(repository.NewProductPriceRepository(db.Postgres), productRedis)
+
+	purgeInterval := viper.GetDuration("jobs.purge_interval")
+	purgeRetention := viper.GetDuration("jobs.purge_retention")
+
+	if purgeInterval > 0 && purgeRetention > 0 {
+		go runPurgeWorker(ctx, productUsecase, purgeInterval, purgeRetention)
+		log.Printf("[Worker] Purge enabled: every %s, retention %s", purgeInterval, purgeRetention)
+	}
+
+	publishInterval := viper.GetDuration("jobs.publish_interval")
+
+	if publishInterval > 0 {
+		go runPublishWorker(ctx, productUsecase, publishInterval)
+		log.Printf("[Worker] Publish windows enabled: every %s", publishInterval)
+	}
+
+	importInterval := viper.GetDuration("jobs.import_interval")
+
+	if importInterval > 0 {
+		go runImportWorker(ctx, importUsecase, importInterval)
+		log.Printf("[Worker] Import enabled: polling every %s", importInterval)
+	}
+
+	reservationInterval := viper.GetDuration("jobs.reservation_interval")
+
+	if reservationInterval > 0 {
+		go runReservationReaper(ctx, reservationUsecase, reservationInterval)
+		log.Printf("[Worker] Reservation reaper enabled: every %s", reservationInterval)
+	}
+
+	priceInterval := viper.GetDuration("jobs.price_interval")
+
+	if priceInterval > 0 {
+		go runPriceScheduler(ctx, priceUsecase, priceInterval)
+		log.Printf("[Worker] Price scheduler enabled: every %s", priceInterval)
+	}
+}
+
+func runPurgeWorker(ctx context.Context, productUsecase interfaces.ProductUsecase, interval, retention time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := productUsecase.PurgeDeletedProducts(ctx, retention)
+			if err != nil {
+				log.Printf("[Worker] Purge deleted products failed: %v", err)
+				continue
+			}
+			if purged > 0 {
+				log.Printf("[Worker] Purged %d deleted products", purged)
+			}
+		}
+	}
+}
+
+// runPublishWorker syncs the products whose publish window opened or closed
+// since the previous tick. A tick that fails is covered again by the next one.
+func runPublishWorker(ctx context.Context, productUsecase interfaces.ProductUsecase, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	last := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			synced, err := productUsecase.SyncPublishWindows(ctx, last, now)
+			if err != nil {
+				log.Printf("[Worker] Sync publish windows failed: %v", err)
+				continue
+			}
+			last = now
+			if synced > 0 {
+				log.Printf("[Worker] Synced %d products whose publish window opened or closed", synced)
+			}
+		}
+	}
+}
+
+// runImportWorker drains the pending imports on every tick, one at a time.
+func runImportWorker(ctx context.Context, importUsecase interfaces.ProductImportUsecase, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for ctx.Err() == nil {
+				processed, err := importUsecase.ProcessNextImport(ctx)
+				if err != nil {
+					log.Printf("[Worker] Product import failed: %v", err)
+				}
+				if !processed {
+					break
+				}
+			}
+		}
+	}
+}
+
+// runReservationReaper releases the stock of expired reservations on every
+// tick.
+func runReservationReaper(ctx context.Context, reservationUsecase interfaces.StockReservationUsecase, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			released, err := reservationUsecase.ReleaseExpired(ctx)
+			if err != nil {
+				log.Printf("[Worker] Release expired reservations failed: %v", err)
+			}
+			if released > 0 {
+				log.Printf("[Worker] Released %d expired reservations", released)
+			}
+		}
+	}
+}
+
+// runPriceScheduler applies the scheduled prices that came due on every tick.
+func runPriceScheduler(ctx context.Context, priceUsecase interfaces.ProductPriceUsecase, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			applied, err := priceUsecase.ApplyDuePrices(ctx)
+			if err != nil {
+				log.Printf("[Worker] Apply scheduled prices failed: %v", err)
+			}
+			if applied > 0 {
+				log.Printf("[Worker] Applied %d scheduled prices", applied)
+			}
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package http
+
+import (
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AttributeHandler struct {
+	usecase  interfaces.AttributeUsecase
+	response *response.StdResponse
+}
+
+func NewAttributeHandler(attributeUsecase interfaces.AttributeUsecase, standardResponse *response.StdResponse) *AttributeHandler {
+	return &AttributeHandler{
+		usecase:  attributeUsecase,
+		response: standardResponse,
+	}
+}
+
+// CreateAttribute godoc
+// @Summary Create an attribute
+// @Description Define a product attribute. Its code is unique and, like its type, never changes; unit attributes need a unit and enum attributes their options.
+// @Tags attributes
+// @Accept json
+// @Produce json
+// @Param attribute body request.Attribute true "Attribute object"
+// @Success 201 {object} response.ApiResponse{data=entity.Attribute}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/attributes [post]
+func (h *AttributeHandler) CreateAttribute(c echo.Context) error {
+	var req request.Attribute
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	attribute, err := h.usecase.CreateAttribute(ctx, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.InsertSuccess, attribute, "PRD-ERA-201"))
+}
+
+// ListAttributes godoc
+// @Summary List attributes
+// @Description Get every attribute ordered by code
+// @Tags attributes
+// @Produce json
+// @Success 200 {object} response.ApiResponse{data=[]entity.Attribute}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/attributes [get]
+func (h *AttributeHandler) ListAttributes(c echo.Context) error {
+	ctx := c.Request().Context()
+	attributes, err := h.usecase.ListAttributes(ctx)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, attributes, "PRD-ERA-200"))
+}
+
+// GetAttribute godoc
+// @Summary Get attribute by ID
+// @Description Get a single attribute
+// @Tags attributes
+// @Produce json
+// @Param id path int true "Attribute ID"
+// @Success 200 {object} response.ApiResponse{data=entity.Attribute}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/attributes/{id} [get]
+func (h *AttributeHandler) GetAttribute(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	attribute, err := h.usecase.GetAttribute(ctx, id)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, attribute, "PRD-ERA-200"))
+}
+
+// UpdateAttribute godoc
+// @Summary Update an attribute
+// @Description Rename an attribute and replace its unit and options. Options products still hold cannot be removed.
+// @Tags attributes
+// @Accept json
+// @Produce json
+// @Param id path int true "Attribute ID"
+// @Param attribute body request.AttributeUpdate true "Attribute object"
+// @Success 200 {object} response.ApiResponse{data=entity.Attribute}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/attributes/{id} [put]
+func (h *AttributeHandler) UpdateAttribute(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.AttributeUpdate
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	attribute, err := h.usecase.UpdateAttribute(ctx, id, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, attribute, "PRD-ERA-200"))
+}
+
+// DeleteAttribute godoc
+// @Summary Delete an attribute
+// @Description Delete an attribute no product, soft-deleted ones included, holds a value of
+// @Tags attributes
+// @Produce json
+// @Param id path int true "Attribute ID"
+// @Success 200 {object} response.ApiResponse
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/attributes/{id} [delete]
+func (h *AttributeHandler) DeleteAttribute(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	if err := h.usecase.DeleteAttribute(ctx, id); err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.DeleteSuccess, nil, "PRD-ERA-200"))
+}
+
+// GetCategoryAttributes godoc
+// @Summary Get the attributes of a category
+// @Description Get the attributes that apply to the products of a category: its own and those of its ancestors
+// @Tags attributes
+// @Produce json
+// @Param id path int true "Category ID"
+// @Success 200 {object} response.ApiResponse{data=[]entity.Attribute}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/categories/{id}/attributes [get]
+func (h *AttributeHandler) GetCategoryAttributes(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	attributes, err := h.usecase.GetCategoryAttributes(ctx, id)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, attributes, "PRD-ERA-200"))
+}
+
+// SetCategoryAttributes godoc
+// @Summary Replace the attributes of a category
+// @Description Define exactly the given attributes on a category and return those that now apply to it, inherited ones included. Values products already hold are kept.
+// @Tags attributes
+// @Accept json
+// @Produce json
+// @Param id path int true "Category ID"
+// @Param attributes body request.CategoryAttributes true "Attribute IDs"
+// @Success 200 {object} response.ApiResponse{data=[]entity.Attribute}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/categories/{id}/attributes [put]
+func (h *AttributeHandler) SetCategoryAttributes(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.CategoryAttributes
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	attributes, err := h.usecase.SetCategoryAttributes(ctx, id, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, attributes, "PRD-ERA-200"))
+}
+
+// SetProductAttributes godoc
+// @Summary Replace the attribute values of a product
+// @Description Set the attribute values of a product by attribute code. Every attribute must apply to a category of the product and every value match its type; an empty object removes all values.
+// @Tags attributes
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param If-Match header string true "ETag of the version being changed"
+// @Param attributes body request.ProductAttributes true "Attribute values"
+// @Success 200 {object} response.ApiResponse{data=object}
+// @Header 200 {string} ETag "Current product and stock version"
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 412 {object} response.ApiResponse{error=error} "Product changed since it was read, scheduled price changes included; read it again and retry"
+// @Failure 428 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/attributes [put]
+func (h *AttributeHandler) SetProductAttributes(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.ProductAttributes
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	ctx := c.Request().Context()
+	product, err := h.usecase.SetProductAttributes(ctx, id, version, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	c.Response().Header().Set(headerETag, utils.FormatETag(product.Version, product.StockVersion))
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, product.Attributes, "PRD-ERA-200"))
+}
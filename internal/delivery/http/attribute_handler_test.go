@@ -0,0 +1,258 @@
+package http_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"erajaya-test/app"
+	productHttp "erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AttributeHandlerTestSuite struct {
+	suite.Suite
+	echo     *echo.Echo
+	mockUC   *mocks.AttributeUsecase
+	handler  *productHttp.AttributeHandler
+	recorder *httptest.ResponseRecorder
+}
+
+func (s *AttributeHandlerTestSuite) SetupTest() {
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: utils.NewValidator().Validator}
+
+	s.mockUC = new(mocks.AttributeUsecase)
+
+	logger := app.InitZapLogger()
+	resp := response.NewStdResponse(logger)
+	s.handler = productHttp.NewAttributeHandler(s.mockUC, resp)
+
+	s.recorder = httptest.NewRecorder()
+}
+
+func (s *AttributeHandlerTestSuite) sendRequest(method, path, body string, id int64) echo.Context {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+
+	s.recorder = httptest.NewRecorder()
+	c := s.echo.NewContext(req, s.recorder)
+	if id != 0 {
+		c.SetParamNames("id")
+		c.SetParamValues(fmt.Sprint(id))
+	}
+	return c
+}
+
+func (s *AttributeHandlerTestSuite) TestCreateAttribute() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/attributes", `{"code":"ram_gb","name":"RAM","type":"unit","unit":"GB","created_by":"arya"}`, 0)
+
+		s.mockUC.On("CreateAttribute", mock.Anything, mock.MatchedBy(func(r *request.Attribute) bool {
+			return r.Code == "ram_gb" && r.Unit == "GB"
+		})).Return(&entity.Attribute{ID: 1, Code: "ram_gb", Name: "RAM", Type: entity.AttributeUnit, Unit: "GB"}, nil).Once()
+
+		err := s.handler.CreateAttribute(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusCreated, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"unit":"GB"`)
+	})
+
+	s.Run("Unknown Type", func() {
+		c := s.sendRequest(http.MethodPost, "/attributes", `{"code":"ram_gb","name":"RAM","type":"list","created_by":"arya"}`, 0)
+
+		err := s.handler.CreateAttribute(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Duplicate Code", func() {
+		c := s.sendRequest(http.MethodPost, "/attributes", `{"code":"ram_gb","name":"RAM","type":"number","created_by":"arya"}`, 0)
+
+		s.mockUC.On("CreateAttribute", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: an attribute with this code already exists", constant.ErrConflict)).Once()
+
+		err := s.handler.CreateAttribute(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+}
+
+func (s *AttributeHandlerTestSuite) TestListAttributes() {
+	c := s.sendRequest(http.MethodGet, "/attributes", "", 0)
+
+	s.mockUC.On("ListAttributes", mock.Anything).
+		Return([]entity.Attribute{{ID: 1, Code: "color", Type: entity.AttributeEnum, Options: entity.AttributeOptions{"Black"}}}, nil).Once()
+
+	err := s.handler.ListAttributes(c)
+
+	s.NoError(err)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"options":["Black"]`)
+}
+
+func (s *AttributeHandlerTestSuite) TestUpdateAttribute() {
+
+	s.Run("Option In Use", func() {
+		c := s.sendRequest(http.MethodPut, "/attributes/1", `{"name":"Color","options":["Black"],"updated_by":"arya"}`, 1)
+
+		s.mockUC.On("UpdateAttribute", mock.Anything, int64(1), mock.Anything).
+			Return(nil, fmt.Errorf("%w: products still hold an option the update removes", constant.ErrConflict)).Once()
+
+		err := s.handler.UpdateAttribute(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPut, "/attributes/1", `{"name":"Color"}`, 1)
+
+		err := s.handler.UpdateAttribute(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "updated_by is required")
+	})
+}
+
+func (s *AttributeHandlerTestSuite) TestDeleteAttribute() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodDelete, "/attributes/1", "", 1)
+
+		s.mockUC.On("DeleteAttribute", mock.Anything, int64(1)).Return(nil).Once()
+
+		err := s.handler.DeleteAttribute(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodDelete, "/attributes/9", "", 9)
+
+		s.mockUC.On("DeleteAttribute", mock.Anything, int64(9)).Return(constant.ErrNotFound).Once()
+
+		err := s.handler.DeleteAttribute(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *AttributeHandlerTestSuite) TestSetCategoryAttributes() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPut, "/categories/4/attributes", `{"attribute_ids":[1,2]}`, 4)
+
+		s.mockUC.On("SetCategoryAttributes", mock.Anything, int64(4), &request.CategoryAttributes{AttributeIDs: []int64{1, 2}}).
+			Return([]entity.Attribute{{ID: 1, Code: "color"}, {ID: 2, Code: "ram_gb"}}, nil).Once()
+
+		err := s.handler.SetCategoryAttributes(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"code":"ram_gb"`)
+	})
+
+	s.Run("Invalid ID", func() {
+		c := s.sendRequest(http.MethodPut, "/categories/4/attributes", `{"attribute_ids":[0]}`, 4)
+
+		err := s.handler.SetCategoryAttributes(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+}
+
+func (s *AttributeHandlerTestSuite) TestSetProductAttributes() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1/attributes", `{"attributes":{"ram_gb":8,"color":"black"},"updated_by":"arya"}`, 1)
+		c.Request().Header.Set("If-Match", `"2-5"`)
+
+		s.mockUC.On("SetProductAttributes", mock.Anything, int64(1), int64(2), mock.MatchedBy(func(r *request.ProductAttributes) bool {
+			return r.Attributes["ram_gb"] == float64(8) && r.Attributes["color"] == "black"
+		})).Return(&entity.Product{ID: 1, Version: 3, StockVersion: 5, Attributes: entity.ProductAttributes{"color": "Black", "ram_gb": float64(8)}}, nil).Once()
+
+		err := s.handler.SetProductAttributes(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"data":{"color":"Black","ram_gb":8}`)
+		s.Equal(`"3-5"`, s.recorder.Header().Get("ETag"))
+	})
+
+	s.Run("Missing If-Match", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1/attributes", `{"attributes":{},"updated_by":"arya"}`, 1)
+
+		err := s.handler.SetProductAttributes(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusPreconditionRequired, s.recorder.Code)
+	})
+
+	s.Run("Stale If-Match", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1/attributes", `{"attributes":{},"updated_by":"arya"}`, 1)
+		c.Request().Header.Set("If-Match", `"1-0"`)
+
+		s.mockUC.On("SetProductAttributes", mock.Anything, int64(1), int64(1), mock.Anything).Return(nil, constant.ErrVersionMismatch).Once()
+
+		err := s.handler.SetProductAttributes(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusPreconditionFailed, s.recorder.Code)
+	})
+
+	s.Run("Wrong Type", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1/attributes", `{"attributes":{"ram_gb":"8"},"updated_by":"arya"}`, 1)
+		c.Request().Header.Set("If-Match", "*")
+
+		s.mockUC.On("SetProductAttributes", mock.Anything, int64(1), int64(0), mock.Anything).
+			Return(nil, fmt.Errorf("%w: attributes.ram_gb must be a number", constant.ErrValidation)).Once()
+
+		err := s.handler.SetProductAttributes(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Internal Error", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1/attributes", `{"attributes":{},"updated_by":"arya"}`, 1)
+		c.Request().Header.Set("If-Match", "*")
+
+		s.mockUC.On("SetProductAttributes", mock.Anything, int64(1), int64(0), mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		err := s.handler.SetProductAttributes(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func TestAttributeHandlerSuite(t *testing.T) {
+	suite.Run(t, new(AttributeHandlerTestSuite))
+}
@@ -0,0 +1,194 @@
+package http
+
+import (
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/response"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type BrandHandler struct {
+	usecase        interfaces.BrandUsecase
+	productUsecase interfaces.ProductUsecase
+	rates          interfaces.ExchangeRateUsecase
+	response       *response.StdResponse
+}
+
+func NewBrandHandler(brandUsecase interfaces.BrandUsecase, productUsecase interfaces.ProductUsecase, rateUsecase interfaces.ExchangeRateUsecase, standardResponse *response.StdResponse) *BrandHandler {
+	return &BrandHandler{
+		usecase:        brandUsecase,
+		productUsecase: productUsecase,
+		rates:          rateUsecase,
+		response:       standardResponse,
+	}
+}
+
+// CreateBrand godoc
+// @Summary Create a brand
+// @Description Create a brand; names are unique regardless of case
+// @Tags brands
+// @Accept json
+// @Produce json
+// @Param brand body request.Brand true "Brand object"
+// @Success 201 {object} response.ApiResponse{data=entity.Brand}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/brands [post]
+func (h *BrandHandler) CreateBrand(c echo.Context) error {
+	var req request.Brand
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	brand, err := h.usecase.CreateBrand(ctx, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.InsertSuccess, brand, "PRD-ERA-201"))
+}
+
+// ListBrands godoc
+// @Summary List brands
+// @Description Get every brand ordered by name
+// @Tags brands
+// @Produce json
+// @Success 200 {object} response.ApiResponse{data=[]entity.Brand}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/brands [get]
+func (h *BrandHandler) ListBrands(c echo.Context) error {
+	ctx := c.Request().Context()
+	brands, err := h.usecase.ListBrands(ctx)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, brands, "PRD-ERA-200"))
+}
+
+// GetBrand godoc
+// @Summary Get brand by ID
+// @Description Get a single brand
+// @Tags brands
+// @Produce json
+// @Param id path int true "Brand ID"
+// @Success 200 {object} response.ApiResponse{data=entity.Brand}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/brands/{id} [get]
+func (h *BrandHandler) GetBrand(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	brand, err := h.usecase.GetBrand(ctx, id)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, brand, "PRD-ERA-200"))
+}
+
+// UpdateBrand godoc
+// @Summary Rename a brand
+// @Description Rename a brand
+// @Tags brands
+// @Accept json
+// @Produce json
+// @Param id path int true "Brand ID"
+// @Param brand body request.BrandUpdate true "Brand object"
+// @Success 200 {object} response.ApiResponse{data=entity.Brand}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/brands/{id} [put]
+func (h *BrandHandler) UpdateBrand(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.BrandUpdate
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	brand, err := h.usecase.UpdateBrand(ctx, id, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, brand, "PRD-ERA-200"))
+}
+
+// DeleteBrand godoc
+// @Summary Delete a brand
+// @Description Delete a brand that no product, soft-deleted ones included, refers to
+// @Tags brands
+// @Produce json
+// @Param id path int true "Brand ID"
+// @Success 200 {object} response.ApiResponse
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/brands/{id} [delete]
+func (h *BrandHandler) DeleteBrand(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	if err := h.usecase.DeleteBrand(ctx, id); err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.DeleteSuccess, nil, "PRD-ERA-200"))
+}
+
+// ListBrandProducts godoc
+// @Summary List the products of a brand
+// @Description Get the products of a brand, with the filtering and pagination of the product listing
+// @Tags brands
+// @Produce json
+// @Param id path int true "Brand ID"
+// @Param search query string false "Search term"
+// @Param sort query string false "Comma separated sort fields, prefix - for descending (name, price, quantity, created_at, updated_at, id, relevance)" default(-created_at)
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Param cursor query string false "Opaque next_cursor from a previous page, replaces page"
+// @Param skip_total query bool false "Skip counting the total rows"
+// @Param min_price query int false "Minimum price in IDR (inclusive), compared with base_price"
+// @Param max_price query int false "Maximum price in IDR (inclusive), compared with base_price"
+// @Param in_stock query bool false "Only products with (true) or without (false) stock"
+// @Param location_id query int false "Location ID: only products kept there, with their stock there in location_stock; in_stock then applies to that location"
+// @Param category query int false "Category ID, includes its subcategories"
+// @Param currency query string false "ISO 4217 code to show the price in, converted at the loaded exchange rate in the pricing block"
+// @Success 200 {object} response.ApiResponse{data=[]request.Product,metadata=response.StdPagination}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/brands/{id}/products [get]
+func (h *BrandHandler) ListBrandProducts(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	filter, err := productListFilter(c)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	ctx := c.Request().Context()
+	if _, err := h.usecase.GetBrand(ctx, id); err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	filter.BrandIDs = []int64{id}
+	return listProductsResponse(c, h.response, h.productUsecase, h.rates, filter)
+}
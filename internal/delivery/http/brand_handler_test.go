@@ -0,0 +1,264 @@
+package http_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"erajaya-test/app"
+	productHttp "erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BrandHandlerTestSuite struct {
+	suite.Suite
+	echo          *echo.Echo
+	mockUC        *mocks.BrandUsecase
+	mockProductUC *mocks.ProductUsecase
+	mockRate      *mocks.ExchangeRateUsecase
+	handler       *productHttp.BrandHandler
+	recorder      *httptest.ResponseRecorder
+}
+
+func (s *BrandHandlerTestSuite) SetupTest() {
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: utils.NewValidator().Validator}
+
+	s.mockUC = new(mocks.BrandUsecase)
+	s.mockProductUC = new(mocks.ProductUsecase)
+	s.mockRate = new(mocks.ExchangeRateUsecase)
+	s.mockRate.On("PriceProducts", mock.Anything, "", mock.Anything).Return(nil).Maybe()
+
+	logger := app.InitZapLogger()
+	resp := response.NewStdResponse(logger)
+	s.handler = productHttp.NewBrandHandler(s.mockUC, s.mockProductUC, s.mockRate, resp)
+
+	s.recorder = httptest.NewRecorder()
+}
+
+func (s *BrandHandlerTestSuite) sendRequest(method, path, body string, id int64) echo.Context {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+
+	s.recorder = httptest.NewRecorder()
+	c := s.echo.NewContext(req, s.recorder)
+	if id != 0 {
+		c.SetParamNames("id")
+		c.SetParamValues(fmt.Sprint(id))
+	}
+	return c
+}
+
+func (s *BrandHandlerTestSuite) TestCreateBrand() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/brands", `{"name":"Samsung","created_by":"arya"}`, 0)
+
+		s.mockUC.On("CreateBrand", mock.Anything, mock.MatchedBy(func(r *request.Brand) bool {
+			return r.Name == "Samsung" && r.CreatedBy == "arya"
+		})).Return(&entity.Brand{ID: 1, Name: "Samsung"}, nil).Once()
+
+		err := s.handler.CreateBrand(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusCreated, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"name":"Samsung"`)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPost, "/brands", `{"created_by":"arya"}`, 0)
+
+		err := s.handler.CreateBrand(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "name is required")
+	})
+
+	s.Run("Duplicate Name", func() {
+		c := s.sendRequest(http.MethodPost, "/brands", `{"name":"samsung","created_by":"arya"}`, 0)
+
+		s.mockUC.On("CreateBrand", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: a brand with this name already exists", constant.ErrConflict)).Once()
+
+		err := s.handler.CreateBrand(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+}
+
+func (s *BrandHandlerTestSuite) TestListBrands() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/brands", "", 0)
+
+		s.mockUC.On("ListBrands", mock.Anything).Return([]entity.Brand{{ID: 2, Name: "Apple"}, {ID: 1, Name: "Samsung"}}, nil).Once()
+
+		err := s.handler.ListBrands(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"name":"Apple"`)
+	})
+
+	s.Run("Internal Server Error", func() {
+		c := s.sendRequest(http.MethodGet, "/brands", "", 0)
+
+		s.mockUC.On("ListBrands", mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		err := s.handler.ListBrands(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func (s *BrandHandlerTestSuite) TestGetBrand() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/brands/1", "", 1)
+
+		s.mockUC.On("GetBrand", mock.Anything, int64(1)).Return(&entity.Brand{ID: 1, Name: "Samsung"}, nil).Once()
+
+		err := s.handler.GetBrand(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodGet, "/brands/9", "", 9)
+
+		s.mockUC.On("GetBrand", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.GetBrand(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *BrandHandlerTestSuite) TestUpdateBrand() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPut, "/brands/1", `{"name":"Samsung Electronics","updated_by":"arya"}`, 1)
+
+		s.mockUC.On("UpdateBrand", mock.Anything, int64(1), mock.MatchedBy(func(r *request.BrandUpdate) bool {
+			return r.Name == "Samsung Electronics"
+		})).Return(&entity.Brand{ID: 1, Name: "Samsung Electronics"}, nil).Once()
+
+		err := s.handler.UpdateBrand(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPut, "/brands/1", `{"name":"Samsung Electronics"}`, 1)
+
+		err := s.handler.UpdateBrand(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "updated_by is required")
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodPut, "/brands/9", `{"name":"Sony","updated_by":"arya"}`, 9)
+
+		s.mockUC.On("UpdateBrand", mock.Anything, int64(9), mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.UpdateBrand(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *BrandHandlerTestSuite) TestDeleteBrand() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodDelete, "/brands/1", "", 1)
+
+		s.mockUC.On("DeleteBrand", mock.Anything, int64(1)).Return(nil).Once()
+
+		err := s.handler.DeleteBrand(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Still Used", func() {
+		c := s.sendRequest(http.MethodDelete, "/brands/2", "", 2)
+
+		s.mockUC.On("DeleteBrand", mock.Anything, int64(2)).
+			Return(fmt.Errorf("%w: the brand is still used by products", constant.ErrConflict)).Once()
+
+		err := s.handler.DeleteBrand(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+}
+
+func (s *BrandHandlerTestSuite) TestListBrandProducts() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/brands/2/products?brand_id=7&min_price=1000&facets=brand", "", 2)
+
+		s.mockUC.On("GetBrand", mock.Anything, int64(2)).Return(&entity.Brand{ID: 2, Name: "Samsung"}, nil).Once()
+		s.mockProductUC.On("ListProducts", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return reflect.DeepEqual(f.BrandIDs, []int64{2}) && *f.MinPrice == 1000 && f.Page == 1 && f.Limit == 10
+		})).Return([]entity.Product{{ID: 1, Name: "Galaxy S24"}}, response.StdPagination{Page: 1, Limit: 10, Total: 1}, nil).Once()
+
+		err := s.handler.ListBrandProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"name":"Galaxy S24"`)
+	})
+
+	s.Run("Brand Not Found", func() {
+		c := s.sendRequest(http.MethodGet, "/brands/9/products", "", 9)
+
+		s.mockUC.On("GetBrand", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.ListBrandProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+
+	s.Run("Malformed Filter", func() {
+		c := s.sendRequest(http.MethodGet, "/brands/2/products?min_price=cheap", "", 2)
+
+		err := s.handler.ListBrandProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+}
+
+func TestBrandHandlerSuite(t *testing.T) {
+	suite.Run(t, new(BrandHandlerTestSuite))
+}
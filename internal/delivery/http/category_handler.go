@@ -0,0 +1,205 @@
+package http
+
+import (
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/response"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CategoryHandler struct {
+	usecase  interfaces.CategoryUsecase
+	response *response.StdResponse
+}
+
+func NewCategoryHandler(categoryUsecase interfaces.CategoryUsecase, standardResponse *response.StdResponse) *CategoryHandler {
+	return &CategoryHandler{
+		usecase:  categoryUsecase,
+		response: standardResponse,
+	}
+}
+
+// CreateCategory godoc
+// @Summary Create a category
+// @Description Create a category, below parent_id or as a root when it is omitted
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param category body request.Category true "Category object"
+// @Success 201 {object} response.ApiResponse{data=entity.Category}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/categories [post]
+func (h *CategoryHandler) CreateCategory(c echo.Context) error {
+	var req request.Category
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	category, err := h.usecase.CreateCategory(ctx, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.InsertSuccess, category, "PRD-ERA-201"))
+}
+
+// ListCategories godoc
+// @Summary List categories
+// @Description Get the whole category tree, subcategories nested in children
+// @Tags categories
+// @Produce json
+// @Success 200 {object} response.ApiResponse{data=[]entity.Category}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/categories [get]
+func (h *CategoryHandler) ListCategories(c echo.Context) error {
+	ctx := c.Request().Context()
+	categories, err := h.usecase.ListCategories(ctx)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, categories, "PRD-ERA-200"))
+}
+
+// GetCategory godoc
+// @Summary Get category by ID
+// @Description Get a single category
+// @Tags categories
+// @Produce json
+// @Param id path int true "Category ID"
+// @Success 200 {object} response.ApiResponse{data=entity.Category}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/categories/{id} [get]
+func (h *CategoryHandler) GetCategory(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	category, err := h.usecase.GetCategory(ctx, id)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, category, "PRD-ERA-200"))
+}
+
+// UpdateCategory godoc
+// @Summary Update a category
+// @Description Rename a category and move it, with its subcategories, below parent_id (a root when omitted)
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path int true "Category ID"
+// @Param category body request.CategoryUpdate true "Category object"
+// @Success 200 {object} response.ApiResponse{data=entity.Category}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/categories/{id} [put]
+func (h *CategoryHandler) UpdateCategory(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.CategoryUpdate
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	category, err := h.usecase.UpdateCategory(ctx, id, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, category, "PRD-ERA-200"))
+}
+
+// DeleteCategory godoc
+// @Summary Delete a category
+// @Description Delete a category without subcategories; its products are unlinked, not deleted
+// @Tags categories
+// @Produce json
+// @Param id path int true "Category ID"
+// @Success 200 {object} response.ApiResponse
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/categories/{id} [delete]
+func (h *CategoryHandler) DeleteCategory(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	if err := h.usecase.DeleteCategory(ctx, id); err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.DeleteSuccess, nil, "PRD-ERA-200"))
+}
+
+// GetProductCategories godoc
+// @Summary List the categories of a product
+// @Description Get the categories a product is linked to
+// @Tags categories
+// @Produce json
+// @Param id path int true "Product ID"
+// @Success 200 {object} response.ApiResponse{data=[]entity.Category}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/categories [get]
+func (h *CategoryHandler) GetProductCategories(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	categories, err := h.usecase.GetProductCategories(ctx, id)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, categories, "PRD-ERA-200"))
+}
+
+// SetProductCategories godoc
+// @Summary Replace the categories of a product
+// @Description Link a product to exactly the given categories; an empty list unlinks it from all
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param categories body request.ProductCategories true "Category IDs"
+// @Success 200 {object} response.ApiResponse{data=[]entity.Category}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/categories [put]
+func (h *CategoryHandler) SetProductCategories(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.ProductCategories
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	categories, err := h.usecase.SetProductCategories(ctx, id, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, categories, "PRD-ERA-200"))
+}
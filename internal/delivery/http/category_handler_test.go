@@ -0,0 +1,243 @@
+package http_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"erajaya-test/app"
+	productHttp "erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CategoryHandlerTestSuite struct {
+	suite.Suite
+	echo     *echo.Echo
+	mockUC   *mocks.CategoryUsecase
+	handler  *productHttp.CategoryHandler
+	recorder *httptest.ResponseRecorder
+}
+
+func (s *CategoryHandlerTestSuite) SetupTest() {
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: utils.NewValidator().Validator}
+
+	s.mockUC = new(mocks.CategoryUsecase)
+
+	logger := app.InitZapLogger()
+	resp := response.NewStdResponse(logger)
+	s.handler = productHttp.NewCategoryHandler(s.mockUC, resp)
+
+	s.recorder = httptest.NewRecorder()
+}
+
+func (s *CategoryHandlerTestSuite) sendRequest(method, path, body string, id int64) echo.Context {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+
+	s.recorder = httptest.NewRecorder()
+	c := s.echo.NewContext(req, s.recorder)
+	if id != 0 {
+		c.SetParamNames("id")
+		c.SetParamValues(fmt.Sprint(id))
+	}
+	return c
+}
+
+func (s *CategoryHandlerTestSuite) TestCreateCategory() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/categories", `{"name":"TV","parent_id":1,"created_by":"arya"}`, 0)
+
+		s.mockUC.On("CreateCategory", mock.Anything, mock.MatchedBy(func(r *request.Category) bool {
+			return r.Name == "TV" && *r.ParentID == 1
+		})).Return(&entity.Category{ID: 4, Name: "TV", Path: "/1/4/", Depth: 1}, nil).Once()
+
+		err := s.handler.CreateCategory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusCreated, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"path":"/1/4/"`)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPost, "/categories", `{"name":"TV"}`, 0)
+
+		err := s.handler.CreateCategory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "created_by is required")
+	})
+
+	s.Run("Duplicate Name", func() {
+		c := s.sendRequest(http.MethodPost, "/categories", `{"name":"TV","created_by":"arya"}`, 0)
+
+		s.mockUC.On("CreateCategory", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: a category with this name already exists under the same parent", constant.ErrConflict)).Once()
+
+		err := s.handler.CreateCategory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+}
+
+func (s *CategoryHandlerTestSuite) TestListCategories() {
+	c := s.sendRequest(http.MethodGet, "/categories", "", 0)
+
+	s.mockUC.On("ListCategories", mock.Anything).Return([]entity.Category{
+		{ID: 1, Name: "Electronics", Children: []entity.Category{{ID: 4, Name: "TV"}}},
+	}, nil).Once()
+
+	err := s.handler.ListCategories(c)
+
+	s.NoError(err)
+	s.Equal(http.StatusOK, s.recorder.Code)
+	s.Contains(s.recorder.Body.String(), `"children":[{"id":4`)
+}
+
+func (s *CategoryHandlerTestSuite) TestGetCategory() {
+	c := s.sendRequest(http.MethodGet, "/categories/9", "", 9)
+
+	s.mockUC.On("GetCategory", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+	err := s.handler.GetCategory(c)
+
+	s.NoError(err)
+	s.Equal(http.StatusNotFound, s.recorder.Code)
+}
+
+func (s *CategoryHandlerTestSuite) TestUpdateCategory() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPut, "/categories/4", `{"name":"TV","parent_id":2,"updated_by":"arya"}`, 4)
+
+		s.mockUC.On("UpdateCategory", mock.Anything, int64(4), mock.MatchedBy(func(r *request.CategoryUpdate) bool {
+			return *r.ParentID == 2
+		})).Return(&entity.Category{ID: 4, Path: "/2/4/"}, nil).Once()
+
+		err := s.handler.UpdateCategory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Move Below Descendant", func() {
+		c := s.sendRequest(http.MethodPut, "/categories/4", `{"name":"TV","parent_id":7,"updated_by":"arya"}`, 4)
+
+		s.mockUC.On("UpdateCategory", mock.Anything, int64(4), mock.Anything).
+			Return(nil, fmt.Errorf("%w: a category cannot be moved below itself or its descendants", constant.ErrValidation)).Once()
+
+		err := s.handler.UpdateCategory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+}
+
+func (s *CategoryHandlerTestSuite) TestDeleteCategory() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodDelete, "/categories/4", "", 4)
+
+		s.mockUC.On("DeleteCategory", mock.Anything, int64(4)).Return(nil).Once()
+
+		err := s.handler.DeleteCategory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Has Subcategories", func() {
+		c := s.sendRequest(http.MethodDelete, "/categories/1", "", 1)
+
+		s.mockUC.On("DeleteCategory", mock.Anything, int64(1)).
+			Return(fmt.Errorf("%w: the category still has subcategories", constant.ErrConflict)).Once()
+
+		err := s.handler.DeleteCategory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+}
+
+func (s *CategoryHandlerTestSuite) TestProductCategories() {
+
+	s.Run("Get", func() {
+		c := s.sendRequest(http.MethodGet, "/products/10/categories", "", 10)
+
+		s.mockUC.On("GetProductCategories", mock.Anything, int64(10)).Return([]entity.Category{{ID: 4, Name: "TV"}}, nil).Once()
+
+		err := s.handler.GetProductCategories(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Set", func() {
+		c := s.sendRequest(http.MethodPut, "/products/10/categories", `{"category_ids":[4,5],"updated_by":"arya"}`, 10)
+
+		s.mockUC.On("SetProductCategories", mock.Anything, int64(10), mock.MatchedBy(func(r *request.ProductCategories) bool {
+			return len(r.CategoryIDs) == 2 && r.UpdatedBy == "arya"
+		})).Return([]entity.Category{{ID: 4}, {ID: 5}}, nil).Once()
+
+		err := s.handler.SetProductCategories(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Set Invalid ID", func() {
+		c := s.sendRequest(http.MethodPut, "/products/10/categories", `{"category_ids":[0],"updated_by":"arya"}`, 10)
+
+		err := s.handler.SetProductCategories(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Set Unknown Product", func() {
+		c := s.sendRequest(http.MethodPut, "/products/99/categories", `{"category_ids":[4],"updated_by":"arya"}`, 99)
+
+		s.mockUC.On("SetProductCategories", mock.Anything, int64(99), mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.SetProductCategories(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+
+	s.Run("Set Internal Error", func() {
+		c := s.sendRequest(http.MethodPut, "/products/10/categories", `{"category_ids":[],"updated_by":"arya"}`, 10)
+
+		s.mockUC.On("SetProductCategories", mock.Anything, int64(10), mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		err := s.handler.SetProductCategories(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func TestCategoryHandlerSuite(t *testing.T) {
+	suite.Run(t, new(CategoryHandlerTestSuite))
+}
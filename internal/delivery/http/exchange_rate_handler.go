@@ -0,0 +1,69 @@
+package http
+
+import (
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ExchangeRateHandler struct {
+	usecase  interfaces.ExchangeRateUsecase
+	response *response.StdResponse
+}
+
+func NewExchangeRateHandler(rateUsecase interfaces.ExchangeRateUsecase, standardResponse *response.StdResponse) *ExchangeRateHandler {
+	return &ExchangeRateHandler{
+		usecase:  rateUsecase,
+		response: standardResponse,
+	}
+}
+
+// ListRates godoc
+// @Summary List exchange rates
+// @Description Get the exchange rates of every known currency: what one unit is worth in IDR, and the decimals prices in the currency are kept in
+// @Tags pricing
+// @Produce json
+// @Success 200 {object} response.ApiResponse{data=[]entity.ExchangeRate}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/exchange-rates [get]
+func (h *ExchangeRateHandler) ListRates(c echo.Context) error {
+	ctx := c.Request().Context()
+	rates, err := h.usecase.ListRates(ctx)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, rates, "PRD-ERA-200"))
+}
+
+// LoadRates godoc
+// @Summary Load exchange rates
+// @Description Add or replace the exchange rates of the given currencies (admin). rate is what one unit of the currency is worth in IDR, whose own rate stays 1 with no minor units. The minor_units of a known currency cannot change. Rates apply to the next read converting prices with ?currency=.
+// @Tags pricing
+// @Accept json
+// @Produce json
+// @Param rates body request.ExchangeRateLoad true "Exchange rates"
+// @Success 200 {object} response.ApiResponse{data=[]entity.ExchangeRate}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/exchange-rates [put]
+func (h *ExchangeRateHandler) LoadRates(c echo.Context) error {
+	var req request.ExchangeRateLoad
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	rates, err := h.usecase.LoadRates(ctx, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, rates, "PRD-ERA-200"))
+}
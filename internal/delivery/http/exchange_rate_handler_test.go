@@ -0,0 +1,121 @@
+package http_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"erajaya-test/app"
+	productHttp "erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExchangeRateHandlerTestSuite struct {
+	suite.Suite
+	echo     *echo.Echo
+	mockUC   *mocks.ExchangeRateUsecase
+	handler  *productHttp.ExchangeRateHandler
+	recorder *httptest.ResponseRecorder
+}
+
+func (s *ExchangeRateHandlerTestSuite) SetupTest() {
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: utils.NewValidator().Validator}
+
+	s.mockUC = new(mocks.ExchangeRateUsecase)
+
+	logger := app.InitZapLogger()
+	resp := response.NewStdResponse(logger)
+	s.handler = productHttp.NewExchangeRateHandler(s.mockUC, resp)
+
+	s.recorder = httptest.NewRecorder()
+}
+
+func (s *ExchangeRateHandlerTestSuite) sendRequest(method, path, body string) echo.Context {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+
+	s.recorder = httptest.NewRecorder()
+	return s.echo.NewContext(req, s.recorder)
+}
+
+func (s *ExchangeRateHandlerTestSuite) TestListRates() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/exchange-rates", "")
+
+		s.mockUC.On("ListRates", mock.Anything).Return([]entity.ExchangeRate{{Currency: "IDR", Rate: 1}, {Currency: "USD", Rate: 16000, MinorUnits: 2}}, nil).Once()
+
+		err := s.handler.ListRates(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"currency":"USD","rate":16000,"minor_units":2`)
+	})
+
+	s.Run("Usecase Error", func() {
+		c := s.sendRequest(http.MethodGet, "/exchange-rates", "")
+
+		s.mockUC.On("ListRates", mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		err := s.handler.ListRates(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func (s *ExchangeRateHandlerTestSuite) TestLoadRates() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPut, "/exchange-rates", `{"rates":[{"currency":"USD","rate":16250,"minor_units":2}],"updated_by":"arya"}`)
+
+		s.mockUC.On("LoadRates", mock.Anything, mock.MatchedBy(func(r *request.ExchangeRateLoad) bool {
+			return len(r.Rates) == 1 && r.Rates[0].Currency == "USD" && r.Rates[0].Rate == 16250 && *r.Rates[0].MinorUnits == 2
+		})).Return([]entity.ExchangeRate{{Currency: "USD", Rate: 16250, MinorUnits: 2}}, nil).Once()
+
+		err := s.handler.LoadRates(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPut, "/exchange-rates", `{"rates":[{"currency":"dollar","rate":0}],"updated_by":"arya"}`)
+
+		err := s.handler.LoadRates(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "must be an ISO 4217 currency code")
+	})
+
+	s.Run("Bind Error", func() {
+		c := s.sendRequest(http.MethodPut, "/exchange-rates", `{"rates":`)
+
+		err := s.handler.LoadRates(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+}
+
+func TestExchangeRateHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ExchangeRateHandlerTestSuite))
+}
@@ -0,0 +1,172 @@
+package http
+
+import (
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/response"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type LocationHandler struct {
+	usecase  interfaces.LocationUsecase
+	response *response.StdResponse
+}
+
+func NewLocationHandler(locationUsecase interfaces.LocationUsecase, standardResponse *response.StdResponse) *LocationHandler {
+	return &LocationHandler{
+		usecase:  locationUsecase,
+		response: standardResponse,
+	}
+}
+
+// CreateLocation godoc
+// @Summary Create a location
+// @Description Create a warehouse or store that keeps stock; codes are unique regardless of case
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Param location body request.Location true "Location object"
+// @Success 201 {object} response.ApiResponse{data=entity.Location}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/locations [post]
+func (h *LocationHandler) CreateLocation(c echo.Context) error {
+	var req request.Location
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	location, err := h.usecase.CreateLocation(ctx, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.InsertSuccess, location, "PRD-ERA-201"))
+}
+
+// ListLocations godoc
+// @Summary List locations
+// @Description Get every location ordered by code
+// @Tags locations
+// @Produce json
+// @Success 200 {object} response.ApiResponse{data=[]entity.Location}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/locations [get]
+func (h *LocationHandler) ListLocations(c echo.Context) error {
+	ctx := c.Request().Context()
+	locations, err := h.usecase.ListLocations(ctx)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, locations, "PRD-ERA-200"))
+}
+
+// GetLocation godoc
+// @Summary Get location by ID
+// @Description Get a single location
+// @Tags locations
+// @Produce json
+// @Param id path int true "Location ID"
+// @Success 200 {object} response.ApiResponse{data=entity.Location}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/locations/{id} [get]
+func (h *LocationHandler) GetLocation(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	location, err := h.usecase.GetLocation(ctx, id)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, location, "PRD-ERA-200"))
+}
+
+// UpdateLocation godoc
+// @Summary Update a location
+// @Description Replace the code, name, type and address of a location
+// @Tags locations
+// @Accept json
+// @Produce json
+// @Param id path int true "Location ID"
+// @Param location body request.LocationUpdate true "Location object"
+// @Success 200 {object} response.ApiResponse{data=entity.Location}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/locations/{id} [put]
+func (h *LocationHandler) UpdateLocation(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.LocationUpdate
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	location, err := h.usecase.UpdateLocation(ctx, id, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, location, "PRD-ERA-200"))
+}
+
+// DeleteLocation godoc
+// @Summary Delete a location
+// @Description Delete a location that never kept stock. The default location cannot be deleted.
+// @Tags locations
+// @Produce json
+// @Param id path int true "Location ID"
+// @Success 200 {object} response.ApiResponse
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/locations/{id} [delete]
+func (h *LocationHandler) DeleteLocation(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	if err := h.usecase.DeleteLocation(ctx, id); err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.DeleteSuccess, nil, "PRD-ERA-200"))
+}
+
+// ListProductStocks godoc
+// @Summary List the stock of a product per location
+// @Description Get the on hand, reserved and available stock of a product at every location that kept it. The quantity of the product is their total.
+// @Tags stock
+// @Produce json
+// @Param id path int true "Product ID"
+// @Success 200 {object} response.ApiResponse{data=[]entity.ProductStock}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/stocks [get]
+func (h *LocationHandler) ListProductStocks(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	stocks, err := h.usecase.ListProductStocks(ctx, productID)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, stocks, "PRD-ERA-200"))
+}
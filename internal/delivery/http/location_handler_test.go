@@ -0,0 +1,246 @@
+package http_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"erajaya-test/app"
+	productHttp "erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocationHandlerTestSuite struct {
+	suite.Suite
+	echo     *echo.Echo
+	mockUC   *mocks.LocationUsecase
+	handler  *productHttp.LocationHandler
+	recorder *httptest.ResponseRecorder
+}
+
+func (s *LocationHandlerTestSuite) SetupTest() {
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: utils.NewValidator().Validator}
+
+	s.mockUC = new(mocks.LocationUsecase)
+
+	logger := app.InitZapLogger()
+	resp := response.NewStdResponse(logger)
+	s.handler = productHttp.NewLocationHandler(s.mockUC, resp)
+
+	s.recorder = httptest.NewRecorder()
+}
+
+func (s *LocationHandlerTestSuite) sendRequest(method, path, body string, id int64) echo.Context {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+
+	s.recorder = httptest.NewRecorder()
+	c := s.echo.NewContext(req, s.recorder)
+	if id != 0 {
+		c.SetParamNames("id")
+		c.SetParamValues(fmt.Sprint(id))
+	}
+	return c
+}
+
+func (s *LocationHandlerTestSuite) TestCreateLocation() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/locations", `{"code":"JKT-01","name":"Jakarta store","type":"store","created_by":"arya"}`, 0)
+
+		s.mockUC.On("CreateLocation", mock.Anything, mock.MatchedBy(func(r *request.Location) bool {
+			return r.Code == "JKT-01" && r.Type == entity.LocationStore
+		})).Return(&entity.Location{ID: 2, Code: "JKT-01", Name: "Jakarta store", Type: entity.LocationStore}, nil).Once()
+
+		err := s.handler.CreateLocation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusCreated, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"code":"JKT-01"`)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPost, "/locations", `{"code":"JKT-01","name":"Jakarta store","type":"kiosk","created_by":"arya"}`, 0)
+
+		err := s.handler.CreateLocation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Duplicate Code", func() {
+		c := s.sendRequest(http.MethodPost, "/locations", `{"code":"jkt-01","name":"Jakarta store","type":"store","created_by":"arya"}`, 0)
+
+		s.mockUC.On("CreateLocation", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: a location with this code already exists", constant.ErrConflict)).Once()
+
+		err := s.handler.CreateLocation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+}
+
+func (s *LocationHandlerTestSuite) TestListLocations() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/locations", "", 0)
+
+		s.mockUC.On("ListLocations", mock.Anything).Return([]entity.Location{{ID: 1, Code: "MAIN", IsDefault: true}}, nil).Once()
+
+		err := s.handler.ListLocations(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"is_default":true`)
+	})
+
+	s.Run("Internal Server Error", func() {
+		c := s.sendRequest(http.MethodGet, "/locations", "", 0)
+
+		s.mockUC.On("ListLocations", mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		err := s.handler.ListLocations(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func (s *LocationHandlerTestSuite) TestGetLocation() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/locations/1", "", 1)
+
+		s.mockUC.On("GetLocation", mock.Anything, int64(1)).Return(&entity.Location{ID: 1, Code: "MAIN"}, nil).Once()
+
+		err := s.handler.GetLocation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodGet, "/locations/9", "", 9)
+
+		s.mockUC.On("GetLocation", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.GetLocation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *LocationHandlerTestSuite) TestUpdateLocation() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPut, "/locations/2", `{"code":"JKT-01","name":"Jakarta flagship","type":"store","updated_by":"arya"}`, 2)
+
+		s.mockUC.On("UpdateLocation", mock.Anything, int64(2), mock.MatchedBy(func(r *request.LocationUpdate) bool {
+			return r.Name == "Jakarta flagship"
+		})).Return(&entity.Location{ID: 2, Name: "Jakarta flagship"}, nil).Once()
+
+		err := s.handler.UpdateLocation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPut, "/locations/2", `{"code":"JKT-01","name":"Jakarta flagship","type":"store"}`, 2)
+
+		err := s.handler.UpdateLocation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "updated_by is required")
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodPut, "/locations/9", `{"code":"SBY-01","name":"Surabaya","type":"warehouse","updated_by":"arya"}`, 9)
+
+		s.mockUC.On("UpdateLocation", mock.Anything, int64(9), mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.UpdateLocation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *LocationHandlerTestSuite) TestDeleteLocation() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodDelete, "/locations/2", "", 2)
+
+		s.mockUC.On("DeleteLocation", mock.Anything, int64(2)).Return(nil).Once()
+
+		err := s.handler.DeleteLocation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Default Location", func() {
+		c := s.sendRequest(http.MethodDelete, "/locations/1", "", 1)
+
+		s.mockUC.On("DeleteLocation", mock.Anything, int64(1)).
+			Return(fmt.Errorf("%w: the default location cannot be deleted", constant.ErrConflict)).Once()
+
+		err := s.handler.DeleteLocation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+}
+
+func (s *LocationHandlerTestSuite) TestListProductStocks() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/stocks", "", 1)
+
+		s.mockUC.On("ListProductStocks", mock.Anything, int64(1)).
+			Return([]entity.ProductStock{{ProductID: 1, LocationID: 1, Quantity: 6, Reserved: 2, Available: 4}}, nil).Once()
+
+		err := s.handler.ListProductStocks(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"available":4`)
+	})
+
+	s.Run("Product Not Found", func() {
+		c := s.sendRequest(http.MethodGet, "/products/9/stocks", "", 9)
+
+		s.mockUC.On("ListProductStocks", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.ListProductStocks(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func TestLocationHandlerSuite(t *testing.T) {
+	suite.Run(t, new(LocationHandlerTestSuite))
+}
@@ -0,0 +1,215 @@
+package http
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// exportFlushEvery is how many rows are written between flushes to the client.
+const exportFlushEvery = 500
+
+var exportColumns = []string{"id", "sku", "barcode", "slug", "brand_id", "name", "price", "description", "quantity", "created_by", "created_at", "updated_by", "updated_at"}
+
+// productExporter writes products in one export format.
+type productExporter interface {
+	// Start writes whatever precedes the first product, e.g. a header row.
+	Start(w io.Writer) error
+	Write(product entity.Product) error
+	// Finish writes out what is still buffered.
+	Finish() error
+	ContentType() string
+	Extension() string
+}
+
+func newProductExporter(format string) (productExporter, error) {
+	switch format {
+	case "", "csv":
+		return &csvExporter{}, nil
+	case "ndjson":
+		return &ndjsonExporter{}, nil
+	case "xlsx":
+		return &xlsxExporter{}, nil
+	default:
+		return nil, fmt.Errorf("%w: format must be csv, ndjson or xlsx", constant.ErrValidation)
+	}
+}
+
+// exportRecord is the row of a product under exportColumns.
+func exportRecord(product entity.Product) []interface{} {
+	var sku, barcode, brandID, price, quantity interface{}
+	if product.SKU != nil {
+		sku = *product.SKU
+	}
+	if product.Barcode != nil {
+		barcode = *product.Barcode
+	}
+	if product.BrandID != nil {
+		brandID = *product.BrandID
+	}
+	if product.Price != nil {
+		price = *product.Price
+	}
+	if product.Quantity != nil {
+		quantity = *product.Quantity
+	}
+
+	return []interface{}{
+		product.ID, sku, barcode, product.Slug, brandID, product.Name, price, product.Description, quantity,
+		product.CreatedBy, product.CreatedAt, product.UpdatedBy, product.UpdatedAt,
+	}
+}
+
+func flushExport(w io.Writer) {
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+type csvExporter struct {
+	out    io.Writer
+	writer *csv.Writer
+	rows   int
+}
+
+func (e *csvExporter) ContentType() string { return "text/csv" }
+func (e *csvExporter) Extension() string   { return "csv" }
+
+func (e *csvExporter) Start(w io.Writer) error {
+	e.out = w
+	e.writer = csv.NewWriter(w)
+	return e.writer.Write(exportColumns)
+}
+
+func (e *csvExporter) Write(product entity.Product) error {
+	values := exportRecord(product)
+
+	record := make([]string, len(values))
+	for i, value := range values {
+		switch v := value.(type) {
+		case nil:
+		case string:
+			record[i] = v
+		case time.Time:
+			record[i] = v.Format(time.RFC3339)
+		case int64:
+			record[i] = strconv.FormatInt(v, 10)
+		default:
+			record[i] = fmt.Sprint(v)
+		}
+	}
+	if err := e.writer.Write(record); err != nil {
+		return err
+	}
+
+	e.rows++
+	if e.rows%exportFlushEvery == 0 {
+		e.writer.Flush()
+		flushExport(e.out)
+	}
+	return e.writer.Error()
+}
+
+func (e *csvExporter) Finish() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+type ndjsonExporter struct {
+	out     io.Writer
+	buffer  *bufio.Writer
+	encoder *json.Encoder
+	rows    int
+}
+
+func (e *ndjsonExporter) ContentType() string { return "application/x-ndjson" }
+func (e *ndjsonExporter) Extension() string   { return "ndjson" }
+
+func (e *ndjsonExporter) Start(w io.Writer) error {
+	e.out = w
+	e.buffer = bufio.NewWriter(w)
+	e.encoder = json.NewEncoder(e.buffer)
+	return nil
+}
+
+func (e *ndjsonExporter) Write(product entity.Product) error {
+	if err := e.encoder.Encode(product); err != nil {
+		return err
+	}
+
+	e.rows++
+	if e.rows%exportFlushEvery == 0 {
+		if err := e.buffer.Flush(); err != nil {
+			return err
+		}
+		flushExport(e.out)
+	}
+	return nil
+}
+
+func (e *ndjsonExporter) Finish() error {
+	return e.buffer.Flush()
+}
+
+// xlsxExporter builds the sheet with the excelize stream writer, which spills
+// rows to a temporary file instead of holding them in memory. A workbook is a
+// zip archive, so nothing reaches the client before Finish.
+type xlsxExporter struct {
+	out    io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	row    int
+}
+
+func (e *xlsxExporter) ContentType() string {
+	return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+}
+func (e *xlsxExporter) Extension() string { return "xlsx" }
+
+func (e *xlsxExporter) Start(w io.Writer) error {
+	e.out = w
+	e.file = excelize.NewFile()
+
+	stream, err := e.file.NewStreamWriter(e.file.GetSheetName(0))
+	if err != nil {
+		return err
+	}
+	e.stream = stream
+
+	header := make([]interface{}, len(exportColumns))
+	for i, column := range exportColumns {
+		header[i] = column
+	}
+	return e.writeRow(header)
+}
+
+func (e *xlsxExporter) Write(product entity.Product) error {
+	return e.writeRow(exportRecord(product))
+}
+
+func (e *xlsxExporter) writeRow(values []interface{}) error {
+	e.row++
+	cell, err := excelize.CoordinatesToCellName(1, e.row)
+	if err != nil {
+		return err
+	}
+	return e.stream.SetRow(cell, values)
+}
+
+func (e *xlsxExporter) Finish() error {
+	defer e.file.Close()
+
+	if err := e.stream.Flush(); err != nil {
+		return err
+	}
+	return e.file.Write(e.out)
+}
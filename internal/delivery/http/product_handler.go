@@ -1,23 +1,43 @@
 package http
 
 import (
+	"encoding/json"
 	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
 	"erajaya-test/internal/models/request"
 	"erajaya-test/shared/constant"
 	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+	"errors"
+	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 )
 
+const (
+	headerETag        = "ETag"
+	headerIfMatch     = "If-Match"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// maxHistoryLimit bounds the page size of the audit history of a product.
+const maxHistoryLimit = 100
+
 type ProductHandler struct {
 	usecase  interfaces.ProductUsecase
+	rates    interfaces.ExchangeRateUsecase
 	response *response.StdResponse
 }
 
-func NewHandler(productUsecase interfaces.ProductUsecase, standardResponse *response.StdResponse) *ProductHandler {
+func NewHandler(productUsecase interfaces.ProductUsecase, rateUsecase interfaces.ExchangeRateUsecase, standardResponse *response.StdResponse) *ProductHandler {
 	return &ProductHandler{
 		usecase:  productUsecase,
+		rates:    rateUsecase,
 		response: standardResponse,
 	}
 }
@@ -31,6 +51,7 @@ func NewHandler(productUsecase interfaces.ProductUsecase, standardResponse *resp
 // @Param product body request.Product true "Product object"
 // @Success 201 {object} response.ApiResponse{data=request.Product}
 // @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 409 {object} response.ApiResponse{error=error}
 // @Failure 500 {object} response.ApiResponse{error=error}
 // @Router /api/v1/products [post]
 func (h *ProductHandler) CreateProduct(c echo.Context) error {
@@ -46,36 +67,112 @@ func (h *ProductHandler) CreateProduct(c echo.Context) error {
 	ctx := c.Request().Context()
 	err := h.usecase.CreateProduct(ctx, &req)
 	if err != nil {
-		return h.response.StandardResponse(c, h.response.ErrorResponse(ctx, response.InternalError, err, "PRD-ERA-500"))
+		return h.errorResponse(c, err)
 	}
 
 	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.InsertSuccess, req, "PRD-ERA-201"))
 }
 
+// CreateProductsBulk godoc
+// @Summary Create products in bulk
+// @Description Create up to 1000 products in one request. Each item is validated on its own and reported by its index. In atomic mode (default) nothing is inserted unless every item is valid; in best_effort mode the valid items are inserted.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param products body request.ProductBulk true "Products to create"
+// @Success 201 {object} response.ApiResponse{data=response.BulkResult}
+// @Success 207 {object} response.ApiResponse{data=response.BulkResult}
+// @Failure 400 {object} response.ApiResponse{data=response.BulkResult,error=[]utils.ValidationError}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/bulk [post]
+func (h *ProductHandler) CreateProductsBulk(c echo.Context) error {
+	var req request.ProductBulk
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	result, err := h.usecase.CreateProductsBulk(ctx, &req)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	switch {
+	case result.Failed == 0:
+		return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.InsertSuccess, result, "PRD-ERA-201"))
+	case result.Created == 0:
+		return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.BulkRejected, result, "PRD-ERA-400"))
+	default:
+		return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.BulkPartialSuccess, result, "PRD-ERA-207"))
+	}
+}
+
 // ListProducts godoc
 // @Summary List all products
-// @Description Get a list of products with optional filtering and pagination
+// @Description Get a list of products with optional filtering and pagination. Attribute values filter with attr.<code> parameters: attr.color=Black,White matches any of the listed values, attr.ram_gb>=8 (also >, <, <=) compares number and unit attributes.
 // @Tags products
 // @Accept json
 // @Produce json
 // @Param search query string false "Search term"
-// @Param sort query string false "Sort field"
+// @Param sort query string false "Comma separated sort fields, prefix - for descending (name, price, quantity, created_at, updated_at, id, relevance)" default(-created_at)
 // @Param page query int false "Page number"
 // @Param limit query int false "Items per page"
+// @Param include_deleted query bool false "Include soft-deleted products (admin)"
+// @Param include_unpublished query bool false "Include products in every status and outside their publish window (admin)"
+// @Param status query []string false "Statuses, comma separated or repeated; only active products are public" collectionFormat(csv) Enums(draft, active, archived, discontinued)
+// @Param cursor query string false "Opaque next_cursor from a previous page, replaces page"
+// @Param skip_total query bool false "Skip counting the total rows"
+// @Param min_price query int false "Minimum price in IDR (inclusive), compared with base_price"
+// @Param max_price query int false "Maximum price in IDR (inclusive), compared with base_price"
+// @Param in_stock query bool false "Only products with (true) or without (false) stock"
+// @Param location_id query int false "Location ID: only products kept there, with their stock there in location_stock; in_stock then applies to that location"
+// @Param created_from query string false "Created at or after, RFC3339 or YYYY-MM-DD"
+// @Param created_to query string false "Created at or before, RFC3339 or YYYY-MM-DD (whole day)"
+// @Param created_by query string false "Creator username"
+// @Param ids query []int false "Product IDs, comma separated or repeated (max 100)" collectionFormat(csv)
+// @Param category query int false "Category ID, includes its subcategories"
+// @Param brand_id query []int false "Brand IDs, comma separated or repeated (max 50)" collectionFormat(csv)
+// @Param currency query string false "ISO 4217 code to show the price in, converted at the loaded exchange rate in the pricing block"
+// @Param facets query []string false "Facet counts to return in metadata.facets" collectionFormat(csv) Enums(brand, category, price, in_stock)
 // @Success 200 {object} response.ApiResponse{data=[]request.Product,metadata=response.StdPagination}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
 // @Failure 500 {object} response.ApiResponse{error=error}
 // @Router /api/v1/products [get]
 func (h *ProductHandler) ListProducts(c echo.Context) error {
+	filter, err := productListFilter(c)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return listProductsResponse(c, h.response, h.usecase, h.rates, filter)
+}
+
+// productListFilter reads the paging and filter parameters of a product listing.
+func productListFilter(c echo.Context) (request.ProductFilter, error) {
 	search := c.QueryParam("search")
 	sort := c.QueryParam("sort")
 	page, _ := strconv.Atoi(c.QueryParam("page"))
 	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	includeDeleted, _ := strconv.ParseBool(c.QueryParam("include_deleted"))
+	skipTotal, _ := strconv.ParseBool(c.QueryParam("skip_total"))
 
 	filter := request.ProductFilter{
-		Search: search,
-		Sort:   sort,
-		Page:   page,
-		Limit:  limit,
+		Search:         search,
+		Sort:           sort,
+		Page:           page,
+		Limit:          limit,
+		IncludeDeleted: includeDeleted,
+		Cursor:         c.QueryParam("cursor"),
+		SkipTotal:      skipTotal,
+		CreatedBy:      c.QueryParam("created_by"),
+	}
+
+	if err := bindProductFilterParams(c, &filter); err != nil {
+		return filter, err
 	}
 
 	if filter.Page <= 0 {
@@ -85,18 +182,163 @@ func (h *ProductHandler) ListProducts(c echo.Context) error {
 		filter.Limit = 10
 	}
 
+	return filter, nil
+}
+
+// listProductsResponse answers with a page of products priced in the
+// currency the request asks for.
+func listProductsResponse(c echo.Context, res *response.StdResponse, productUsecase interfaces.ProductUsecase, rateUsecase interfaces.ExchangeRateUsecase, filter request.ProductFilter) error {
 	ctx := c.Request().Context()
-	products, metadata, err := h.usecase.ListProducts(ctx, filter)
+	products, metadata, err := productUsecase.ListProducts(ctx, filter)
 	if err != nil {
-		return h.response.StandardResponse(c, h.response.ErrorResponse(ctx, response.InternalError, err, "PRD-ERA-500"))
+		return errorResponse(c, res, err)
 	}
 
-	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, map[string]interface{}{
+	priced := make([]*entity.Product, len(products))
+	for i := range products {
+		priced[i] = &products[i]
+	}
+	if err := rateUsecase.PriceProducts(ctx, c.QueryParam("currency"), priced); err != nil {
+		return errorResponse(c, res, err)
+	}
+
+	return res.StandardResponse(c, res.SuccessResponse(ctx, response.GetSuccess, map[string]interface{}{
 		"data":     products,
 		"metadata": metadata,
 	}, "PRD-ERA-200"))
 }
 
+// ExportProducts godoc
+// @Summary Export products
+// @Description Stream every product matching the listing filters as CSV, NDJSON or XLSX. Paging parameters are ignored.
+// @Tags products
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "Export format" Enums(csv, ndjson, xlsx) default(csv)
+// @Param search query string false "Search term"
+// @Param sort query string false "Comma separated sort fields, prefix - for descending (name, price, quantity, created_at, updated_at, id, relevance)" default(-created_at)
+// @Param include_deleted query bool false "Include soft-deleted products (admin)"
+// @Param include_unpublished query bool false "Include products in every status and outside their publish window (admin)"
+// @Param status query []string false "Statuses, comma separated or repeated; only active products are public" collectionFormat(csv) Enums(draft, active, archived, discontinued)
+// @Param min_price query int false "Minimum price in IDR (inclusive), compared with base_price"
+// @Param max_price query int false "Maximum price in IDR (inclusive), compared with base_price"
+// @Param in_stock query bool false "Only products with (true) or without (false) stock"
+// @Param location_id query int false "Location ID: only products kept there; in_stock then applies to that location"
+// @Param created_from query string false "Created at or after, RFC3339 or YYYY-MM-DD"
+// @Param created_to query string false "Created at or before, RFC3339 or YYYY-MM-DD (whole day)"
+// @Param created_by query string false "Creator username"
+// @Param ids query []int false "Product IDs, comma separated or repeated (max 100)" collectionFormat(csv)
+// @Param category query int false "Category ID, includes its subcategories"
+// @Param brand_id query []int false "Brand IDs, comma separated or repeated (max 50)" collectionFormat(csv)
+// @Success 200 {file} file
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/export [get]
+func (h *ProductHandler) ExportProducts(c echo.Context) error {
+	includeDeleted, _ := strconv.ParseBool(c.QueryParam("include_deleted"))
+
+	filter := request.ProductFilter{
+		Search:         c.QueryParam("search"),
+		Sort:           c.QueryParam("sort"),
+		IncludeDeleted: includeDeleted,
+		CreatedBy:      c.QueryParam("created_by"),
+	}
+
+	if err := bindProductFilterParams(c, &filter); err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	exporter, err := newProductExporter(c.QueryParam("format"))
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	// The response is committed with the first row, until then a failure
+	// can still be answered with an error body.
+	started := false
+	start := func() error {
+		started = true
+		fileName := fmt.Sprintf("products-%s.%s", time.Now().Format("20060102-150405"), exporter.Extension())
+		c.Response().Header().Set(echo.HeaderContentType, exporter.ContentType())
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, fileName))
+		c.Response().WriteHeader(http.StatusOK)
+		return exporter.Start(c.Response())
+	}
+
+	ctx := c.Request().Context()
+	err = h.usecase.ExportProducts(ctx, filter, func(product entity.Product) error {
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		return exporter.Write(product)
+	})
+	if err != nil {
+		if !started {
+			return h.errorResponse(c, err)
+		}
+		return err
+	}
+
+	if !started {
+		if err := start(); err != nil {
+			return err
+		}
+	}
+
+	return exporter.Finish()
+}
+
+// SuggestProducts godoc
+// @Summary Suggest product names
+// @Description Typo tolerant "did you mean" suggestions for a search term, ranked by trigram similarity, from published products only
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param q query string true "Search term"
+// @Param limit query int false "Maximum suggestions (max 10)"
+// @Success 200 {object} response.ApiResponse{data=[]entity.ProductSuggestion}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/suggest [get]
+func (h *ProductHandler) SuggestProducts(c echo.Context) error {
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	ctx := c.Request().Context()
+	suggestions, err := h.usecase.SuggestProducts(ctx, c.QueryParam("q"), limit)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, suggestions, "PRD-ERA-200"))
+}
+
+// AutocompleteProducts godoc
+// @Summary Autocomplete product names
+// @Description Products with a word in their name starting with the typed prefix, served from the Redis index
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param q query string true "Typed prefix"
+// @Param limit query int false "Maximum results (max 10)"
+// @Success 200 {object} response.ApiResponse{data=[]entity.ProductAutocomplete}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/autocomplete [get]
+func (h *ProductHandler) AutocompleteProducts(c echo.Context) error {
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	ctx := c.Request().Context()
+	products, err := h.usecase.AutocompleteProducts(ctx, c.QueryParam("q"), limit)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, products, "PRD-ERA-200"))
+}
+
 // GetProductByID godoc
 // @Summary Get product by ID
 // @Description Get a single product by its ID
@@ -104,7 +346,12 @@ func (h *ProductHandler) ListProducts(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "Product ID"
+// @Param If-None-Match header string false "ETag from a previous response"
+// @Param currency query string false "ISO 4217 code to show the price in, converted at the loaded exchange rate in the pricing block"
 // @Success 200 {object} response.ApiResponse{data=request.Product}
+// @Header 200 {string} ETag "Current product and stock version"
+// @Success 304 "Product has not changed"
+// @Failure 400 {object} response.ApiResponse{error=error}
 // @Failure 404 {object} response.ApiResponse{error=error}
 // @Failure 500 {object} response.ApiResponse{error=error}
 // @Router /api/v1/products/{id} [get]
@@ -120,5 +367,487 @@ func (h *ProductHandler) GetProductByID(c echo.Context) error {
 		return h.response.StandardResponse(c, h.response.ErrorResponse(ctx, response.InternalError, err, "PRD-ERA-500"))
 	}
 
-	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, product, "PRD-ERA-200"))
+	return h.productResponse(c, product)
+}
+
+// GetProductBySKU godoc
+// @Summary Get product by SKU
+// @Description Get a single product by its stock keeping unit
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param sku path string true "Product SKU"
+// @Param If-None-Match header string false "ETag from a previous response"
+// @Param currency query string false "ISO 4217 code to show the price in, converted at the loaded exchange rate in the pricing block"
+// @Success 200 {object} response.ApiResponse{data=entity.Product}
+// @Header 200 {string} ETag "Current product and stock version"
+// @Success 304 "Product has not changed"
+// @Failure 400 {object} response.ApiResponse{error=error}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/sku/{sku} [get]
+func (h *ProductHandler) GetProductBySKU(c echo.Context) error {
+	product, err := h.usecase.GetProductBySKU(c.Request().Context(), c.Param("sku"))
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return h.productResponse(c, product)
+}
+
+// GetProductBySlug godoc
+// @Summary Get product by slug
+// @Description Get a single product by its URL slug
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param slug path string true "Product slug"
+// @Param If-None-Match header string false "ETag from a previous response"
+// @Param currency query string false "ISO 4217 code to show the price in, converted at the loaded exchange rate in the pricing block"
+// @Success 200 {object} response.ApiResponse{data=entity.Product}
+// @Header 200 {string} ETag "Current product and stock version"
+// @Success 304 "Product has not changed"
+// @Failure 400 {object} response.ApiResponse{error=error}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/slug/{slug} [get]
+func (h *ProductHandler) GetProductBySlug(c echo.Context) error {
+	product, err := h.usecase.GetProductBySlug(c.Request().Context(), c.Param("slug"))
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return h.productResponse(c, product)
+}
+
+// productResponse answers with a single product and its ETag, or with 304
+// when the client already holds the current versions. A price converted into
+// another currency follows the exchange rate, which the version does not
+// track, so a converting read is always answered in full.
+func (h *ProductHandler) productResponse(c echo.Context, product *entity.Product) error {
+	currency := c.QueryParam("currency")
+
+	c.Response().Header().Set(headerETag, utils.FormatETag(product.Version, product.StockVersion))
+	if currency == "" && utils.MatchETag(c.Request().Header.Get(headerIfNoneMatch), product.Version, product.StockVersion) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	if err := h.rates.PriceProducts(c.Request().Context(), currency, []*entity.Product{product}); err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(c.Request().Context(), response.GetSuccess, product, "PRD-ERA-200"))
+}
+
+// UpdateProduct godoc
+// @Summary Update a product
+// @Description Replace all editable fields of a product
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param If-Match header string true "ETag of the version being changed"
+// @Param product body request.ProductUpdate true "Product object"
+// @Success 200 {object} response.ApiResponse{data=entity.Product}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 412 {object} response.ApiResponse{error=error} "Product changed since it was read, scheduled price changes included; read it again and retry"
+// @Failure 428 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id} [put]
+func (h *ProductHandler) UpdateProduct(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.ProductUpdate
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	ctx := c.Request().Context()
+	product, err := h.usecase.UpdateProduct(ctx, id, version, &req)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	c.Response().Header().Set(headerETag, utils.FormatETag(product.Version, product.StockVersion))
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, product, "PRD-ERA-200"))
+}
+
+// PatchProduct godoc
+// @Summary Partially update a product
+// @Description Apply a JSON merge patch (RFC 7386) to a product, updated_by is always required
+// @Tags products
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param If-Match header string true "ETag of the version being changed"
+// @Param product body object true "Merge patch document"
+// @Success 200 {object} response.ApiResponse{data=entity.Product}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 412 {object} response.ApiResponse{error=error} "Product changed since it was read, scheduled price changes included; read it again and retry"
+// @Failure 428 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id} [patch]
+func (h *ProductHandler) PatchProduct(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var patch map[string]interface{}
+	if err := json.NewDecoder(c.Request().Body).Decode(&patch); err != nil || patch == nil {
+		if err == nil {
+			err = errors.New("patch document must be a JSON object")
+		}
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	ctx := c.Request().Context()
+	product, err := h.usecase.PatchProduct(ctx, id, version, patch)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	c.Response().Header().Set(headerETag, utils.FormatETag(product.Version, product.StockVersion))
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, product, "PRD-ERA-200"))
+}
+
+// DeleteProduct godoc
+// @Summary Delete a product
+// @Description Delete a product by its ID
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param If-Match header string true "ETag of the version being changed"
+// @Param deleted_by query string true "Deleter identifier"
+// @Success 200 {object} response.ApiResponse
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 412 {object} response.ApiResponse{error=error} "Product changed since it was read, scheduled price changes included; read it again and retry"
+// @Failure 428 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id} [delete]
+func (h *ProductHandler) DeleteProduct(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.ProductDelete
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	ctx := c.Request().Context()
+	if err := h.usecase.DeleteProduct(ctx, id, version, &req); err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.DeleteSuccess, nil, "PRD-ERA-200"))
+}
+
+// TransitionProductStatus godoc
+// @Summary Change the status of a product
+// @Description Move a product to another status and replace its publish window. Allowed: draft to active or archived, active to archived or discontinued, archived to active or discontinued, discontinued to archived; keeping the status only reschedules the window. Public listings show active products between publish_at and unpublish_at.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param If-Match header string true "ETag of the version being changed"
+// @Param status body request.ProductStatus true "Target status"
+// @Success 200 {object} response.ApiResponse{data=entity.Product}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 412 {object} response.ApiResponse{error=error} "Product changed since it was read, scheduled price changes included; read it again and retry"
+// @Failure 428 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/status [post]
+func (h *ProductHandler) TransitionProductStatus(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.ProductStatus
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	ctx := c.Request().Context()
+	product, err := h.usecase.TransitionProductStatus(ctx, id, version, &req)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	c.Response().Header().Set(headerETag, utils.FormatETag(product.Version, product.StockVersion))
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, product, "PRD-ERA-200"))
+}
+
+// RestoreProduct godoc
+// @Summary Restore a deleted product
+// @Description Restore a soft-deleted product so it is visible again
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param If-Match header string true "ETag of the deleted version"
+// @Param product body request.ProductRestore true "Restore object"
+// @Success 200 {object} response.ApiResponse{data=entity.Product}
+// @Header 200 {string} ETag "Current product and stock version"
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 412 {object} response.ApiResponse{error=error} "Product changed since it was read; read it again and retry"
+// @Failure 428 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/restore [post]
+func (h *ProductHandler) RestoreProduct(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.ProductRestore
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	ctx := c.Request().Context()
+	product, err := h.usecase.RestoreProduct(ctx, id, version, &req)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	c.Response().Header().Set(headerETag, utils.FormatETag(product.Version, product.StockVersion))
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, product, "PRD-ERA-200"))
+}
+
+// ListProductHistory godoc
+// @Summary List the audit history of a product
+// @Description Get the writes to a product, latest first: the action, its actor and request id, and the changed fields with their values before and after. The history of a deleted or purged product stays readable.
+// @Tags products
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page (max 100)"
+// @Success 200 {object} response.ApiResponse{data=[]entity.AuditEvent,metadata=response.StdPagination}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/history [get]
+func (h *ProductHandler) ListProductHistory(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, maxHistoryLimit)
+
+	ctx := c.Request().Context()
+	events, metadata, err := h.usecase.ListProductHistory(ctx, id, page, limit)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, map[string]interface{}{
+		"data":     events,
+		"metadata": metadata,
+	}, "PRD-ERA-200"))
+}
+
+func (h *ProductHandler) errorResponse(c echo.Context, err error) error {
+	return errorResponse(c, h.response, err)
+}
+
+// errorResponse maps a usecase error onto its status and code.
+func errorResponse(c echo.Context, res *response.StdResponse, err error) error {
+	ctx := c.Request().Context()
+
+	var validationErrors validator.ValidationErrors
+	switch {
+	case errors.Is(err, constant.ErrNotFound):
+		return res.StandardResponse(c, res.ErrorResponse(ctx, response.NotFound, err, "PRD-ERA-404"))
+	case errors.Is(err, constant.ErrConflict):
+		return res.StandardResponse(c, res.ErrorResponse(ctx, response.Conflict, err, "PRD-ERA-409"))
+	case errors.Is(err, constant.ErrVersionMismatch):
+		return res.StandardResponse(c, res.ErrorResponse(ctx, response.PreconditionFailed, err, "PRD-ERA-412"))
+	case errors.Is(err, constant.ErrIfMatchRequired):
+		return res.StandardResponse(c, res.ErrorResponse(ctx, response.PreconditionRequired, err, "PRD-ERA-428"))
+	case errors.As(err, &validationErrors):
+		return res.StandardResponse(c, res.ErrorResponse(ctx, response.BadRequest, validationErrors, "PRD-ERA-400"))
+	case errors.Is(err, constant.ErrValidation):
+		return res.StandardResponse(c, res.ErrorResponse(ctx, response.BadRequest, err, "PRD-ERA-400"))
+	default:
+		return res.StandardResponse(c, res.ErrorResponse(ctx, response.InternalError, err, "PRD-ERA-500"))
+	}
+}
+
+// attributeFilterPrefix marks the query parameters filtering by attribute.
+const attributeFilterPrefix = "attr."
+
+// bindProductFilterParams parses the typed list filters. Unlike paging
+// parameters, a malformed value is rejected rather than ignored so a typo never
+// silently widens the result set.
+func bindProductFilterParams(c echo.Context, filter *request.ProductFilter) error {
+	for name, target := range map[string]**int64{
+		"min_price":   &filter.MinPrice,
+		"max_price":   &filter.MaxPrice,
+		"category":    &filter.Category,
+		"location_id": &filter.LocationID,
+	} {
+		if raw := c.QueryParam(name); raw != "" {
+			value, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("%w: %s must be an integer", constant.ErrValidation, name)
+			}
+			*target = &value
+		}
+	}
+
+	// Callers see what the public sees unless they ask for more (admin).
+	filter.Published = true
+	if raw := c.QueryParam("include_unpublished"); raw != "" {
+		includeUnpublished, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("%w: include_unpublished must be a boolean", constant.ErrValidation)
+		}
+		filter.Published = !includeUnpublished
+	}
+
+	if raw := c.QueryParam("in_stock"); raw != "" {
+		inStock, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("%w: in_stock must be a boolean", constant.ErrValidation)
+		}
+		filter.InStock = &inStock
+	}
+
+	for name, target := range map[string]**time.Time{
+		"created_from": &filter.CreatedFrom,
+		"created_to":   &filter.CreatedTo,
+	} {
+		if raw := c.QueryParam(name); raw != "" {
+			value, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				day, dayErr := time.Parse(time.DateOnly, raw)
+				if dayErr != nil {
+					return fmt.Errorf("%w: %s must be RFC3339 or YYYY-MM-DD", constant.ErrValidation, name)
+				}
+				// A bare date as upper bound covers the whole day.
+				if name == "created_to" {
+					day = day.Add(24*time.Hour - time.Nanosecond)
+				}
+				value = day
+			}
+			*target = &value
+		}
+	}
+
+	for name, target := range map[string]*[]int64{
+		"ids":      &filter.IDs,
+		"brand_id": &filter.BrandIDs,
+	} {
+		for _, raw := range c.QueryParams()[name] {
+			for _, part := range strings.Split(raw, ",") {
+				part = strings.TrimSpace(part)
+				if part == "" {
+					continue
+				}
+				id, err := strconv.ParseInt(part, 10, 64)
+				if err != nil {
+					return fmt.Errorf("%w: %s must be a list of integers", constant.ErrValidation, name)
+				}
+				*target = append(*target, id)
+			}
+		}
+	}
+
+	for name, target := range map[string]*[]string{
+		"facets": &filter.Facets,
+		"status": &filter.Statuses,
+	} {
+		for _, raw := range c.QueryParams()[name] {
+			for _, part := range strings.Split(raw, ",") {
+				if part = strings.TrimSpace(part); part != "" {
+					*target = append(*target, part)
+				}
+			}
+		}
+	}
+
+	// Attribute filters arrive as attr.ram_gb>=8, which the query string
+	// splits into the key "attr.ram_gb>" and the value "8"; attr.ram_gb>8
+	// has no "=" and arrives as a key alone.
+	for name, values := range c.QueryParams() {
+		code, ok := strings.CutPrefix(name, attributeFilterPrefix)
+		if !ok {
+			continue
+		}
+		for _, value := range values {
+			if value == "" && strings.ContainsAny(code, "<>") {
+				filter.Attributes = append(filter.Attributes, code)
+			} else {
+				filter.Attributes = append(filter.Attributes, code+"="+value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ifMatchVersion reads the product version a mutation is conditioned on. The
+// wildcard tag matches any current version and is returned as 0.
+func ifMatchVersion(c echo.Context) (int64, error) {
+	header := strings.TrimSpace(c.Request().Header.Get(headerIfMatch))
+	if header == "" {
+		return 0, constant.ErrIfMatchRequired
+	}
+	if header == "*" {
+		return 0, nil
+	}
+
+	version, ok := utils.ParseETag(header)
+	if !ok {
+		return 0, constant.ErrVersionMismatch
+	}
+	return version, nil
 }
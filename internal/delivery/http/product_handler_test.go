@@ -3,10 +3,14 @@ package http_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"erajaya-test/app"
 	productHttp "erajaya-test/internal/delivery/http"
@@ -22,6 +26,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"github.com/xuri/excelize/v2"
 )
 
 type CustomValidator struct {
@@ -42,6 +47,7 @@ type ProductHandlerTestSuite struct {
 	suite.Suite
 	echo     *echo.Echo
 	mockUC   *mocks.ProductUsecase
+	mockRate *mocks.ExchangeRateUsecase
 	handler  *productHttp.ProductHandler
 	recorder *httptest.ResponseRecorder
 }
@@ -49,13 +55,16 @@ type ProductHandlerTestSuite struct {
 func (s *ProductHandlerTestSuite) SetupTest() {
 
 	s.echo = echo.New()
-	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.echo.Validator = &CustomValidator{validator: utils.NewValidator().Validator}
 
 	s.mockUC = new(mocks.ProductUsecase)
+	s.mockRate = new(mocks.ExchangeRateUsecase)
+	// Reads without ?currency= price products in their own currency.
+	s.mockRate.On("PriceProducts", mock.Anything, "", mock.Anything).Return(nil).Maybe()
 
 	logger := app.InitZapLogger()
 	resp := response.NewStdResponse(logger)
-	s.handler = productHttp.NewHandler(s.mockUC, resp)
+	s.handler = productHttp.NewHandler(s.mockUC, s.mockRate, resp)
 
 	s.recorder = httptest.NewRecorder()
 }
@@ -89,6 +98,29 @@ func (s *ProductHandlerTestSuite) TestCreateProduct() {
 		s.Equal(http.StatusCreated, s.recorder.Code)
 	})
 
+	s.Run("Duplicate SKU", func() {
+		c := s.sendRequest(http.MethodPost, "/products", `{"name":"LG TV","sku":"LG-TV-42","price":5000000,"description":"Desc","quantity":10,"created_by":"arya"}`)
+
+		s.mockUC.On("CreateProduct", mock.Anything, mock.Anything).
+			Return(fmt.Errorf("%w: sku is already used by another product", constant.ErrConflict)).Once()
+
+		err := s.handler.CreateProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "PRD-ERA-409")
+	})
+
+	s.Run("Validation Error - Invalid Barcode", func() {
+		c := s.sendRequest(http.MethodPost, "/products", `{"name":"LG TV","barcode":"1234567890123","price":5000000,"description":"Desc","quantity":10,"created_by":"arya"}`)
+
+		err := s.handler.CreateProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "barcode must be a valid EAN-13 or UPC-A barcode")
+	})
+
 	s.Run("Bad Request - Invalid JSON", func() {
 		c := s.sendRequest(http.MethodPost, "/products", "invalid-json")
 
@@ -126,10 +158,10 @@ func (s *ProductHandlerTestSuite) TestCreateProduct() {
 		s.NoError(errJSON)
 
 		expectedErrors := []utils.ValidationError{
-			{Parameter: "Price is required"},
-			{Parameter: "Description is required"},
-			{Parameter: "Quantity is required"},
-			{Parameter: "CreatedBy is required"},
+			{Parameter: "price is required"},
+			{Parameter: "description is required"},
+			{Parameter: "quantity is required"},
+			{Parameter: "created_by is required"},
 		}
 
 		s.ElementsMatch(expectedErrors, resp.Error, "Error list harus mencakup semua field yang missing")
@@ -147,6 +179,85 @@ func (s *ProductHandlerTestSuite) TestCreateProduct() {
 	})
 }
 
+func (s *ProductHandlerTestSuite) TestCreateProductsBulk() {
+	reqJSON := `{"mode":"best_effort","products":[{"name":"LG TV","price":5000000,"description":"Desc","quantity":10,"created_by":"arya"},{"name":"OLED"}]}`
+
+	s.Run("All Created", func() {
+		c := s.sendRequest(http.MethodPost, "/products/bulk", reqJSON)
+
+		s.mockUC.On("CreateProductsBulk", mock.Anything, mock.MatchedBy(func(r *request.ProductBulk) bool {
+			return r.Mode == request.BulkModeBestEffort && len(r.Products) == 2
+		})).Return(response.BulkResult{Created: 2, Items: []response.BulkItem{{Index: 0, ID: 1}, {Index: 1, ID: 2}}}, nil).Once()
+
+		err := s.handler.CreateProductsBulk(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusCreated, s.recorder.Code)
+	})
+
+	s.Run("Partially Created", func() {
+		c := s.sendRequest(http.MethodPost, "/products/bulk", reqJSON)
+
+		s.mockUC.On("CreateProductsBulk", mock.Anything, mock.Anything).
+			Return(response.BulkResult{Created: 1, Failed: 1, Items: []response.BulkItem{{Index: 0, ID: 1}, {Index: 1, Error: "invalid"}}}, nil).Once()
+
+		err := s.handler.CreateProductsBulk(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusMultiStatus, s.recorder.Code)
+
+		var resp struct {
+			Code string              `json:"code"`
+			Data response.BulkResult `json:"data"`
+		}
+		s.NoError(json.Unmarshal(s.recorder.Body.Bytes(), &resp))
+		s.Equal("PRD-ERA-207", resp.Code)
+		s.Equal("invalid", resp.Data.Items[1].Error)
+	})
+
+	s.Run("Nothing Created", func() {
+		c := s.sendRequest(http.MethodPost, "/products/bulk", reqJSON)
+
+		s.mockUC.On("CreateProductsBulk", mock.Anything, mock.Anything).
+			Return(response.BulkResult{Failed: 1, Items: []response.BulkItem{{Index: 0}, {Index: 1, Error: "invalid"}}}, nil).Once()
+
+		err := s.handler.CreateProductsBulk(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Validation Error - Empty Products", func() {
+		c := s.sendRequest(http.MethodPost, "/products/bulk", `{"products":[]}`)
+
+		err := s.handler.CreateProductsBulk(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Validation Error - Unknown Mode", func() {
+		c := s.sendRequest(http.MethodPost, "/products/bulk", `{"mode":"fast","products":[{"name":"LG TV"}]}`)
+
+		err := s.handler.CreateProductsBulk(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Internal Server Error", func() {
+		c := s.sendRequest(http.MethodPost, "/products/bulk", reqJSON)
+
+		s.mockUC.On("CreateProductsBulk", mock.Anything, mock.Anything).
+			Return(response.BulkResult{}, errors.New("db error")).Once()
+
+		err := s.handler.CreateProductsBulk(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
 func (s *ProductHandlerTestSuite) TestListProducts() {
 	s.Run("Success", func() {
 		c := s.sendRequest(http.MethodGet, "/products?search=test&sort=newest&page=1&limit=10", "")
@@ -161,6 +272,235 @@ func (s *ProductHandlerTestSuite) TestListProducts() {
 		s.Equal(http.StatusOK, s.recorder.Code)
 	})
 
+	s.Run("Success Include Deleted", func() {
+		c := s.sendRequest(http.MethodGet, "/products?include_deleted=true", "")
+
+		s.mockUC.On("ListProducts", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return f.IncludeDeleted
+		})).Return([]entity.Product{}, response.StdPagination{Page: 1, Limit: 10}, nil).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Published By Default", func() {
+		c := s.sendRequest(http.MethodGet, "/products", "")
+
+		s.mockUC.On("ListProducts", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return f.Published && len(f.Statuses) == 0
+		})).Return([]entity.Product{}, response.StdPagination{Page: 1, Limit: 10}, nil).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Success Include Unpublished", func() {
+		c := s.sendRequest(http.MethodGet, "/products?include_unpublished=true&status=draft,archived", "")
+
+		s.mockUC.On("ListProducts", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return !f.Published && slices.Equal(f.Statuses, []string{"draft", "archived"})
+		})).Return([]entity.Product{}, response.StdPagination{Page: 1, Limit: 10}, nil).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Invalid Include Unpublished", func() {
+		c := s.sendRequest(http.MethodGet, "/products?include_unpublished=maybe", "")
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Success Cursor Without Total", func() {
+		c := s.sendRequest(http.MethodGet, "/products?cursor=abc&skip_total=true&limit=20", "")
+
+		s.mockUC.On("ListProducts", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return f.Cursor == "abc" && f.SkipTotal && f.Limit == 20
+		})).Return([]entity.Product{}, response.StdPagination{Limit: 20, NextCursor: "def"}, nil).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"next_cursor":"def"`)
+	})
+
+	s.Run("Success With Structured Filters", func() {
+		c := s.sendRequest(http.MethodGet, "/products?min_price=1000&max_price=5000&in_stock=false&created_from=2025-01-01&created_to=2025-01-31&created_by=arya&ids=3,1&ids=2&category=4", "")
+
+		s.mockUC.On("ListProducts", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return *f.MinPrice == 1000 && *f.MaxPrice == 5000 && !*f.InStock &&
+				f.CreatedFrom.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) &&
+				f.CreatedTo.Equal(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)) &&
+				f.CreatedBy == "arya" && reflect.DeepEqual(f.IDs, []int64{3, 1, 2}) && *f.Category == 4
+		})).Return([]entity.Product{}, response.StdPagination{}, nil).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Success With Single Bounds", func() {
+		for query, matches := range map[string]func(f request.ProductFilter) bool{
+			"min_price=500000": func(f request.ProductFilter) bool {
+				return f.MinPrice != nil && *f.MinPrice == 500000 && f.MaxPrice == nil
+			},
+			"max_price=500000": func(f request.ProductFilter) bool {
+				return f.MaxPrice != nil && *f.MaxPrice == 500000 && f.MinPrice == nil
+			},
+			"created_from=2025-01-01": func(f request.ProductFilter) bool {
+				return f.CreatedFrom != nil && f.CreatedFrom.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) && f.CreatedTo == nil
+			},
+			"created_to=2025-01-31": func(f request.ProductFilter) bool {
+				return f.CreatedTo != nil && f.CreatedTo.Equal(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)) && f.CreatedFrom == nil
+			},
+		} {
+			c := s.sendRequest(http.MethodGet, "/products?"+query, "")
+
+			s.mockUC.On("ListProducts", mock.Anything, mock.MatchedBy(matches)).Return([]entity.Product{}, response.StdPagination{}, nil).Once()
+
+			err := s.handler.ListProducts(c)
+
+			s.NoError(err)
+			s.Equal(http.StatusOK, s.recorder.Code, query)
+		}
+	})
+
+	s.Run("Success With Brands And Facets", func() {
+		c := s.sendRequest(http.MethodGet, "/products?brand_id=2,1&brand_id=5&facets=brand", "")
+
+		s.mockUC.On("ListProducts", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return reflect.DeepEqual(f.BrandIDs, []int64{2, 1, 5}) && reflect.DeepEqual(f.Facets, []string{"brand"})
+		})).Return([]entity.Product{}, response.StdPagination{
+			Facets: &entity.ProductFacets{Brands: []entity.FacetCount{{ID: 2, Name: "Samsung", Count: 3}}},
+		}, nil).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"facets":{"brand":[{"id":2,"name":"Samsung","count":3}]}`)
+	})
+
+	s.Run("Success With Attributes", func() {
+		c := s.sendRequest(http.MethodGet, "/products?attr.ram_gb>=8&attr.screen<7&attr.color=Black,White&attr.nfc=true&attr.nfc=false", "")
+
+		s.mockUC.On("ListProducts", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return reflect.DeepEqual(slices.Sorted(slices.Values(f.Attributes)), []string{"color=Black,White", "nfc=false", "nfc=true", "ram_gb>=8", "screen<7"})
+		})).Return([]entity.Product{}, response.StdPagination{}, nil).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Success With Location", func() {
+		c := s.sendRequest(http.MethodGet, "/products?location_id=2&in_stock=true", "")
+		quantity := 10
+
+		s.mockUC.On("ListProducts", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return *f.LocationID == 2 && *f.InStock
+		})).Return([]entity.Product{{
+			ID:            1,
+			Name:          "LG TV",
+			Quantity:      &quantity,
+			LocationStock: &entity.ProductStock{ProductID: 1, LocationID: 2, Quantity: 4, Reserved: 1, Available: 3},
+		}}, response.StdPagination{Page: 1, Limit: 10, Total: 1}, nil).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"quantity":10`)
+		s.Contains(s.recorder.Body.String(), `"location_stock":{"product_id":1,"location_id":2,"quantity":4,"reserved":1,"available":3}`)
+	})
+
+	s.Run("Malformed Location", func() {
+		c := s.sendRequest(http.MethodGet, "/products?location_id=main", "")
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "location_id must be an integer")
+	})
+
+	s.Run("Success With Price And Stock Facets", func() {
+		c := s.sendRequest(http.MethodGet, "/products?facets=price,in_stock", "")
+		bound := int64(1000000)
+
+		s.mockUC.On("ListProducts", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return reflect.DeepEqual(f.Facets, []string{"price", "in_stock"})
+		})).Return([]entity.Product{}, response.StdPagination{
+			Facets: &entity.ProductFacets{
+				Prices: []entity.PriceBucket{{Min: 0, Max: &bound, Count: 2}, {Min: 1000000, Count: 1}},
+				Stock:  &entity.StockCount{InStock: 2, OutOfStock: 1},
+			},
+		}, nil).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"facets":{"price":[{"min":0,"max":1000000,"count":2},{"min":1000000,"count":1}],"in_stock":{"in_stock":2,"out_of_stock":1}}`)
+	})
+
+	s.Run("Malformed Filter", func() {
+		for _, target := range []string{
+			"/products?min_price=cheap",
+			"/products?in_stock=maybe",
+			"/products?created_from=yesterday",
+			"/products?ids=1,a",
+			"/products?category=tv",
+			"/products?brand_id=apple",
+		} {
+			c := s.sendRequest(http.MethodGet, target, "")
+
+			err := s.handler.ListProducts(c)
+
+			s.NoError(err)
+			s.Equal(http.StatusBadRequest, s.recorder.Code, target)
+		}
+	})
+
+	s.Run("Unknown Sort Field", func() {
+		c := s.sendRequest(http.MethodGet, "/products?sort=price,-stock", "")
+
+		sortErr := fmt.Errorf("%w: sort field \"stock\" is not allowed, use one of name, price", constant.ErrValidation)
+		s.mockUC.On("ListProducts", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return f.Sort == "price,-stock"
+		})).Return(nil, response.StdPagination{}, sortErr).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"parameter":"validation error: sort field \"stock\" is not allowed, use one of name, price"`)
+	})
+
+	s.Run("Invalid Cursor", func() {
+		c := s.sendRequest(http.MethodGet, "/products?cursor=abc", "")
+
+		s.mockUC.On("ListProducts", mock.Anything, mock.Anything).
+			Return(nil, response.StdPagination{}, constant.ErrValidation).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
 	s.Run("Success with Default Pagination", func() {
 
 		c := s.sendRequest(http.MethodGet, "/products", "")
@@ -187,61 +527,920 @@ func (s *ProductHandlerTestSuite) TestListProducts() {
 	})
 }
 
-func (s *ProductHandlerTestSuite) TestGetProductByID() {
-	s.Run("Success", func() {
-		c := s.sendRequest(http.MethodGet, "/products/1", "")
-		c.SetPath("/products/:id")
-		c.SetParamNames("id")
-		c.SetParamValues("1")
+func (s *ProductHandlerTestSuite) TestExportProducts() {
+	price := int64(5000000)
+	sku := "LG-TV-42"
+	brandID := int64(3)
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	products := []entity.Product{
+		{ID: 1, SKU: &sku, Slug: "lg-tv", BrandID: &brandID, Name: "LG TV", Price: &price, Description: "Desc, 42 Inch", CreatedBy: "arya", CreatedAt: createdAt, UpdatedAt: createdAt},
+		{ID: 2, Name: "OLED", CreatedAt: createdAt, UpdatedAt: createdAt},
+	}
+	stream := func(args mock.Arguments) {
+		fn := args.Get(2).(func(entity.Product) error)
+		for _, product := range products {
+			s.Require().NoError(fn(product))
+		}
+	}
 
-		expected := &entity.Product{ID: 1, Name: "Test"}
-		s.mockUC.On("GetProductByID", mock.Anything, int64(1)).Return(expected, nil).Once()
+	s.Run("CSV", func() {
+		c := s.sendRequest(http.MethodGet, "/products/export?format=csv&min_price=1000&sort=price", "")
 
-		err := s.handler.GetProductByID(c)
+		s.mockUC.On("ExportProducts", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return f.Sort == "price" && f.MinPrice != nil && *f.MinPrice == 1000
+		}), mock.Anything).Run(stream).Return(nil).Once()
+
+		err := s.handler.ExportProducts(c)
 
 		s.NoError(err)
 		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Equal("text/csv", s.recorder.Header().Get(echo.HeaderContentType))
+		s.Regexp(`^attachment; filename="products-\d{8}-\d{6}\.csv"$`, s.recorder.Header().Get(echo.HeaderContentDisposition))
+		s.Equal("id,sku,barcode,slug,brand_id,name,price,description,quantity,created_by,created_at,updated_by,updated_at\n"+
+			"1,LG-TV-42,,lg-tv,3,LG TV,5000000,\"Desc, 42 Inch\",,arya,2025-01-02T03:04:05Z,,2025-01-02T03:04:05Z\n"+
+			"2,,,,,OLED,,,,,2025-01-02T03:04:05Z,,2025-01-02T03:04:05Z\n", s.recorder.Body.String())
 	})
 
-	s.Run("Invalid ID (Parse Error)", func() {
+	s.Run("NDJSON", func() {
+		c := s.sendRequest(http.MethodGet, "/products/export?format=ndjson", "")
 
-		c := s.sendRequest(http.MethodGet, "/products/abc", "")
-		c.SetPath("/products/:id")
-		c.SetParamNames("id")
-		c.SetParamValues("abc")
+		s.mockUC.On("ExportProducts", mock.Anything, mock.Anything, mock.Anything).Run(stream).Return(nil).Once()
 
-		s.mockUC.On("GetProductByID", mock.Anything, int64(0)).Return(nil, constant.ErrNotFound).Once()
+		err := s.handler.ExportProducts(c)
 
-		err := s.handler.GetProductByID(c)
+		s.NoError(err)
+		s.Equal("application/x-ndjson", s.recorder.Header().Get(echo.HeaderContentType))
+
+		lines := strings.Split(strings.TrimSpace(s.recorder.Body.String()), "\n")
+		s.Len(lines, 2)
+		var first entity.Product
+		s.NoError(json.Unmarshal([]byte(lines[0]), &first))
+		s.Equal("LG TV", first.Name)
+	})
+
+	s.Run("XLSX", func() {
+		c := s.sendRequest(http.MethodGet, "/products/export?format=xlsx", "")
+
+		s.mockUC.On("ExportProducts", mock.Anything, mock.Anything, mock.Anything).Run(stream).Return(nil).Once()
+
+		err := s.handler.ExportProducts(c)
 
 		s.NoError(err)
-		s.Equal(http.StatusNotFound, s.recorder.Code)
+		s.Contains(s.recorder.Header().Get(echo.HeaderContentDisposition), ".xlsx")
+
+		book, err := excelize.OpenReader(s.recorder.Body)
+		s.Require().NoError(err)
+		rows, err := book.GetRows(book.GetSheetName(0))
+		s.NoError(err)
+		s.Len(rows, 3)
+		s.Equal([]string{"1", "LG-TV-42", "", "lg-tv", "3", "LG TV", "5000000"}, rows[1][:7])
 	})
 
-	s.Run("Not Found", func() {
-		c := s.sendRequest(http.MethodGet, "/products/999", "")
-		c.SetPath("/products/:id")
-		c.SetParamNames("id")
-		c.SetParamValues("999")
+	s.Run("Empty Export Still Has Header", func() {
+		c := s.sendRequest(http.MethodGet, "/products/export", "")
 
-		s.mockUC.On("GetProductByID", mock.Anything, int64(999)).Return(nil, constant.ErrNotFound).Once()
+		s.mockUC.On("ExportProducts", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 
-		err := s.handler.GetProductByID(c)
+		err := s.handler.ExportProducts(c)
 
 		s.NoError(err)
-		s.Equal(http.StatusNotFound, s.recorder.Code)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Equal("id,sku,barcode,slug,brand_id,name,price,description,quantity,created_by,created_at,updated_by,updated_at\n", s.recorder.Body.String())
 	})
 
-	s.Run("Usecase Error", func() {
-		c := s.sendRequest(http.MethodGet, "/products/1", "")
+	s.Run("Unknown Format", func() {
+		c := s.sendRequest(http.MethodGet, "/products/export?format=pdf", "")
+
+		err := s.handler.ExportProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Error Before First Row", func() {
+		c := s.sendRequest(http.MethodGet, "/products/export?sort=stock", "")
+
+		s.mockUC.On("ExportProducts", mock.Anything, mock.Anything, mock.Anything).
+			Return(fmt.Errorf("%w: unknown sort field", constant.ErrValidation)).Once()
+
+		err := s.handler.ExportProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Equal(echo.MIMEApplicationJSON, s.recorder.Header().Get(echo.HeaderContentType))
+	})
+
+	s.Run("Error After First Row", func() {
+		c := s.sendRequest(http.MethodGet, "/products/export", "")
+
+		s.mockUC.On("ExportProducts", mock.Anything, mock.Anything, mock.Anything).
+			Run(stream).Return(errors.New("connection reset")).Once()
+
+		err := s.handler.ExportProducts(c)
+
+		s.EqualError(err, "connection reset")
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+}
+
+func (s *ProductHandlerTestSuite) TestCurrency() {
+	s.Run("Detail Converted", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1?currency=USD", "")
+		c.Request().Header.Set("If-None-Match", `"2-0"`)
 		c.SetPath("/products/:id")
 		c.SetParamNames("id")
 		c.SetParamValues("1")
 
-		s.mockUC.On("GetProductByID", mock.Anything, int64(1)).Return(nil, errors.New("db error")).Once()
+		s.mockUC.On("GetProductByID", mock.Anything, int64(1)).Return(&entity.Product{ID: 1, Version: 2}, nil).Once()
+		s.mockRate.On("PriceProducts", mock.Anything, "USD", mock.MatchedBy(func(products []*entity.Product) bool {
+			return len(products) == 1 && products[0].ID == 1
+		})).Run(func(args mock.Arguments) {
+			args.Get(2).([]*entity.Product)[0].Pricing = &entity.PriceBreakdown{Currency: "USD", Net: 27027, Tax: 2973, Gross: 30000, VATRate: 11}
+		}).Return(nil).Once()
 
 		err := s.handler.GetProductByID(c)
 
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code, "A converted price is never answered with 304")
+		s.Contains(s.recorder.Body.String(), `"pricing":{"currency":"USD","net":27027,"tax":2973,"gross":30000,"vat_rate":11}`)
+	})
+
+	s.Run("List Converted", func() {
+		c := s.sendRequest(http.MethodGet, "/products?currency=SGD", "")
+
+		s.mockUC.On("ListProducts", mock.Anything, mock.Anything).
+			Return([]entity.Product{{ID: 1}, {ID: 2}}, response.StdPagination{Page: 1, Limit: 10}, nil).Once()
+		s.mockRate.On("PriceProducts", mock.Anything, "SGD", mock.MatchedBy(func(products []*entity.Product) bool {
+			return len(products) == 2
+		})).Return(nil).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Unknown Currency", func() {
+		c := s.sendRequest(http.MethodGet, "/products?currency=XYZ", "")
+
+		s.mockUC.On("ListProducts", mock.Anything, mock.Anything).
+			Return([]entity.Product{{ID: 1}}, response.StdPagination{Page: 1, Limit: 10}, nil).Once()
+		s.mockRate.On("PriceProducts", mock.Anything, "XYZ", mock.Anything).
+			Return(fmt.Errorf("%w: currency XYZ has no exchange rate", constant.ErrValidation)).Once()
+
+		err := s.handler.ListProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+}
+
+func (s *ProductHandlerTestSuite) TestGetProductByID() {
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1", "")
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		expected := &entity.Product{ID: 1, Name: "Test", Version: 2}
+		s.mockUC.On("GetProductByID", mock.Anything, int64(1)).Return(expected, nil).Once()
+
+		err := s.handler.GetProductByID(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Equal(`"2-0"`, s.recorder.Header().Get("ETag"))
+	})
+
+	s.Run("Not Modified", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1", "")
+		c.Request().Header.Set("If-None-Match", `"1-0", W/"2-0"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("GetProductByID", mock.Anything, int64(1)).Return(&entity.Product{ID: 1, Version: 2}, nil).Once()
+
+		err := s.handler.GetProductByID(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotModified, s.recorder.Code)
+		s.Empty(s.recorder.Body.String())
+	})
+
+	s.Run("Modified Since ETag", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1", "")
+		c.Request().Header.Set("If-None-Match", `"1"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("GetProductByID", mock.Anything, int64(1)).Return(&entity.Product{ID: 1, Version: 2}, nil).Once()
+
+		err := s.handler.GetProductByID(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Modified Since Stock Moved", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1", "")
+		c.Request().Header.Set("If-None-Match", `"2-0"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("GetProductByID", mock.Anything, int64(1)).Return(&entity.Product{ID: 1, Version: 2, StockVersion: 1}, nil).Once()
+
+		err := s.handler.GetProductByID(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Equal(`"2-1"`, s.recorder.Header().Get("ETag"))
+	})
+
+	s.Run("Invalid ID (Parse Error)", func() {
+
+		c := s.sendRequest(http.MethodGet, "/products/abc", "")
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("abc")
+
+		s.mockUC.On("GetProductByID", mock.Anything, int64(0)).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.GetProductByID(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodGet, "/products/999", "")
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("999")
+
+		s.mockUC.On("GetProductByID", mock.Anything, int64(999)).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.GetProductByID(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+
+	s.Run("Usecase Error", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1", "")
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("GetProductByID", mock.Anything, int64(1)).Return(nil, errors.New("db error")).Once()
+
+		err := s.handler.GetProductByID(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func (s *ProductHandlerTestSuite) TestGetProductBySKU() {
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/products/sku/LG-TV-42", "")
+		c.SetPath("/products/sku/:sku")
+		c.SetParamNames("sku")
+		c.SetParamValues("LG-TV-42")
+
+		sku := "LG-TV-42"
+		s.mockUC.On("GetProductBySKU", mock.Anything, "LG-TV-42").Return(&entity.Product{ID: 1, SKU: &sku, Version: 3}, nil).Once()
+
+		err := s.handler.GetProductBySKU(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Equal(`"3-0"`, s.recorder.Header().Get("ETag"))
+		s.Contains(s.recorder.Body.String(), `"sku":"LG-TV-42"`)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodGet, "/products/sku/missing", "")
+		c.SetPath("/products/sku/:sku")
+		c.SetParamNames("sku")
+		c.SetParamValues("missing")
+
+		s.mockUC.On("GetProductBySKU", mock.Anything, "missing").Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.GetProductBySKU(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *ProductHandlerTestSuite) TestGetProductBySlug() {
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/products/slug/lg-tv", "")
+		c.SetPath("/products/slug/:slug")
+		c.SetParamNames("slug")
+		c.SetParamValues("lg-tv")
+
+		s.mockUC.On("GetProductBySlug", mock.Anything, "lg-tv").Return(&entity.Product{ID: 1, Slug: "lg-tv"}, nil).Once()
+
+		err := s.handler.GetProductBySlug(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Internal Server Error", func() {
+		c := s.sendRequest(http.MethodGet, "/products/slug/lg-tv", "")
+		c.SetPath("/products/slug/:slug")
+		c.SetParamNames("slug")
+		c.SetParamValues("lg-tv")
+
+		s.mockUC.On("GetProductBySlug", mock.Anything, "lg-tv").Return(nil, errors.New("db error")).Once()
+
+		err := s.handler.GetProductBySlug(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func (s *ProductHandlerTestSuite) TestUpdateProduct() {
+	reqJSON := `{"name":"LG TV 42 Inch","price":5500000,"description":"LG TV 42 Inch Full HD","quantity":8,"updated_by":"arya"}`
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1", reqJSON)
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("UpdateProduct", mock.Anything, int64(1), int64(3), mock.MatchedBy(func(p *request.ProductUpdate) bool {
+			return p.Name == "LG TV 42 Inch" && p.UpdatedBy == "arya"
+		})).Return(&entity.Product{ID: 1, Name: "LG TV 42 Inch", Version: 4}, nil).Once()
+
+		err := s.handler.UpdateProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Equal(`"4-0"`, s.recorder.Header().Get("ETag"))
+	})
+
+	s.Run("Missing If-Match", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1", reqJSON)
+		c.Request().Header.Del("If-Match")
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.UpdateProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusPreconditionRequired, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), response.CodePreconditionRequired)
+	})
+
+	s.Run("Invalid If-Match", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1", reqJSON)
+		c.Request().Header.Set("If-Match", "not-an-etag")
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.UpdateProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusPreconditionFailed, s.recorder.Code)
+	})
+
+	s.Run("Stale Version", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1", reqJSON)
+		c.Request().Header.Set("If-Match", `W/"2"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("UpdateProduct", mock.Anything, int64(1), int64(2), mock.Anything).Return(nil, constant.ErrVersionMismatch).Once()
+
+		err := s.handler.UpdateProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusPreconditionFailed, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), response.CodePreconditionFailed)
+	})
+
+	s.Run("Wildcard If-Match", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1", reqJSON)
+		c.Request().Header.Set("If-Match", "*")
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("UpdateProduct", mock.Anything, int64(1), int64(0), mock.Anything).Return(&entity.Product{ID: 1, Version: 5}, nil).Once()
+
+		err := s.handler.UpdateProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Bind Error", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1", "invalid-json")
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.UpdateProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1", `{"name":"LG TV"}`)
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.UpdateProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodPut, "/products/999", reqJSON)
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("999")
+
+		s.mockUC.On("UpdateProduct", mock.Anything, int64(999), int64(3), mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.UpdateProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+
+	s.Run("Usecase Error", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1", reqJSON)
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("UpdateProduct", mock.Anything, int64(1), int64(3), mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		err := s.handler.UpdateProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func (s *ProductHandlerTestSuite) TestPatchProduct() {
+	patchJSON := `{"name":"LG OLED","updated_by":"arya"}`
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPatch, "/products/1", patchJSON)
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("PatchProduct", mock.Anything, int64(1), int64(3), map[string]interface{}{"name": "LG OLED", "updated_by": "arya"}).
+			Return(&entity.Product{ID: 1, Name: "LG OLED"}, nil).Once()
+
+		err := s.handler.PatchProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Missing If-Match", func() {
+		c := s.sendRequest(http.MethodPatch, "/products/1", patchJSON)
+		c.Request().Header.Del("If-Match")
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.PatchProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusPreconditionRequired, s.recorder.Code)
+	})
+
+	s.Run("Invalid JSON", func() {
+		c := s.sendRequest(http.MethodPatch, "/products/1", "invalid-json")
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.PatchProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Null Document", func() {
+		c := s.sendRequest(http.MethodPatch, "/products/1", "null")
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.PatchProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPatch, "/products/1", `{"name":"LG OLED"}`)
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		validationErr := utils.NewValidator().Validate(request.ProductUpdate{})
+		s.mockUC.On("PatchProduct", mock.Anything, int64(1), int64(3), mock.Anything).Return(nil, validationErr).Once()
+
+		err := s.handler.PatchProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Invalid Type", func() {
+		c := s.sendRequest(http.MethodPatch, "/products/1", `{"price":"abc","updated_by":"arya"}`)
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("PatchProduct", mock.Anything, int64(1), int64(3), mock.Anything).Return(nil, constant.ErrValidation).Once()
+
+		err := s.handler.PatchProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Usecase Error", func() {
+		c := s.sendRequest(http.MethodPatch, "/products/1", patchJSON)
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("PatchProduct", mock.Anything, int64(1), int64(3), mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		err := s.handler.PatchProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func (s *ProductHandlerTestSuite) TestDeleteProduct() {
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodDelete, "/products/1?deleted_by=arya", "")
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("DeleteProduct", mock.Anything, int64(1), int64(3), &request.ProductDelete{DeletedBy: "arya"}).Return(nil).Once()
+
+		err := s.handler.DeleteProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Missing If-Match", func() {
+		c := s.sendRequest(http.MethodDelete, "/products/1?deleted_by=arya", "")
+		c.Request().Header.Del("If-Match")
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.DeleteProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusPreconditionRequired, s.recorder.Code)
+	})
+
+	s.Run("Bind Error", func() {
+		c := s.sendRequest(http.MethodDelete, "/products/1", "invalid-json")
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.DeleteProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodDelete, "/products/1", "")
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.DeleteProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodDelete, "/products/999?deleted_by=arya", "")
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("999")
+
+		s.mockUC.On("DeleteProduct", mock.Anything, int64(999), int64(3), mock.Anything).Return(constant.ErrNotFound).Once()
+
+		err := s.handler.DeleteProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+
+	s.Run("Usecase Error", func() {
+		c := s.sendRequest(http.MethodDelete, "/products/1?deleted_by=arya", "")
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("DeleteProduct", mock.Anything, int64(1), int64(3), mock.Anything).Return(errors.New("db error")).Once()
+
+		err := s.handler.DeleteProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func (s *ProductHandlerTestSuite) TestRestoreProduct() {
+	reqJSON := `{"updated_by":"arya"}`
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/restore", reqJSON)
+		c.SetPath("/products/:id/restore")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+		c.Request().Header.Set("If-Match", `"4-1"`)
+
+		s.mockUC.On("RestoreProduct", mock.Anything, int64(1), int64(4), &request.ProductRestore{UpdatedBy: "arya"}).
+			Return(&entity.Product{ID: 1, Name: "LG TV", Version: 5, StockVersion: 1}, nil).Once()
+
+		err := s.handler.RestoreProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Equal(`"5-1"`, s.recorder.Header().Get("ETag"))
+	})
+
+	s.Run("Missing If-Match", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/restore", reqJSON)
+		c.SetPath("/products/:id/restore")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.RestoreProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusPreconditionRequired, s.recorder.Code)
+	})
+
+	s.Run("Stale If-Match", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/restore", reqJSON)
+		c.SetPath("/products/:id/restore")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+		c.Request().Header.Set("If-Match", `"3-0"`)
+
+		s.mockUC.On("RestoreProduct", mock.Anything, int64(1), int64(3), mock.Anything).Return(nil, constant.ErrVersionMismatch).Once()
+
+		err := s.handler.RestoreProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusPreconditionFailed, s.recorder.Code)
+	})
+
+	s.Run("Bind Error", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/restore", "invalid-json")
+		c.SetPath("/products/:id/restore")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.RestoreProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/restore", `{}`)
+		c.SetPath("/products/:id/restore")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.RestoreProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodPost, "/products/999/restore", reqJSON)
+		c.SetPath("/products/:id/restore")
+		c.SetParamNames("id")
+		c.SetParamValues("999")
+		c.Request().Header.Set("If-Match", "*")
+
+		s.mockUC.On("RestoreProduct", mock.Anything, int64(999), int64(0), mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.RestoreProduct(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *ProductHandlerTestSuite) TestTransitionProductStatus() {
+	reqJSON := `{"status":"archived","updated_by":"arya"}`
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/status", reqJSON)
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id/status")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("TransitionProductStatus", mock.Anything, int64(1), int64(3), &request.ProductStatus{Status: "archived", UpdatedBy: "arya"}).
+			Return(&entity.Product{ID: 1, Status: entity.ProductArchived, Version: 4}, nil).Once()
+
+		err := s.handler.TransitionProductStatus(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Equal(`"4-0"`, s.recorder.Header().Get("ETag"))
+		s.Contains(s.recorder.Body.String(), `"status":"archived"`)
+	})
+
+	s.Run("Missing If-Match", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/status", reqJSON)
+		c.SetPath("/products/:id/status")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.TransitionProductStatus(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusPreconditionRequired, s.recorder.Code)
+	})
+
+	s.Run("Unknown Status", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/status", `{"status":"sold_out","updated_by":"arya"}`)
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id/status")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		err := s.handler.TransitionProductStatus(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Transition Not Allowed", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/status", reqJSON)
+		c.Request().Header.Set("If-Match", `"3"`)
+		c.SetPath("/products/:id/status")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("TransitionProductStatus", mock.Anything, int64(1), int64(3), mock.Anything).
+			Return(nil, fmt.Errorf("%w: a draft product cannot become discontinued", constant.ErrConflict)).Once()
+
+		err := s.handler.TransitionProductStatus(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+}
+
+func (s *ProductHandlerTestSuite) TestListProductHistory() {
+
+	s.Run("Success Caps Limit", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/history?page=2&limit=500", "")
+		c.SetPath("/products/:id/history")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		events := []entity.AuditEvent{{ID: 7, EntityType: entity.AuditProduct, EntityID: 1, Action: entity.AuditUpdate, Actor: "arya", RequestID: "req-1",
+			Before: entity.AuditValues{"price": float64(5000000)}, After: entity.AuditValues{"price": float64(4500000)}}}
+		s.mockUC.On("ListProductHistory", mock.Anything, int64(1), 2, 100).Return(events, response.StandardPagination(2, 100, 101), nil).Once()
+
+		err := s.handler.ListProductHistory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"before":{"price":5000000},"after":{"price":4500000}`)
+		s.Contains(s.recorder.Body.String(), `"request_id":"req-1"`)
+	})
+
+	s.Run("Defaults", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/history", "")
+		c.SetPath("/products/:id/history")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("ListProductHistory", mock.Anything, int64(1), 1, 10).Return([]entity.AuditEvent{}, response.StdPagination{}, nil).Once()
+
+		err := s.handler.ListProductHistory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Usecase Error", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/history", "")
+		c.SetPath("/products/:id/history")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		s.mockUC.On("ListProductHistory", mock.Anything, int64(1), 1, 10).Return(nil, response.StdPagination{}, errors.New("db error")).Once()
+
+		err := s.handler.ListProductHistory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func (s *ProductHandlerTestSuite) TestSuggestProducts() {
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/products/suggest?q=televsion&limit=3", "")
+
+		s.mockUC.On("SuggestProducts", mock.Anything, "televsion", 3).
+			Return([]entity.ProductSuggestion{{Name: "LG Television", Score: 0.6}}, nil).Once()
+
+		err := s.handler.SuggestProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"name":"LG Television"`)
+	})
+
+	s.Run("Missing Term", func() {
+		c := s.sendRequest(http.MethodGet, "/products/suggest", "")
+
+		s.mockUC.On("SuggestProducts", mock.Anything, "", 0).
+			Return(nil, constant.ErrValidation).Once()
+
+		err := s.handler.SuggestProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+}
+
+func (s *ProductHandlerTestSuite) TestAutocompleteProducts() {
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/products/autocomplete?q=lg&limit=5", "")
+
+		s.mockUC.On("AutocompleteProducts", mock.Anything, "lg", 5).
+			Return([]entity.ProductAutocomplete{{ID: 1, Name: "LG TV"}}, nil).Once()
+
+		err := s.handler.AutocompleteProducts(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `{"id":1,"name":"LG TV"}`)
+	})
+
+	s.Run("Index Unavailable", func() {
+		c := s.sendRequest(http.MethodGet, "/products/autocomplete?q=lg", "")
+
+		s.mockUC.On("AutocompleteProducts", mock.Anything, "lg", 0).
+			Return(nil, errors.New("redis down")).Once()
+
+		err := s.handler.AutocompleteProducts(c)
+
 		s.NoError(err)
 		s.Equal(http.StatusInternalServerError, s.recorder.Code)
 	})
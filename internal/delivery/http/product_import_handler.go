@@ -0,0 +1,139 @@
+package http
+
+import (
+	"encoding/csv"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxImportFileSize bounds an upload, which is held in the database until the
+// import worker has processed it.
+const maxImportFileSize = 10 << 20
+
+type ProductImportHandler struct {
+	usecase  interfaces.ProductImportUsecase
+	response *response.StdResponse
+}
+
+func NewImportHandler(importUsecase interfaces.ProductImportUsecase, standardResponse *response.StdResponse) *ProductImportHandler {
+	return &ProductImportHandler{
+		usecase:  importUsecase,
+		response: standardResponse,
+	}
+}
+
+// CreateImport godoc
+// @Summary Import products from a file
+// @Description Upload a CSV or XLSX file with the columns name, price, description, quantity and optionally created_by. The file is processed in the background; poll the returned import for progress.
+// @Tags imports
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "CSV or XLSX file, at most 10 MB"
+// @Param created_by formData string true "Uploader, used for rows without created_by"
+// @Success 202 {object} response.ApiResponse{data=entity.ProductImport}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/imports [post]
+func (h *ProductImportHandler) CreateImport(c echo.Context) error {
+	var req request.ProductImport
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	file, err := c.FormFile("file")
+	if err != nil {
+		return errorResponse(c, h.response, fmt.Errorf("%w: file is required", constant.ErrValidation))
+	}
+	if file.Size > maxImportFileSize {
+		return errorResponse(c, h.response, fmt.Errorf("%w: file must be at most %d MB", constant.ErrValidation, maxImportFileSize>>20))
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+	defer src.Close()
+
+	req.FileName = file.Filename
+	req.Content, err = io.ReadAll(src)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	job, err := h.usecase.CreateImport(ctx, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.ImportAccepted, job, "PRD-ERA-202"))
+}
+
+// GetImport godoc
+// @Summary Get import progress
+// @Description Get the status and row counters of an import
+// @Tags imports
+// @Produce json
+// @Param id path int true "Import ID"
+// @Success 200 {object} response.ApiResponse{data=entity.ProductImport}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/imports/{id} [get]
+func (h *ProductImportHandler) GetImport(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	job, err := h.usecase.GetImport(ctx, id)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, job, "PRD-ERA-200"))
+}
+
+// GetImportErrors godoc
+// @Summary Download the error report of an import
+// @Description CSV with one line per problem of a rejected row, rows numbered as in the uploaded file
+// @Tags imports
+// @Produce text/csv
+// @Param id path int true "Import ID"
+// @Success 200 {file} file
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/imports/{id}/errors [get]
+func (h *ProductImportHandler) GetImportErrors(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	job, err := h.usecase.GetImport(ctx, id)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv")
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="import-%d-errors.csv"`, job.ID))
+	c.Response().WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(c.Response())
+	_ = writer.Write([]string{"row", "error"})
+	for _, rowError := range job.Errors {
+		for _, message := range rowError.Errors {
+			_ = writer.Write([]string{strconv.Itoa(rowError.Row), message})
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,200 @@
+package http_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"erajaya-test/app"
+	productHttp "erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductImportHandlerTestSuite struct {
+	suite.Suite
+	echo     *echo.Echo
+	mockUC   *mocks.ProductImportUsecase
+	handler  *productHttp.ProductImportHandler
+	recorder *httptest.ResponseRecorder
+}
+
+func (s *ProductImportHandlerTestSuite) SetupTest() {
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: utils.NewValidator().Validator}
+
+	s.mockUC = new(mocks.ProductImportUsecase)
+
+	logger := app.InitZapLogger()
+	resp := response.NewStdResponse(logger)
+	s.handler = productHttp.NewImportHandler(s.mockUC, resp)
+
+	s.recorder = httptest.NewRecorder()
+}
+
+func (s *ProductImportHandlerTestSuite) sendUpload(createdBy, fileName, content string) echo.Context {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	if createdBy != "" {
+		s.Require().NoError(writer.WriteField("created_by", createdBy))
+	}
+	if fileName != "" {
+		part, err := writer.CreateFormFile("file", fileName)
+		s.Require().NoError(err)
+		_, err = part.Write([]byte(content))
+		s.Require().NoError(err)
+	}
+	s.Require().NoError(writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/products/imports", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+
+	s.recorder = httptest.NewRecorder()
+	return s.echo.NewContext(req, s.recorder)
+}
+
+func (s *ProductImportHandlerTestSuite) sendGet(path string, id int64) echo.Context {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+
+	s.recorder = httptest.NewRecorder()
+	c := s.echo.NewContext(req, s.recorder)
+	c.SetParamNames("id")
+	c.SetParamValues(fmt.Sprint(id))
+	return c
+}
+
+func (s *ProductImportHandlerTestSuite) TestCreateImport() {
+
+	s.Run("Accepted", func() {
+		c := s.sendUpload("arya", "catalog.csv", "name,price,description,quantity\n")
+
+		s.mockUC.On("CreateImport", mock.Anything, mock.MatchedBy(func(r *request.ProductImport) bool {
+			return r.CreatedBy == "arya" && r.FileName == "catalog.csv" && string(r.Content) == "name,price,description,quantity\n"
+		})).Return(&entity.ProductImport{ID: 1, Status: entity.ImportStatusPending}, nil).Once()
+
+		err := s.handler.CreateImport(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusAccepted, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"status":"pending"`)
+	})
+
+	s.Run("Validation Error - Missing Created By", func() {
+		c := s.sendUpload("", "catalog.csv", "name")
+
+		err := s.handler.CreateImport(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Validation Error - Missing File", func() {
+		c := s.sendUpload("arya", "", "")
+
+		err := s.handler.CreateImport(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "file is required")
+	})
+
+	s.Run("Usecase Validation Error", func() {
+		c := s.sendUpload("arya", "catalog.pdf", "data")
+
+		s.mockUC.On("CreateImport", mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: file must be a .csv or .xlsx", constant.ErrValidation)).Once()
+
+		err := s.handler.CreateImport(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Internal Server Error", func() {
+		c := s.sendUpload("arya", "catalog.csv", "data")
+
+		s.mockUC.On("CreateImport", mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		err := s.handler.CreateImport(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func (s *ProductImportHandlerTestSuite) TestGetImport() {
+
+	s.Run("Success", func() {
+		c := s.sendGet("/imports/1", 1)
+
+		s.mockUC.On("GetImport", mock.Anything, int64(1)).
+			Return(&entity.ProductImport{ID: 1, Status: entity.ImportStatusProcessing, TotalRows: 10, ProcessedRows: 5}, nil).Once()
+
+		err := s.handler.GetImport(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"processed_rows":5`)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendGet("/imports/9", 9)
+
+		s.mockUC.On("GetImport", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.GetImport(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *ProductImportHandlerTestSuite) TestGetImportErrors() {
+
+	s.Run("Report", func() {
+		c := s.sendGet("/imports/1/errors", 1)
+
+		s.mockUC.On("GetImport", mock.Anything, int64(1)).Return(&entity.ProductImport{
+			ID: 1,
+			Errors: entity.ImportRowErrors{
+				{Row: 3, Errors: []string{"price must be an integer", "quantity must be an integer"}},
+				{Row: 7, Errors: []string{"name is required"}},
+			},
+		}, nil).Once()
+
+		err := s.handler.GetImportErrors(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Equal("text/csv", s.recorder.Header().Get(echo.HeaderContentType))
+		s.Equal("row,error\n3,price must be an integer\n3,quantity must be an integer\n7,name is required\n", s.recorder.Body.String())
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendGet("/imports/9/errors", 9)
+
+		s.mockUC.On("GetImport", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.GetImportErrors(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func TestProductImportHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ProductImportHandlerTestSuite))
+}
@@ -0,0 +1,192 @@
+package http
+
+import (
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProductMediaHandler struct {
+	usecase  interfaces.ProductMediaUsecase
+	response *response.StdResponse
+}
+
+func NewProductMediaHandler(mediaUsecase interfaces.ProductMediaUsecase, standardResponse *response.StdResponse) *ProductMediaHandler {
+	return &ProductMediaHandler{
+		usecase:  mediaUsecase,
+		response: standardResponse,
+	}
+}
+
+// UploadMedia godoc
+// @Summary Upload a product image
+// @Description Upload a JPEG, PNG or WebP image of a product (at most media.max_size_mb, 5 MB by default). The type is read from the file itself. A thumbnail is generated, and the image goes after the other images of the product. The first image of a product, or one uploaded with is_primary, is its primary image.
+// @Tags media
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param file formData file true "JPEG, PNG or WebP image"
+// @Param is_primary formData bool false "Make the image the primary image"
+// @Param created_by formData string true "Uploader"
+// @Success 201 {object} response.ApiResponse{data=entity.ProductMedia}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/media [post]
+func (h *ProductMediaHandler) UploadMedia(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.ProductMediaUpload
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	file, err := c.FormFile("file")
+	if err != nil {
+		return errorResponse(c, h.response, fmt.Errorf("%w: file is required", constant.ErrValidation))
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+	defer src.Close()
+
+	req.FileName = file.Filename
+	req.Content, err = io.ReadAll(src)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	media, err := h.usecase.UploadMedia(ctx, productID, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.InsertSuccess, media, "PRD-ERA-201"))
+}
+
+// ListMedia godoc
+// @Summary List the images of a product
+// @Description Get the images of a product in their order, with the URLs of the image and its thumbnail
+// @Tags media
+// @Produce json
+// @Param id path int true "Product ID"
+// @Success 200 {object} response.ApiResponse{data=[]entity.ProductMedia}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/media [get]
+func (h *ProductMediaHandler) ListMedia(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	media, err := h.usecase.ListMedia(ctx, productID)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, media, "PRD-ERA-200"))
+}
+
+// ReorderMedia godoc
+// @Summary Reorder the images of a product
+// @Description Put the images of a product in the order of media_ids, which lists every image of the product once
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param order body request.ProductMediaOrder true "New order"
+// @Success 200 {object} response.ApiResponse{data=[]entity.ProductMedia}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/media/order [put]
+func (h *ProductMediaHandler) ReorderMedia(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.ProductMediaOrder
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	media, err := h.usecase.ReorderMedia(ctx, productID, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, media, "PRD-ERA-200"))
+}
+
+// SetPrimaryMedia godoc
+// @Summary Set the primary image of a product
+// @Description Make an image the primary image of its product in place of the current one
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param media_id path int true "Media ID"
+// @Param media body request.ProductMediaPrimary true "Updater"
+// @Success 200 {object} response.ApiResponse{data=entity.ProductMedia}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/media/{media_id}/primary [post]
+func (h *ProductMediaHandler) SetPrimaryMedia(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	id, _ := strconv.ParseInt(c.Param("media_id"), 10, 64)
+
+	var req request.ProductMediaPrimary
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	media, err := h.usecase.SetPrimaryMedia(ctx, productID, id, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, media, "PRD-ERA-200"))
+}
+
+// DeleteMedia godoc
+// @Summary Delete a product image
+// @Description Delete an image of a product with its files. The images after it move up, and when it was the primary image the first image left takes over.
+// @Tags media
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param media_id path int true "Media ID"
+// @Success 200 {object} response.ApiResponse
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/media/{media_id} [delete]
+func (h *ProductMediaHandler) DeleteMedia(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	id, _ := strconv.ParseInt(c.Param("media_id"), 10, 64)
+
+	ctx := c.Request().Context()
+	if err := h.usecase.DeleteMedia(ctx, productID, id); err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.DeleteSuccess, nil, "PRD-ERA-200"))
+}
@@ -0,0 +1,269 @@
+package http_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"erajaya-test/app"
+	productHttp "erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductMediaHandlerTestSuite struct {
+	suite.Suite
+	echo     *echo.Echo
+	mockUC   *mocks.ProductMediaUsecase
+	handler  *productHttp.ProductMediaHandler
+	recorder *httptest.ResponseRecorder
+}
+
+func (s *ProductMediaHandlerTestSuite) SetupTest() {
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: utils.NewValidator().Validator}
+
+	s.mockUC = new(mocks.ProductMediaUsecase)
+
+	logger := app.InitZapLogger()
+	resp := response.NewStdResponse(logger)
+	s.handler = productHttp.NewProductMediaHandler(s.mockUC, resp)
+
+	s.recorder = httptest.NewRecorder()
+}
+
+func (s *ProductMediaHandlerTestSuite) sendRequest(method, path, body string, productID, mediaID int64) echo.Context {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+
+	return s.newContext(req, productID, mediaID)
+}
+
+// sendUpload posts a multipart form with the fields and, when content is not
+// nil, a file part named "file".
+func (s *ProductMediaHandlerTestSuite) sendUpload(fields map[string]string, content []byte, productID int64) echo.Context {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for name, value := range fields {
+		_ = writer.WriteField(name, value)
+	}
+	if content != nil {
+		part, _ := writer.CreateFormFile("file", "front.png")
+		_, _ = part.Write(content)
+	}
+	_ = writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/products/%d/media", productID), &body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	return s.newContext(req, productID, 0)
+}
+
+func (s *ProductMediaHandlerTestSuite) newContext(req *http.Request, productID, mediaID int64) echo.Context {
+	s.recorder = httptest.NewRecorder()
+	c := s.echo.NewContext(req, s.recorder)
+	c.SetParamNames("id", "media_id")
+	c.SetParamValues(fmt.Sprint(productID), fmt.Sprint(mediaID))
+	return c
+}
+
+func (s *ProductMediaHandlerTestSuite) TestUploadMedia() {
+
+	s.Run("Success", func() {
+		c := s.sendUpload(map[string]string{"created_by": "arya", "is_primary": "true"}, []byte("image bytes"), 1)
+
+		s.mockUC.On("UploadMedia", mock.Anything, int64(1), mock.MatchedBy(func(r *request.ProductMediaUpload) bool {
+			return r.CreatedBy == "arya" && r.IsPrimary && r.FileName == "front.png" && string(r.Content) == "image bytes"
+		})).Return(&entity.ProductMedia{ID: 5, ProductID: 1, StorageKey: "products/1/a.png", URL: "http://cdn/products/1/a.png", IsPrimary: true}, nil).Once()
+
+		err := s.handler.UploadMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusCreated, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"url":"http://cdn/products/1/a.png"`)
+		s.NotContains(s.recorder.Body.String(), "storage_key")
+	})
+
+	s.Run("Missing File", func() {
+		c := s.sendUpload(map[string]string{"created_by": "arya"}, nil, 1)
+
+		err := s.handler.UploadMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "file is required")
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendUpload(nil, []byte("image bytes"), 1)
+
+		err := s.handler.UploadMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Unsupported File", func() {
+		c := s.sendUpload(map[string]string{"created_by": "arya"}, []byte("%PDF-1.4"), 1)
+
+		s.mockUC.On("UploadMedia", mock.Anything, int64(1), mock.MatchedBy(func(r *request.ProductMediaUpload) bool {
+			return string(r.Content) == "%PDF-1.4"
+		})).Return(nil, fmt.Errorf("%w: file must be a JPEG, PNG or WebP image", constant.ErrValidation)).Once()
+
+		err := s.handler.UploadMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "file must be a JPEG, PNG or WebP image")
+	})
+
+	s.Run("Product Not Found", func() {
+		c := s.sendUpload(map[string]string{"created_by": "arya"}, []byte("image bytes"), 9)
+
+		s.mockUC.On("UploadMedia", mock.Anything, int64(9), mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.UploadMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *ProductMediaHandlerTestSuite) TestListMedia() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/media", "", 1, 0)
+
+		s.mockUC.On("ListMedia", mock.Anything, int64(1)).
+			Return([]entity.ProductMedia{{ID: 5, Position: 1, IsPrimary: true}, {ID: 6, Position: 2}}, nil).Once()
+
+		err := s.handler.ListMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"id":6`)
+	})
+
+	s.Run("Error", func() {
+		c := s.sendRequest(http.MethodGet, "/products/2/media", "", 2, 0)
+
+		s.mockUC.On("ListMedia", mock.Anything, int64(2)).Return(nil, errors.New("db error")).Once()
+
+		err := s.handler.ListMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func (s *ProductMediaHandlerTestSuite) TestReorderMedia() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1/media/order", `{"media_ids":[6,5],"updated_by":"arya"}`, 1, 0)
+
+		s.mockUC.On("ReorderMedia", mock.Anything, int64(1), mock.MatchedBy(func(r *request.ProductMediaOrder) bool {
+			return len(r.MediaIDs) == 2 && r.MediaIDs[0] == 6 && r.UpdatedBy == "arya"
+		})).Return([]entity.ProductMedia{{ID: 6, Position: 1}, {ID: 5, Position: 2}}, nil).Once()
+
+		err := s.handler.ReorderMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1/media/order", `{"media_ids":[],"updated_by":"arya"}`, 1, 0)
+
+		err := s.handler.ReorderMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Incomplete Order", func() {
+		c := s.sendRequest(http.MethodPut, "/products/2/media/order", `{"media_ids":[6],"updated_by":"arya"}`, 2, 0)
+
+		s.mockUC.On("ReorderMedia", mock.Anything, int64(2), mock.Anything).
+			Return(nil, fmt.Errorf("%w: media_ids must list each of the 2 images of the product once", constant.ErrValidation)).Once()
+
+		err := s.handler.ReorderMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+}
+
+func (s *ProductMediaHandlerTestSuite) TestSetPrimaryMedia() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/media/6/primary", `{"updated_by":"arya"}`, 1, 6)
+
+		s.mockUC.On("SetPrimaryMedia", mock.Anything, int64(1), int64(6), mock.MatchedBy(func(r *request.ProductMediaPrimary) bool {
+			return r.UpdatedBy == "arya"
+		})).Return(&entity.ProductMedia{ID: 6, IsPrimary: true}, nil).Once()
+
+		err := s.handler.SetPrimaryMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"is_primary":true`)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/media/9/primary", `{"updated_by":"arya"}`, 1, 9)
+
+		s.mockUC.On("SetPrimaryMedia", mock.Anything, int64(1), int64(9), mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.SetPrimaryMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *ProductMediaHandlerTestSuite) TestDeleteMedia() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodDelete, "/products/1/media/5", "", 1, 5)
+
+		s.mockUC.On("DeleteMedia", mock.Anything, int64(1), int64(5)).Return(nil).Once()
+
+		err := s.handler.DeleteMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodDelete, "/products/1/media/9", "", 1, 9)
+
+		s.mockUC.On("DeleteMedia", mock.Anything, int64(1), int64(9)).Return(constant.ErrNotFound).Once()
+
+		err := s.handler.DeleteMedia(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func TestProductMediaHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ProductMediaHandlerTestSuite))
+}
@@ -0,0 +1,95 @@
+package http
+
+import (
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/response"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxPriceHistoryLimit bounds the page size of the price history.
+const maxPriceHistoryLimit = 100
+
+type ProductPriceHandler struct {
+	usecase  interfaces.ProductPriceUsecase
+	response *response.StdResponse
+}
+
+func NewProductPriceHandler(priceUsecase interfaces.ProductPriceUsecase, standardResponse *response.StdResponse) *ProductPriceHandler {
+	return &ProductPriceHandler{
+		usecase:  priceUsecase,
+		response: standardResponse,
+	}
+}
+
+// SchedulePrice godoc
+// @Summary Schedule a price change
+// @Description Schedule the price a product takes on at valid_from, which has to be in the future. The price scheduler applies it once it is due. A promotion is two scheduled prices: the promotional price and the regular price it ends with.
+// @Tags prices
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param price body request.ProductPriceSchedule true "Scheduled price"
+// @Success 201 {object} response.ApiResponse{data=entity.ProductPrice}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/prices [post]
+func (h *ProductPriceHandler) SchedulePrice(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.ProductPriceSchedule
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	price, err := h.usecase.SchedulePrice(ctx, productID, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.InsertSuccess, price, "PRD-ERA-201"))
+}
+
+// ListPriceHistory godoc
+// @Summary List the price history of a product
+// @Description Get the prices of a product, latest valid_from first: scheduled prices, the current price and the past prices with the time they ended
+// @Tags prices
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page (max 100)"
+// @Success 200 {object} response.ApiResponse{data=[]entity.ProductPrice,metadata=response.StdPagination}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/price-history [get]
+func (h *ProductPriceHandler) ListPriceHistory(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, maxPriceHistoryLimit)
+
+	ctx := c.Request().Context()
+	prices, metadata, err := h.usecase.ListPriceHistory(ctx, productID, page, limit)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, map[string]interface{}{
+		"data":     prices,
+		"metadata": metadata,
+	}, "PRD-ERA-200"))
+}
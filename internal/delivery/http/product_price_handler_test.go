@@ -0,0 +1,161 @@
+package http_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"erajaya-test/app"
+	productHttp "erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductPriceHandlerTestSuite struct {
+	suite.Suite
+	echo     *echo.Echo
+	mockUC   *mocks.ProductPriceUsecase
+	handler  *productHttp.ProductPriceHandler
+	recorder *httptest.ResponseRecorder
+}
+
+func (s *ProductPriceHandlerTestSuite) SetupTest() {
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: utils.NewValidator().Validator}
+
+	s.mockUC = new(mocks.ProductPriceUsecase)
+
+	logger := app.InitZapLogger()
+	resp := response.NewStdResponse(logger)
+	s.handler = productHttp.NewProductPriceHandler(s.mockUC, resp)
+
+	s.recorder = httptest.NewRecorder()
+}
+
+func (s *ProductPriceHandlerTestSuite) sendRequest(method, path, body string, productID int64) echo.Context {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+
+	s.recorder = httptest.NewRecorder()
+	c := s.echo.NewContext(req, s.recorder)
+	c.SetParamNames("id")
+	c.SetParamValues(fmt.Sprint(productID))
+	return c
+}
+
+func (s *ProductPriceHandlerTestSuite) TestSchedulePrice() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/prices", `{"price":4500000,"valid_from":"2030-01-01T00:00:00Z","created_by":"arya"}`, 1)
+
+		s.mockUC.On("SchedulePrice", mock.Anything, int64(1), mock.MatchedBy(func(r *request.ProductPriceSchedule) bool {
+			return *r.Price == 4500000 && r.ValidFrom.Year() == 2030 && r.CreatedBy == "arya"
+		})).Return(&entity.ProductPrice{ID: 9, ProductID: 1, Price: 4500000, Status: entity.PriceScheduled}, nil).Once()
+
+		err := s.handler.SchedulePrice(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusCreated, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"status":"scheduled"`)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/prices", `{"price":-1,"valid_from":"2030-01-01T00:00:00Z","created_by":"arya"}`, 1)
+
+		err := s.handler.SchedulePrice(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Bind Error", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/prices", `{"valid_from":"tomorrow"}`, 1)
+
+		err := s.handler.SchedulePrice(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Valid From In The Past", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/prices", `{"price":4500000,"valid_from":"2020-01-01T00:00:00Z","created_by":"arya"}`, 1)
+
+		s.mockUC.On("SchedulePrice", mock.Anything, int64(1), mock.Anything).
+			Return(nil, fmt.Errorf("%w: valid_from must be in the future", constant.ErrValidation)).Once()
+
+		err := s.handler.SchedulePrice(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Product Not Found", func() {
+		c := s.sendRequest(http.MethodPost, "/products/9/prices", `{"price":4500000,"valid_from":"2030-01-01T00:00:00Z","created_by":"arya"}`, 9)
+
+		s.mockUC.On("SchedulePrice", mock.Anything, int64(9), mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.SchedulePrice(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *ProductPriceHandlerTestSuite) TestListPriceHistory() {
+
+	s.Run("Success Caps Limit", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/price-history?page=2&limit=500", "", 1)
+
+		s.mockUC.On("ListPriceHistory", mock.Anything, int64(1), 2, 100).
+			Return([]entity.ProductPrice{{ID: 1, ProductID: 1, Price: 5000000, Status: entity.PriceCurrent}}, response.StandardPagination(2, 100, 101), nil).Once()
+
+		err := s.handler.ListPriceHistory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"status":"current"`)
+	})
+
+	s.Run("Defaults", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/price-history", "", 1)
+
+		s.mockUC.On("ListPriceHistory", mock.Anything, int64(1), 1, 10).Return([]entity.ProductPrice{}, response.StdPagination{}, nil).Once()
+
+		err := s.handler.ListPriceHistory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Internal Server Error", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/price-history", "", 1)
+
+		s.mockUC.On("ListPriceHistory", mock.Anything, int64(1), 1, 10).Return(nil, response.StdPagination{}, errors.New("db error")).Once()
+
+		err := s.handler.ListPriceHistory(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func TestProductPriceHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ProductPriceHandlerTestSuite))
+}
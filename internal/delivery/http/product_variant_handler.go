@@ -0,0 +1,138 @@
+package http
+
+import (
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/response"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProductVariantHandler struct {
+	usecase  interfaces.ProductVariantUsecase
+	response *response.StdResponse
+}
+
+func NewProductVariantHandler(variantUsecase interfaces.ProductVariantUsecase, standardResponse *response.StdResponse) *ProductVariantHandler {
+	return &ProductVariantHandler{
+		usecase:  variantUsecase,
+		response: standardResponse,
+	}
+}
+
+// CreateVariant godoc
+// @Summary Create a product variant
+// @Description Add a variant with its own SKU, price and stock. options maps each option axis (at most 3, e.g. color, storage) to a value; every variant of a product names the same axes.
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param variant body request.ProductVariant true "Variant object"
+// @Success 201 {object} response.ApiResponse{data=entity.ProductVariant}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/variants [post]
+func (h *ProductVariantHandler) CreateVariant(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.ProductVariant
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	variant, err := h.usecase.CreateVariant(ctx, productID, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.InsertSuccess, variant, "PRD-ERA-201"))
+}
+
+// ListVariants godoc
+// @Summary List the variants of a product
+// @Description Get the variants of a product in creation order
+// @Tags variants
+// @Produce json
+// @Param id path int true "Product ID"
+// @Success 200 {object} response.ApiResponse{data=[]entity.ProductVariant}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/variants [get]
+func (h *ProductVariantHandler) ListVariants(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	variants, err := h.usecase.ListVariants(ctx, productID)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, variants, "PRD-ERA-200"))
+}
+
+// UpdateVariant godoc
+// @Summary Update a product variant
+// @Description Replace the SKU, options, price and stock of a variant
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param variant_id path int true "Variant ID"
+// @Param variant body request.ProductVariantUpdate true "Variant object"
+// @Success 200 {object} response.ApiResponse{data=entity.ProductVariant}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/variants/{variant_id} [put]
+func (h *ProductVariantHandler) UpdateVariant(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	id, _ := strconv.ParseInt(c.Param("variant_id"), 10, 64)
+
+	var req request.ProductVariantUpdate
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	variant, err := h.usecase.UpdateVariant(ctx, productID, id, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, variant, "PRD-ERA-200"))
+}
+
+// DeleteVariant godoc
+// @Summary Delete a product variant
+// @Description Delete a variant of a product
+// @Tags variants
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param variant_id path int true "Variant ID"
+// @Success 200 {object} response.ApiResponse
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/variants/{variant_id} [delete]
+func (h *ProductVariantHandler) DeleteVariant(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	id, _ := strconv.ParseInt(c.Param("variant_id"), 10, 64)
+
+	ctx := c.Request().Context()
+	if err := h.usecase.DeleteVariant(ctx, productID, id); err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.DeleteSuccess, nil, "PRD-ERA-200"))
+}
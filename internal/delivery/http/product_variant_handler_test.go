@@ -0,0 +1,218 @@
+package http_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"erajaya-test/app"
+	productHttp "erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductVariantHandlerTestSuite struct {
+	suite.Suite
+	echo     *echo.Echo
+	mockUC   *mocks.ProductVariantUsecase
+	handler  *productHttp.ProductVariantHandler
+	recorder *httptest.ResponseRecorder
+}
+
+func (s *ProductVariantHandlerTestSuite) SetupTest() {
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: utils.NewValidator().Validator}
+
+	s.mockUC = new(mocks.ProductVariantUsecase)
+
+	logger := app.InitZapLogger()
+	resp := response.NewStdResponse(logger)
+	s.handler = productHttp.NewProductVariantHandler(s.mockUC, resp)
+
+	s.recorder = httptest.NewRecorder()
+}
+
+func (s *ProductVariantHandlerTestSuite) sendRequest(method, path, body string, productID, variantID int64) echo.Context {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+
+	s.recorder = httptest.NewRecorder()
+	c := s.echo.NewContext(req, s.recorder)
+	c.SetParamNames("id", "variant_id")
+	c.SetParamValues(fmt.Sprint(productID), fmt.Sprint(variantID))
+	return c
+}
+
+func (s *ProductVariantHandlerTestSuite) TestCreateVariant() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/variants",
+			`{"sku":"S24-BLK-256","options":{"color":"Black","storage":"256GB"},"price":20000000,"quantity":5,"created_by":"arya"}`, 1, 0)
+
+		s.mockUC.On("CreateVariant", mock.Anything, int64(1), mock.MatchedBy(func(r *request.ProductVariant) bool {
+			return r.SKU == "S24-BLK-256" && r.Options["storage"] == "256GB" && *r.Price == 20000000
+		})).Return(&entity.ProductVariant{ID: 7, ProductID: 1, Options: entity.VariantOptions{"color": "Black", "storage": "256GB"}}, nil).Once()
+
+		err := s.handler.CreateVariant(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusCreated, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"options":{"color":"Black","storage":"256GB"}`)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/variants", `{"price":20000000,"quantity":5,"created_by":"arya"}`, 1, 0)
+
+		err := s.handler.CreateVariant(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "options is required")
+	})
+
+	s.Run("Too Many Axes", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/variants",
+			`{"options":{"a":"1","b":"2","c":"3","d":"4"},"price":1,"quantity":1,"created_by":"arya"}`, 1, 0)
+
+		err := s.handler.CreateVariant(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), "options must be at most 3")
+	})
+
+	s.Run("Product Not Found", func() {
+		c := s.sendRequest(http.MethodPost, "/products/9/variants", `{"options":{"color":"Black"},"price":1,"quantity":1,"created_by":"arya"}`, 9, 0)
+
+		s.mockUC.On("CreateVariant", mock.Anything, int64(9), mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.CreateVariant(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+
+	s.Run("Duplicate Options", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/variants", `{"options":{"color":"Black"},"price":1,"quantity":1,"created_by":"arya"}`, 1, 0)
+
+		s.mockUC.On("CreateVariant", mock.Anything, int64(1), mock.Anything).
+			Return(nil, fmt.Errorf("%w: the product already has a variant with these options", constant.ErrConflict)).Once()
+
+		err := s.handler.CreateVariant(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+}
+
+func (s *ProductVariantHandlerTestSuite) TestListVariants() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/variants", "", 1, 0)
+
+		s.mockUC.On("ListVariants", mock.Anything, int64(1)).
+			Return([]entity.ProductVariant{{ID: 7, ProductID: 1, Options: entity.VariantOptions{"color": "Black"}}}, nil).Once()
+
+		err := s.handler.ListVariants(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"product_id":1`)
+	})
+
+	s.Run("Internal Server Error", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/variants", "", 1, 0)
+
+		s.mockUC.On("ListVariants", mock.Anything, int64(1)).Return(nil, errors.New("db error")).Once()
+
+		err := s.handler.ListVariants(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func (s *ProductVariantHandlerTestSuite) TestUpdateVariant() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1/variants/7", `{"options":{"color":"White"},"price":21000000,"quantity":3,"updated_by":"arya"}`, 1, 7)
+
+		s.mockUC.On("UpdateVariant", mock.Anything, int64(1), int64(7), mock.MatchedBy(func(r *request.ProductVariantUpdate) bool {
+			return r.Options["color"] == "White" && r.UpdatedBy == "arya"
+		})).Return(&entity.ProductVariant{ID: 7, ProductID: 1}, nil).Once()
+
+		err := s.handler.UpdateVariant(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Axes Mismatch", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1/variants/7", `{"options":{"size":"XL"},"price":1,"quantity":1,"updated_by":"arya"}`, 1, 7)
+
+		s.mockUC.On("UpdateVariant", mock.Anything, int64(1), int64(7), mock.Anything).
+			Return(nil, fmt.Errorf("%w: options must name the axes color like the other variants", constant.ErrValidation)).Once()
+
+		err := s.handler.UpdateVariant(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodPut, "/products/1/variants/9", `{"options":{"color":"White"},"price":1,"quantity":1,"updated_by":"arya"}`, 1, 9)
+
+		s.mockUC.On("UpdateVariant", mock.Anything, int64(1), int64(9), mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.UpdateVariant(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *ProductVariantHandlerTestSuite) TestDeleteVariant() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodDelete, "/products/1/variants/7", "", 1, 7)
+
+		s.mockUC.On("DeleteVariant", mock.Anything, int64(1), int64(7)).Return(nil).Once()
+
+		err := s.handler.DeleteVariant(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodDelete, "/products/1/variants/9", "", 1, 9)
+
+		s.mockUC.On("DeleteVariant", mock.Anything, int64(1), int64(9)).Return(constant.ErrNotFound).Once()
+
+		err := s.handler.DeleteVariant(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func TestProductVariantHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ProductVariantHandlerTestSuite))
+}
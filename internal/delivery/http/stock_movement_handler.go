@@ -0,0 +1,96 @@
+package http
+
+import (
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/response"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxStockMovementLimit bounds the page size of the stock ledger.
+const maxStockMovementLimit = 100
+
+type StockMovementHandler struct {
+	usecase  interfaces.StockMovementUsecase
+	response *response.StdResponse
+}
+
+func NewStockMovementHandler(movementUsecase interfaces.StockMovementUsecase, standardResponse *response.StdResponse) *StockMovementHandler {
+	return &StockMovementHandler{
+		usecase:  movementUsecase,
+		response: standardResponse,
+	}
+}
+
+// PostMovement godoc
+// @Summary Post a stock movement
+// @Description Record a receipt, sale, return or adjustment and move the stock of the product by it. quantity counts the units received, sold or returned; an adjustment takes a signed correction and a reason. A movement that would take the stock below zero is rejected.
+// @Tags stock
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param movement body request.StockMovement true "Stock movement"
+// @Success 201 {object} response.ApiResponse{data=entity.StockMovement}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/stock-movements [post]
+func (h *StockMovementHandler) PostMovement(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.StockMovement
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	movement, err := h.usecase.PostMovement(ctx, productID, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.InsertSuccess, movement, "PRD-ERA-201"))
+}
+
+// ListMovements godoc
+// @Summary List the stock movements of a product
+// @Description Get the stock ledger of a product, newest first, each movement with the balance it left
+// @Tags stock
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page (max 100)"
+// @Success 200 {object} response.ApiResponse{data=[]entity.StockMovement,metadata=response.StdPagination}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/stock-movements [get]
+func (h *StockMovementHandler) ListMovements(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, maxStockMovementLimit)
+
+	ctx := c.Request().Context()
+	movements, metadata, err := h.usecase.ListMovements(ctx, productID, page, limit)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, map[string]interface{}{
+		"data":     movements,
+		"metadata": metadata,
+	}, "PRD-ERA-200"))
+}
@@ -0,0 +1,161 @@
+package http_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"erajaya-test/app"
+	productHttp "erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type StockMovementHandlerTestSuite struct {
+	suite.Suite
+	echo     *echo.Echo
+	mockUC   *mocks.StockMovementUsecase
+	handler  *productHttp.StockMovementHandler
+	recorder *httptest.ResponseRecorder
+}
+
+func (s *StockMovementHandlerTestSuite) SetupTest() {
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: utils.NewValidator().Validator}
+
+	s.mockUC = new(mocks.StockMovementUsecase)
+
+	logger := app.InitZapLogger()
+	resp := response.NewStdResponse(logger)
+	s.handler = productHttp.NewStockMovementHandler(s.mockUC, resp)
+
+	s.recorder = httptest.NewRecorder()
+}
+
+func (s *StockMovementHandlerTestSuite) sendRequest(method, path, body string, productID int64) echo.Context {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+
+	s.recorder = httptest.NewRecorder()
+	c := s.echo.NewContext(req, s.recorder)
+	c.SetParamNames("id")
+	c.SetParamValues(fmt.Sprint(productID))
+	return c
+}
+
+func (s *StockMovementHandlerTestSuite) TestPostMovement() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/stock-movements", `{"type":"sale","quantity":3,"created_by":"arya"}`, 1)
+
+		s.mockUC.On("PostMovement", mock.Anything, int64(1), mock.MatchedBy(func(r *request.StockMovement) bool {
+			return r.Type == "sale" && r.Quantity == 3 && r.CreatedBy == "arya"
+		})).Return(&entity.StockMovement{ID: 5, ProductID: 1, Type: "sale", Quantity: -3, Balance: 7}, nil).Once()
+
+		err := s.handler.PostMovement(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusCreated, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"balance":7`)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/stock-movements", `{"type":"theft","quantity":3,"created_by":"arya"}`, 1)
+
+		err := s.handler.PostMovement(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Bind Error", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/stock-movements", `{"quantity":"many"}`, 1)
+
+		err := s.handler.PostMovement(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Insufficient Stock", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/stock-movements", `{"type":"sale","quantity":50,"created_by":"arya"}`, 1)
+
+		s.mockUC.On("PostMovement", mock.Anything, int64(1), mock.Anything).
+			Return(nil, fmt.Errorf("%w: insufficient stock, 7 available", constant.ErrConflict)).Once()
+
+		err := s.handler.PostMovement(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+
+	s.Run("Product Not Found", func() {
+		c := s.sendRequest(http.MethodPost, "/products/9/stock-movements", `{"type":"receipt","quantity":5,"created_by":"arya"}`, 9)
+
+		s.mockUC.On("PostMovement", mock.Anything, int64(9), mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.PostMovement(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *StockMovementHandlerTestSuite) TestListMovements() {
+
+	s.Run("Success Caps Limit", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/stock-movements?page=2&limit=500", "", 1)
+
+		s.mockUC.On("ListMovements", mock.Anything, int64(1), 2, 100).
+			Return([]entity.StockMovement{{ID: 1, ProductID: 1, Type: "receipt", Quantity: 5, Balance: 5}}, response.StandardPagination(2, 100, 101), nil).Once()
+
+		err := s.handler.ListMovements(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"type":"receipt"`)
+	})
+
+	s.Run("Defaults", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/stock-movements", "", 1)
+
+		s.mockUC.On("ListMovements", mock.Anything, int64(1), 1, 10).Return([]entity.StockMovement{}, response.StdPagination{}, nil).Once()
+
+		err := s.handler.ListMovements(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+	})
+
+	s.Run("Internal Server Error", func() {
+		c := s.sendRequest(http.MethodGet, "/products/1/stock-movements", "", 1)
+
+		s.mockUC.On("ListMovements", mock.Anything, int64(1), 1, 10).Return(nil, response.StdPagination{}, errors.New("db error")).Once()
+
+		err := s.handler.ListMovements(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, s.recorder.Code)
+	})
+}
+
+func TestStockMovementHandlerSuite(t *testing.T) {
+	suite.Run(t, new(StockMovementHandlerTestSuite))
+}
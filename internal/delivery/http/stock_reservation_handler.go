@@ -0,0 +1,138 @@
+package http
+
+import (
+	"context"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/response"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type StockReservationHandler struct {
+	usecase  interfaces.StockReservationUsecase
+	response *response.StdResponse
+}
+
+func NewStockReservationHandler(reservationUsecase interfaces.StockReservationUsecase, standardResponse *response.StdResponse) *StockReservationHandler {
+	return &StockReservationHandler{
+		usecase:  reservationUsecase,
+		response: standardResponse,
+	}
+}
+
+// Reserve godoc
+// @Summary Reserve stock of a product
+// @Description Hold stock for a checkout until it is committed or released, or until the ttl (seconds, default 900, at most 86400) runs out and the reaper releases it. Reserved stock is no longer available to other reservations or to sales posted as stock movements.
+// @Tags stock
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param reservation body request.StockReservation true "Reservation"
+// @Success 201 {object} response.ApiResponse{data=entity.StockReservation}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/products/{id}/reservations [post]
+func (h *StockReservationHandler) Reserve(c echo.Context) error {
+	productID, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.StockReservation
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	reservation, err := h.usecase.Reserve(ctx, productID, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.InsertSuccess, reservation, "PRD-ERA-201"))
+}
+
+// GetReservation godoc
+// @Summary Get a stock reservation
+// @Description Get a reservation and its status: active, committed, released or expired
+// @Tags stock
+// @Produce json
+// @Param id path int true "Reservation ID"
+// @Success 200 {object} response.ApiResponse{data=entity.StockReservation}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/reservations/{id} [get]
+func (h *StockReservationHandler) GetReservation(c echo.Context) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	ctx := c.Request().Context()
+	reservation, err := h.usecase.GetReservation(ctx, id)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.GetSuccess, reservation, "PRD-ERA-200"))
+}
+
+// CommitReservation godoc
+// @Summary Commit a stock reservation
+// @Description Sell the reserved units: they leave the stock through a sale in the stock ledger. Only an active reservation that has not expired can be committed.
+// @Tags stock
+// @Accept json
+// @Produce json
+// @Param id path int true "Reservation ID"
+// @Param reservation body request.StockReservationResolve true "Committer"
+// @Success 200 {object} response.ApiResponse{data=entity.StockReservation}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/reservations/{id}/commit [post]
+func (h *StockReservationHandler) CommitReservation(c echo.Context) error {
+	return h.resolve(c, h.usecase.CommitReservation)
+}
+
+// ReleaseReservation godoc
+// @Summary Release a stock reservation
+// @Description Give the reserved units back to the available stock. Only an active reservation can be released.
+// @Tags stock
+// @Accept json
+// @Produce json
+// @Param id path int true "Reservation ID"
+// @Param reservation body request.StockReservationResolve true "Releaser"
+// @Success 200 {object} response.ApiResponse{data=entity.StockReservation}
+// @Failure 400 {object} response.ApiResponse{error=[]utils.ValidationError}
+// @Failure 404 {object} response.ApiResponse{error=error}
+// @Failure 409 {object} response.ApiResponse{error=error}
+// @Failure 500 {object} response.ApiResponse{error=error}
+// @Router /api/v1/reservations/{id}/release [post]
+func (h *StockReservationHandler) ReleaseReservation(c echo.Context) error {
+	return h.resolve(c, h.usecase.ReleaseReservation)
+}
+
+func (h *StockReservationHandler) resolve(c echo.Context, apply func(ctx context.Context, id int64, req *request.StockReservationResolve) (*entity.StockReservation, error)) error {
+	id, _ := strconv.ParseInt(c.Param("id"), 10, 64)
+
+	var req request.StockReservationResolve
+	if err := c.Bind(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-410"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return h.response.StandardResponse(c, h.response.ErrorResponse(c.Request().Context(), response.BadRequest, err, "PRD-ERA-400"))
+	}
+
+	ctx := c.Request().Context()
+	reservation, err := apply(ctx, id, &req)
+	if err != nil {
+		return errorResponse(c, h.response, err)
+	}
+
+	return h.response.StandardResponse(c, h.response.SuccessResponse(ctx, response.UpdateSuccess, reservation, "PRD-ERA-200"))
+}
@@ -0,0 +1,195 @@
+package http_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"erajaya-test/app"
+	productHttp "erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type StockReservationHandlerTestSuite struct {
+	suite.Suite
+	echo     *echo.Echo
+	mockUC   *mocks.StockReservationUsecase
+	handler  *productHttp.StockReservationHandler
+	recorder *httptest.ResponseRecorder
+}
+
+func (s *StockReservationHandlerTestSuite) SetupTest() {
+
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: utils.NewValidator().Validator}
+
+	s.mockUC = new(mocks.StockReservationUsecase)
+
+	logger := app.InitZapLogger()
+	resp := response.NewStdResponse(logger)
+	s.handler = productHttp.NewStockReservationHandler(s.mockUC, resp)
+
+	s.recorder = httptest.NewRecorder()
+}
+
+func (s *StockReservationHandlerTestSuite) sendRequest(method, path, body string, id int64) echo.Context {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+
+	s.recorder = httptest.NewRecorder()
+	c := s.echo.NewContext(req, s.recorder)
+	c.SetParamNames("id")
+	c.SetParamValues(fmt.Sprint(id))
+	return c
+}
+
+func (s *StockReservationHandlerTestSuite) TestReserve() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/reservations", `{"quantity":2,"ttl":300,"reference":"cart-7","created_by":"checkout"}`, 1)
+
+		s.mockUC.On("Reserve", mock.Anything, int64(1), mock.MatchedBy(func(r *request.StockReservation) bool {
+			return r.Quantity == 2 && r.TTL == 300 && r.Reference == "cart-7" && r.CreatedBy == "checkout"
+		})).Return(&entity.StockReservation{ID: 4, ProductID: 1, Quantity: 2, Status: entity.ReservationActive}, nil).Once()
+
+		err := s.handler.Reserve(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusCreated, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"status":"active"`)
+	})
+
+	s.Run("Validation Error - TTL Too Long", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/reservations", `{"quantity":2,"ttl":172800,"created_by":"checkout"}`, 1)
+
+		err := s.handler.Reserve(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+
+	s.Run("Insufficient Stock", func() {
+		c := s.sendRequest(http.MethodPost, "/products/1/reservations", `{"quantity":20,"created_by":"checkout"}`, 1)
+
+		s.mockUC.On("Reserve", mock.Anything, int64(1), mock.Anything).
+			Return(nil, fmt.Errorf("%w: insufficient stock, 2 available", constant.ErrConflict)).Once()
+
+		err := s.handler.Reserve(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+}
+
+func (s *StockReservationHandlerTestSuite) TestGetReservation() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodGet, "/reservations/4", "", 4)
+
+		s.mockUC.On("GetReservation", mock.Anything, int64(4)).
+			Return(&entity.StockReservation{ID: 4, Status: entity.ReservationExpired}, nil).Once()
+
+		err := s.handler.GetReservation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"status":"expired"`)
+	})
+
+	s.Run("Not Found", func() {
+		c := s.sendRequest(http.MethodGet, "/reservations/9", "", 9)
+
+		s.mockUC.On("GetReservation", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.handler.GetReservation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusNotFound, s.recorder.Code)
+	})
+}
+
+func (s *StockReservationHandlerTestSuite) TestCommitReservation() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/reservations/4/commit", `{"updated_by":"checkout"}`, 4)
+
+		s.mockUC.On("CommitReservation", mock.Anything, int64(4), mock.MatchedBy(func(r *request.StockReservationResolve) bool {
+			return r.UpdatedBy == "checkout"
+		})).Return(&entity.StockReservation{ID: 4, Status: entity.ReservationCommitted}, nil).Once()
+
+		err := s.handler.CommitReservation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"status":"committed"`)
+	})
+
+	s.Run("Expired", func() {
+		c := s.sendRequest(http.MethodPost, "/reservations/5/commit", `{"updated_by":"checkout"}`, 5)
+
+		s.mockUC.On("CommitReservation", mock.Anything, int64(5), mock.Anything).
+			Return(nil, fmt.Errorf("%w: reservation has expired", constant.ErrConflict)).Once()
+
+		err := s.handler.CommitReservation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+
+	s.Run("Validation Error", func() {
+		c := s.sendRequest(http.MethodPost, "/reservations/4/commit", `{}`, 4)
+
+		err := s.handler.CommitReservation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, s.recorder.Code)
+	})
+}
+
+func (s *StockReservationHandlerTestSuite) TestReleaseReservation() {
+
+	s.Run("Success", func() {
+		c := s.sendRequest(http.MethodPost, "/reservations/4/release", `{"updated_by":"checkout"}`, 4)
+
+		s.mockUC.On("ReleaseReservation", mock.Anything, int64(4), mock.Anything).
+			Return(&entity.StockReservation{ID: 4, Status: entity.ReservationReleased}, nil).Once()
+
+		err := s.handler.ReleaseReservation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, s.recorder.Code)
+		s.Contains(s.recorder.Body.String(), `"status":"released"`)
+	})
+
+	s.Run("Already Committed", func() {
+		c := s.sendRequest(http.MethodPost, "/reservations/4/release", `{"updated_by":"checkout"}`, 4)
+
+		s.mockUC.On("ReleaseReservation", mock.Anything, int64(4), mock.Anything).
+			Return(nil, fmt.Errorf("%w: reservation is committed", constant.ErrConflict)).Once()
+
+		err := s.handler.ReleaseReservation(c)
+
+		s.NoError(err)
+		s.Equal(http.StatusConflict, s.recorder.Code)
+	})
+}
+
+func TestStockReservationHandlerSuite(t *testing.T) {
+	suite.Run(t, new(StockReservationHandlerTestSuite))
+}
@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"time"
+)
+
+type AttributeRepository interface {
+	Create(ctx context.Context, attribute *entity.Attribute) error
+	GetByID(ctx context.Context, id int64) (*entity.Attribute, error)
+	Fetch(ctx context.Context) ([]entity.Attribute, error)
+	FetchByCodes(ctx context.Context, codes []string) ([]entity.Attribute, error)
+	Update(ctx context.Context, attribute *entity.Attribute) error
+	Delete(ctx context.Context, id int64) error
+	FetchByCategory(ctx context.Context, categoryID int64) ([]entity.Attribute, error)
+	SetCategoryAttributes(ctx context.Context, categoryID int64, attributeIDs []int64) error
+	FetchByProduct(ctx context.Context, productID int64) ([]entity.Attribute, error)
+	SetProductValues(ctx context.Context, productID int64, version int64, values entity.ProductAttributes, updatedBy string, at time.Time) (*entity.Product, error)
+}
+
+type AttributeUsecase interface {
+	CreateAttribute(ctx context.Context, req *request.Attribute) (*entity.Attribute, error)
+	GetAttribute(ctx context.Context, id int64) (*entity.Attribute, error)
+	ListAttributes(ctx context.Context) ([]entity.Attribute, error)
+	UpdateAttribute(ctx context.Context, id int64, req *request.AttributeUpdate) (*entity.Attribute, error)
+	DeleteAttribute(ctx context.Context, id int64) error
+	GetCategoryAttributes(ctx context.Context, categoryID int64) ([]entity.Attribute, error)
+	SetCategoryAttributes(ctx context.Context, categoryID int64, req *request.CategoryAttributes) ([]entity.Attribute, error)
+	SetProductAttributes(ctx context.Context, productID int64, version int64, req *request.ProductAttributes) (*entity.Product, error)
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+	"erajaya-test/internal/models/entity"
+)
+
+// AutocompleteRepository keeps the prefix index behind product name
+// autocomplete.
+type AutocompleteRepository interface {
+	Index(ctx context.Context, product entity.Product) error
+	Remove(ctx context.Context, id int64) error
+	Search(ctx context.Context, prefix string, limit int) ([]entity.ProductAutocomplete, error)
+	Rebuild(ctx context.Context, next func(ctx context.Context) ([]entity.Product, error)) (int64, error)
+}
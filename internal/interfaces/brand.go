@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+)
+
+type BrandRepository interface {
+	Create(ctx context.Context, brand *entity.Brand) error
+	GetByID(ctx context.Context, id int64) (*entity.Brand, error)
+	Fetch(ctx context.Context) ([]entity.Brand, error)
+	Update(ctx context.Context, brand *entity.Brand) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type BrandUsecase interface {
+	CreateBrand(ctx context.Context, req *request.Brand) (*entity.Brand, error)
+	GetBrand(ctx context.Context, id int64) (*entity.Brand, error)
+	ListBrands(ctx context.Context) ([]entity.Brand, error)
+	UpdateBrand(ctx context.Context, id int64, req *request.BrandUpdate) (*entity.Brand, error)
+	DeleteBrand(ctx context.Context, id int64) error
+}
@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+)
+
+type CategoryRepository interface {
+	Create(ctx context.Context, category *entity.Category) error
+	GetByID(ctx context.Context, id int64) (*entity.Category, error)
+	Fetch(ctx context.Context) ([]entity.Category, error)
+	Update(ctx context.Context, category *entity.Category) error
+	Delete(ctx context.Context, id int64) error
+	GetByProduct(ctx context.Context, productID int64) ([]entity.Category, error)
+	SetProductCategories(ctx context.Context, productID int64, categoryIDs []int64, updatedBy string) error
+}
+
+type CategoryUsecase interface {
+	CreateCategory(ctx context.Context, req *request.Category) (*entity.Category, error)
+	GetCategory(ctx context.Context, id int64) (*entity.Category, error)
+	ListCategories(ctx context.Context) ([]entity.Category, error)
+	UpdateCategory(ctx context.Context, id int64, req *request.CategoryUpdate) (*entity.Category, error)
+	DeleteCategory(ctx context.Context, id int64) error
+	GetProductCategories(ctx context.Context, productID int64) ([]entity.Category, error)
+	SetProductCategories(ctx context.Context, productID int64, req *request.ProductCategories) ([]entity.Category, error)
+}
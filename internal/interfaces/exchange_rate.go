@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+)
+
+type ExchangeRateRepository interface {
+	Fetch(ctx context.Context) ([]entity.ExchangeRate, error)
+	Upsert(ctx context.Context, rates []entity.ExchangeRate) error
+}
+
+type ExchangeRateUsecase interface {
+	ListRates(ctx context.Context) ([]entity.ExchangeRate, error)
+	LoadRates(ctx context.Context, req *request.ExchangeRateLoad) ([]entity.ExchangeRate, error)
+	PriceProducts(ctx context.Context, currency string, products []*entity.Product) error
+}
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+)
+
+type LocationRepository interface {
+	Create(ctx context.Context, location *entity.Location) error
+	GetByID(ctx context.Context, id int64) (*entity.Location, error)
+	Fetch(ctx context.Context) ([]entity.Location, error)
+	Update(ctx context.Context, location *entity.Location) error
+	Delete(ctx context.Context, id int64) error
+	FetchStocks(ctx context.Context, productID int64) ([]entity.ProductStock, error)
+}
+
+type LocationUsecase interface {
+	CreateLocation(ctx context.Context, req *request.Location) (*entity.Location, error)
+	GetLocation(ctx context.Context, id int64) (*entity.Location, error)
+	ListLocations(ctx context.Context) ([]entity.Location, error)
+	UpdateLocation(ctx context.Context, id int64, req *request.LocationUpdate) (*entity.Location, error)
+	DeleteLocation(ctx context.Context, id int64) error
+	ListProductStocks(ctx context.Context, productID int64) ([]entity.ProductStock, error)
+}
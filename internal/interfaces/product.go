@@ -5,16 +5,48 @@ import (
 	"erajaya-test/internal/models/entity"
 	"erajaya-test/internal/models/request"
 	"erajaya-test/shared/response"
+	"time"
 )
 
 type ProductRepository interface {
 	Create(ctx context.Context, product *entity.Product) error
+	CreateBatch(ctx context.Context, products []*entity.Product, batchSize int) error
 	GetByID(ctx context.Context, id int64) (*entity.Product, error)
+	GetBySKU(ctx context.Context, sku string) (*entity.Product, error)
+	GetBySlug(ctx context.Context, slug string) (*entity.Product, error)
 	Fetch(ctx context.Context, filter request.ProductFilter) ([]entity.Product, int64, error)
+	Stream(ctx context.Context, filter request.ProductFilter, fn func(product entity.Product) error) error
+	Update(ctx context.Context, product *entity.Product) error
+	Patch(ctx context.Context, id int64, version int64, fields map[string]interface{}) (*entity.Product, error)
+	Delete(ctx context.Context, id int64, version int64, deletedBy string) error
+	Restore(ctx context.Context, id int64, version int64, updatedBy string) (*entity.Product, error)
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, []string, error)
+	FetchPublishChanges(ctx context.Context, from time.Time, to time.Time) ([]entity.Product, error)
+	FetchHistory(ctx context.Context, id int64, page int, limit int) ([]entity.AuditEvent, int64, error)
+	Suggest(ctx context.Context, term string, limit int) ([]entity.ProductSuggestion, error)
+	BrandFacets(ctx context.Context, filter request.ProductFilter) ([]entity.FacetCount, error)
+	CategoryFacets(ctx context.Context, filter request.ProductFilter) ([]entity.FacetCount, error)
+	PriceFacets(ctx context.Context, filter request.ProductFilter, bounds []int64) ([]entity.PriceBucket, error)
+	StockFacets(ctx context.Context, filter request.ProductFilter) (*entity.StockCount, error)
 }
 
 type ProductUsecase interface {
 	CreateProduct(ctx context.Context, req *request.Product) error
+	CreateProductsBulk(ctx context.Context, req *request.ProductBulk) (response.BulkResult, error)
 	GetProductByID(ctx context.Context, id int64) (*entity.Product, error)
+	GetProductBySKU(ctx context.Context, sku string) (*entity.Product, error)
+	GetProductBySlug(ctx context.Context, slug string) (*entity.Product, error)
 	ListProducts(ctx context.Context, filter request.ProductFilter) ([]entity.Product, response.StdPagination, error)
+	ExportProducts(ctx context.Context, filter request.ProductFilter, fn func(product entity.Product) error) error
+	UpdateProduct(ctx context.Context, id int64, version int64, req *request.ProductUpdate) (*entity.Product, error)
+	PatchProduct(ctx context.Context, id int64, version int64, patch map[string]interface{}) (*entity.Product, error)
+	DeleteProduct(ctx context.Context, id int64, version int64, req *request.ProductDelete) error
+	RestoreProduct(ctx context.Context, id int64, version int64, req *request.ProductRestore) (*entity.Product, error)
+	TransitionProductStatus(ctx context.Context, id int64, version int64, req *request.ProductStatus) (*entity.Product, error)
+	PurgeDeletedProducts(ctx context.Context, retention time.Duration) (int64, error)
+	SyncPublishWindows(ctx context.Context, from time.Time, to time.Time) (int64, error)
+	ListProductHistory(ctx context.Context, id int64, page int, limit int) ([]entity.AuditEvent, response.StdPagination, error)
+	SuggestProducts(ctx context.Context, term string, limit int) ([]entity.ProductSuggestion, error)
+	AutocompleteProducts(ctx context.Context, prefix string, limit int) ([]entity.ProductAutocomplete, error)
+	RebuildAutocomplete(ctx context.Context) (int64, error)
 }
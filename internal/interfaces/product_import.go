@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"time"
+)
+
+type ProductImportRepository interface {
+	Create(ctx context.Context, job *entity.ProductImport) error
+	GetByID(ctx context.Context, id int64) (*entity.ProductImport, error)
+	ClaimPending(ctx context.Context) (*entity.ProductImport, error)
+	FailStale(ctx context.Context, staleBefore time.Time, message string) error
+	SaveProgress(ctx context.Context, job *entity.ProductImport) error
+}
+
+type ProductImportUsecase interface {
+	CreateImport(ctx context.Context, req *request.ProductImport) (*entity.ProductImport, error)
+	GetImport(ctx context.Context, id int64) (*entity.ProductImport, error)
+	ProcessNextImport(ctx context.Context) (bool, error)
+}
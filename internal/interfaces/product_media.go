@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"time"
+)
+
+type ProductMediaRepository interface {
+	Create(ctx context.Context, media *entity.ProductMedia) error
+	FetchByProduct(ctx context.Context, productID int64) ([]entity.ProductMedia, error)
+	Reorder(ctx context.Context, productID int64, ids []int64, updatedBy string, at time.Time) ([]entity.ProductMedia, error)
+	SetPrimary(ctx context.Context, productID int64, id int64, updatedBy string, at time.Time) (*entity.ProductMedia, error)
+	Delete(ctx context.Context, productID int64, id int64) (*entity.ProductMedia, error)
+}
+
+type ProductMediaUsecase interface {
+	UploadMedia(ctx context.Context, productID int64, req *request.ProductMediaUpload) (*entity.ProductMedia, error)
+	ListMedia(ctx context.Context, productID int64) ([]entity.ProductMedia, error)
+	ReorderMedia(ctx context.Context, productID int64, req *request.ProductMediaOrder) ([]entity.ProductMedia, error)
+	SetPrimaryMedia(ctx context.Context, productID int64, id int64, req *request.ProductMediaPrimary) (*entity.ProductMedia, error)
+	DeleteMedia(ctx context.Context, productID int64, id int64) error
+}
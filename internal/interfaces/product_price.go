@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/response"
+	"time"
+)
+
+type ProductPriceRepository interface {
+	Schedule(ctx context.Context, price *entity.ProductPrice) error
+	FetchByProduct(ctx context.Context, productID int64, page int, limit int) ([]entity.ProductPrice, int64, error)
+	ApplyNext(ctx context.Context, now time.Time) (*entity.ProductPrice, error)
+}
+
+type ProductPriceUsecase interface {
+	SchedulePrice(ctx context.Context, productID int64, req *request.ProductPriceSchedule) (*entity.ProductPrice, error)
+	ListPriceHistory(ctx context.Context, productID int64, page int, limit int) ([]entity.ProductPrice, response.StdPagination, error)
+	ApplyDuePrices(ctx context.Context) (int, error)
+}
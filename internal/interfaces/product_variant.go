@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+)
+
+type ProductVariantRepository interface {
+	Create(ctx context.Context, variant *entity.ProductVariant) error
+	FetchByProduct(ctx context.Context, productID int64) ([]entity.ProductVariant, error)
+	Update(ctx context.Context, variant *entity.ProductVariant) error
+	Delete(ctx context.Context, productID int64, id int64) error
+}
+
+type ProductVariantUsecase interface {
+	CreateVariant(ctx context.Context, productID int64, req *request.ProductVariant) (*entity.ProductVariant, error)
+	ListVariants(ctx context.Context, productID int64) ([]entity.ProductVariant, error)
+	UpdateVariant(ctx context.Context, productID int64, id int64, req *request.ProductVariantUpdate) (*entity.ProductVariant, error)
+	DeleteVariant(ctx context.Context, productID int64, id int64) error
+}
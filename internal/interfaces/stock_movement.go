@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/response"
+)
+
+type StockMovementRepository interface {
+	Post(ctx context.Context, movement *entity.StockMovement) error
+	FetchByProduct(ctx context.Context, productID int64, page int, limit int) ([]entity.StockMovement, int64, error)
+}
+
+type StockMovementUsecase interface {
+	PostMovement(ctx context.Context, productID int64, req *request.StockMovement) (*entity.StockMovement, error)
+	ListMovements(ctx context.Context, productID int64, page int, limit int) ([]entity.StockMovement, response.StdPagination, error)
+}
@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"time"
+)
+
+type StockReservationRepository interface {
+	Reserve(ctx context.Context, reservation *entity.StockReservation) error
+	GetByID(ctx context.Context, id int64) (*entity.StockReservation, error)
+	Commit(ctx context.Context, id int64, updatedBy string, at time.Time) (*entity.StockReservation, error)
+	Release(ctx context.Context, id int64, updatedBy string, at time.Time) (*entity.StockReservation, error)
+	ExpireNext(ctx context.Context, now time.Time) (*entity.StockReservation, error)
+}
+
+type StockReservationUsecase interface {
+	Reserve(ctx context.Context, productID int64, req *request.StockReservation) (*entity.StockReservation, error)
+	GetReservation(ctx context.Context, id int64) (*entity.StockReservation, error)
+	CommitReservation(ctx context.Context, id int64, req *request.StockReservationResolve) (*entity.StockReservation, error)
+	ReleaseReservation(ctx context.Context, id int64, req *request.StockReservationResolve) (*entity.StockReservation, error)
+	ReleaseExpired(ctx context.Context) (int, error)
+}
@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Attribute types.
+const (
+	// AttributeString holds free text.
+	AttributeString = "string"
+	// AttributeNumber holds a number without unit.
+	AttributeNumber = "number"
+	// AttributeEnum holds one of the options of the attribute.
+	AttributeEnum = "enum"
+	// AttributeBoolean holds true or false.
+	AttributeBoolean = "boolean"
+	// AttributeUnit holds a number measured in the unit of the attribute.
+	AttributeUnit = "unit"
+)
+
+// Attribute defines a product attribute. Code is the key of its value in the
+// attributes of a product.
+type Attribute struct {
+	ID        int64            `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
+	Code      string           `json:"code"`
+	Name      string           `json:"name"`
+	Type      string           `json:"type"`
+	Unit      string           `json:"unit,omitempty"`
+	Options   AttributeOptions `json:"options,omitempty" gorm:"type:jsonb" swaggertype:"array,string"`
+	CreatedAt time.Time        `json:"created_at"`
+	CreatedBy string           `json:"created_by"`
+	UpdatedAt time.Time        `json:"updated_at"`
+	UpdatedBy string           `json:"updated_by"`
+}
+
+func (Attribute) TableName() string {
+	return "attributes"
+}
+
+// Numeric reports whether the values of the attribute are numbers, which
+// can be compared by range.
+func (a Attribute) Numeric() bool {
+	return a.Type == AttributeNumber || a.Type == AttributeUnit
+}
+
+// AttributeOptions lists the values an enum attribute takes.
+type AttributeOptions []string
+
+func (o AttributeOptions) Value() (driver.Value, error) {
+	if o == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal(o)
+	return string(b), err
+}
+
+func (o *AttributeOptions) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*o = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, o)
+	case string:
+		return json.Unmarshal([]byte(v), o)
+	default:
+		return errors.New("unsupported type for AttributeOptions")
+	}
+}
+
+// CategoryAttribute makes an attribute apply to the products of a category
+// and of its descendants.
+type CategoryAttribute struct {
+	CategoryID  int64 `gorm:"primaryKey"`
+	AttributeID int64 `gorm:"primaryKey"`
+}
+
+func (CategoryAttribute) TableName() string {
+	return "category_attributes"
+}
+
+// ProductAttributes maps attribute codes to the values of a product: strings,
+// numbers or booleans, e.g. {"ram_gb": 8, "chipset": "Snapdragon 8 Gen 3"}.
+type ProductAttributes map[string]interface{}
+
+func (a ProductAttributes) Value() (driver.Value, error) {
+	if a == nil {
+		return "{}", nil
+	}
+	b, err := json.Marshal(a)
+	return string(b), err
+}
+
+func (a *ProductAttributes) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	default:
+		return errors.New("unsupported type for ProductAttributes")
+	}
+}
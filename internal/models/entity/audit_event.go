@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Audited kinds of rows.
+const (
+	AuditProduct = "product"
+)
+
+// Audited actions.
+const (
+	AuditCreate  = "create"
+	AuditUpdate  = "update"
+	AuditDelete  = "delete"
+	AuditRestore = "restore"
+	AuditPurge   = "purge"
+)
+
+// AuditEvent records one write to an audited row: who made it, in which
+// request, and the fields it changed with their values before and after.
+type AuditEvent struct {
+	ID         int64  `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
+	EntityType string `json:"entity_type"`
+	EntityID   int64  `json:"entity_id"`
+	Action     string `json:"action"`
+	Actor      string `json:"actor"`
+	RequestID  string `json:"request_id"`
+	// Before is nil for a created row and After for a purged one.
+	Before    AuditValues `json:"before" gorm:"type:jsonb" swaggertype:"object"`
+	After     AuditValues `json:"after" gorm:"type:jsonb" swaggertype:"object"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+func (AuditEvent) TableName() string {
+	return "audit_events"
+}
+
+// AuditValues maps the column names of an audited row to their values, e.g.
+// {"price": 5000000, "status": "active"}.
+type AuditValues map[string]interface{}
+
+func (v AuditValues) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	return string(b), err
+}
+
+func (v *AuditValues) Scan(value interface{}) error {
+	switch data := value.(type) {
+	case nil:
+		*v = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(data, v)
+	case string:
+		return json.Unmarshal([]byte(data), v)
+	default:
+		return errors.New("unsupported type for AuditValues")
+	}
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type Brand struct {
+	ID        int64     `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy string    `json:"updated_by"`
+}
+
+func (Brand) TableName() string {
+	return "brands"
+}
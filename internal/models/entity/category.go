@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Category is a node of the product taxonomy. Path lists the ids from the root
+// down to the category itself, e.g. "/1/4/9/", so every descendant has the
+// path of its ancestors as prefix.
+type Category struct {
+	ID        int64      `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
+	ParentID  *int64     `json:"parent_id"`
+	Name      string     `json:"name"`
+	Path      string     `json:"path"`
+	Depth     int        `json:"depth"`
+	CreatedAt time.Time  `json:"created_at"`
+	CreatedBy string     `json:"created_by"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	UpdatedBy string     `json:"updated_by"`
+	Children  []Category `json:"children,omitempty" gorm:"-"`
+}
+
+func (Category) TableName() string {
+	return "categories"
+}
+
+// PathIDs returns the ids along the path, root first and the category last.
+func (c Category) PathIDs() []int64 {
+	var ids []int64
+	for _, part := range strings.Split(strings.Trim(c.Path, "/"), "/") {
+		if id, err := strconv.ParseInt(part, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// ProductCategory links a product to one of its categories.
+type ProductCategory struct {
+	ProductID  int64 `gorm:"primaryKey"`
+	CategoryID int64 `gorm:"primaryKey"`
+}
+
+func (ProductCategory) TableName() string {
+	return "product_categories"
+}
@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// ExchangeRate is what one unit of a currency is worth in the base currency.
+// Prices in the currency are kept in its minor unit, MinorUnits decimals
+// below the unit.
+type ExchangeRate struct {
+	Currency   string    `json:"currency" gorm:"primaryKey"`
+	Rate       float64   `json:"rate"`
+	MinorUnits int       `json:"minor_units"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	UpdatedBy  string    `json:"updated_by"`
+}
+
+func (ExchangeRate) TableName() string {
+	return "exchange_rates"
+}
+
+// VATRule is the value added tax (PPN) prices are subject to.
+type VATRule struct {
+	// Rate is the tax in percent of the net amount.
+	Rate float64
+	// Inclusive tells that stored prices already include the tax.
+	Inclusive bool
+}
+
+// PriceBreakdown is a price in Currency split into its net amount and the tax
+// on it. Amounts are in the minor unit of Currency.
+type PriceBreakdown struct {
+	Currency string  `json:"currency"`
+	Net      int64   `json:"net"`
+	Tax      int64   `json:"tax"`
+	Gross    int64   `json:"gross"`
+	VATRate  float64 `json:"vat_rate"`
+	// ExchangeRate converted the stored price into Currency, set when the
+	// stored price is in another currency.
+	ExchangeRate *float64 `json:"exchange_rate,omitempty"`
+}
@@ -0,0 +1,42 @@
+package entity
+
+import "time"
+
+// Location types.
+const (
+	LocationWarehouse = "warehouse"
+	LocationStore     = "store"
+)
+
+// Location is a store or warehouse that keeps stock. The default location
+// takes the stock movements and reservations that name no location.
+type Location struct {
+	ID        int64     `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	Address   string    `json:"address"`
+	IsDefault bool      `json:"is_default" gorm:"->" readonly:"true"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy string    `json:"updated_by"`
+}
+
+func (Location) TableName() string {
+	return "locations"
+}
+
+// ProductStock is the stock of a product at one location.
+type ProductStock struct {
+	ProductID  int64 `json:"product_id" gorm:"primaryKey;autoIncrement:false"`
+	LocationID int64 `json:"location_id" gorm:"primaryKey;autoIncrement:false"`
+	Quantity   int   `json:"quantity"`
+	Reserved   int   `json:"reserved"`
+	// Available is the stock not held by reservations.
+	Available int `json:"available" gorm:"-"`
+}
+
+func (ProductStock) TableName() string {
+	return "product_stocks"
+}
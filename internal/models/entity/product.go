@@ -1,27 +1,184 @@
 package entity
 
 import (
+	"slices"
+	"strconv"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Product struct {
-	ID          int64     `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
-	Name        string    `json:"name" gorm:"index:idx_product_name;not null"`
-	Price       *int64    `json:"price" gorm:"index:idx_product_price;not null"`
-	Description string    `json:"description"`
-	Quantity    *int      `json:"quantity"`
-	CreatedAt   time.Time `json:"created_at" gorm:"index:idx_product_created_at"`
-	CreatedBy   string    `json:"created_by"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	UpdatedBy   string    `json:"updated_by"`
-	DeletedAt   time.Time `json:"deleted_at"`
-	DeletedBy   string    `json:"deleted_by"`
+	ID       int64   `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
+	Name     string  `json:"name" gorm:"index:idx_product_name;not null"`
+	SKU      *string `json:"sku"`
+	Barcode  *string `json:"barcode"`
+	Slug     string  `json:"slug"`
+	BrandID  *int64  `json:"brand_id"`
+	Price    *int64  `json:"price" gorm:"index:idx_product_price;not null"`
+	Currency string  `json:"currency"`
+	// BasePrice is the price converted into the base currency, kept by the
+	// database on every write and rate change. Price filters, sorting and
+	// facets compare it, so products priced in other currencies rank fairly.
+	BasePrice   *int64         `json:"base_price" gorm:"->" readonly:"true"`
+	Description string         `json:"description"`
+	Quantity    *int           `json:"quantity"`
+	CreatedAt   time.Time      `json:"created_at" gorm:"index:idx_product_created_at"`
+	CreatedBy   string         `json:"created_by"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	UpdatedBy   string         `json:"updated_by"`
+	DeletedAt   gorm.DeletedAt `json:"deleted_at" swaggertype:"string" format:"date-time"`
+	DeletedBy   string         `json:"deleted_by"`
+	Version     int64          `json:"version" gorm:"not null;default:1"`
+	// StockVersion counts the writes to the stock of the product. It is part
+	// of the ETag of the detail but not of the If-Match check, so stock
+	// moving does not conflict with catalog edits.
+	StockVersion int64 `json:"stock_version" gorm:"<-:update" readonly:"true"`
+	// Status is where the product is in its lifecycle. Public listings only
+	// show active products inside their publish window.
+	Status      string     `json:"status" gorm:"not null;default:active"`
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+	// Reserved is the stock held by active reservations, written only together
+	// with them. Creating a product leaves it at zero.
+	Reserved int `json:"-" gorm:"<-:update"`
+	// Attributes are only written through the attribute values of the
+	// product, never by creating or updating it.
+	Attributes ProductAttributes `json:"attributes,omitempty" gorm:"type:jsonb;->" swaggertype:"object"`
+	// Variants, Options and Media are only loaded for the product detail.
+	Variants []ProductVariant `json:"variants,omitempty" gorm:"foreignKey:ProductID"`
+	Options  []ProductOption  `json:"options,omitempty" gorm:"-"`
+	Media    []ProductMedia   `json:"media,omitempty" gorm:"foreignKey:ProductID"`
+	// Available is the stock not held by reservations, only set for the
+	// product detail.
+	Available *int `json:"available,omitempty" gorm:"-"`
+	// LocationStock is the stock at the location a listing is filtered by.
+	LocationStock *ProductStock `json:"location_stock,omitempty" gorm:"-"`
+	// Pricing breaks the price down into net, tax and gross amounts, in the
+	// currency a read asked for. Only set on read responses.
+	Pricing *PriceBreakdown `json:"pricing,omitempty" gorm:"-"`
+	// Score is the search relevance of the product, only set while searching.
+	Score *float64 `json:"score,omitempty" gorm:"->;-:migration"`
 }
 
 func (Product) TableName() string {
 	return "products"
 }
 
+// Lifecycle statuses of a product.
+const (
+	// ProductDraft is a product being prepared, never listed publicly.
+	ProductDraft = "draft"
+	// ProductActive is a product on sale.
+	ProductActive = "active"
+	// ProductArchived is a product taken off sale for now.
+	ProductArchived = "archived"
+	// ProductDiscontinued is a product that will not be sold again.
+	ProductDiscontinued = "discontinued"
+)
+
+// productTransitions lists the statuses each status can move to.
+var productTransitions = map[string][]string{
+	ProductDraft:        {ProductActive, ProductArchived},
+	ProductActive:       {ProductArchived, ProductDiscontinued},
+	ProductArchived:     {ProductActive, ProductDiscontinued},
+	ProductDiscontinued: {ProductArchived},
+}
+
+// CanTransition reports whether a product may move from one status to
+// another. Staying in a status is allowed, which reschedules the window.
+func CanTransition(from, to string) bool {
+	return from == to || slices.Contains(productTransitions[from], to)
+}
+
+// IsPublished reports whether the public sees the product at the given time:
+// active and inside its publish window.
+func (p Product) IsPublished(at time.Time) bool {
+	return p.Status == ProductActive &&
+		(p.PublishAt == nil || !p.PublishAt.After(at)) &&
+		(p.UnpublishAt == nil || p.UnpublishAt.After(at))
+}
+
+// Stock is the quantity on hand, zero when it was never set.
+func (p Product) Stock() int {
+	if p.Quantity == nil {
+		return 0
+	}
+	return *p.Quantity
+}
+
+// SortValue renders the value of a sortable field as cursor text.
+func (p Product) SortValue(field string) string {
+	switch field {
+	case "id":
+		return strconv.FormatInt(p.ID, 10)
+	case "price":
+		if p.BasePrice == nil {
+			return ""
+		}
+		return strconv.FormatInt(*p.BasePrice, 10)
+	case "quantity":
+		if p.Quantity == nil {
+			return "0"
+		}
+		return strconv.Itoa(*p.Quantity)
+	case "name":
+		return p.Name
+	case "relevance":
+		if p.Score == nil {
+			return "0"
+		}
+		return strconv.FormatFloat(*p.Score, 'g', -1, 64)
+	case "updated_at":
+		return p.UpdatedAt.Format(time.RFC3339Nano)
+	default:
+		return p.CreatedAt.Format(time.RFC3339Nano)
+	}
+}
+
+// ProductSuggestion is a product name close to a search term, for "did you
+// mean" hints.
+type ProductSuggestion struct {
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
+
+// ProductAutocomplete is a product whose name starts a word with the typed
+// prefix.
+type ProductAutocomplete struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// FacetCount is the number of listed products sharing one value of a facet.
+type FacetCount struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// PriceBucket is the number of listed products priced from Min up to but
+// excluding Max. The highest bucket has no Max.
+type PriceBucket struct {
+	Min   int64  `json:"min"`
+	Max   *int64 `json:"max,omitempty"`
+	Count int64  `json:"count"`
+}
+
+// StockCount is the number of listed products in and out of stock.
+type StockCount struct {
+	InStock    int64 `json:"in_stock"`
+	OutOfStock int64 `json:"out_of_stock"`
+}
+
+// ProductFacets holds the counts of the facets a listing asked for.
+type ProductFacets struct {
+	Brands     []FacetCount  `json:"brand,omitempty"`
+	Categories []FacetCount  `json:"category,omitempty"`
+	Prices     []PriceBucket `json:"price,omitempty"`
+	Stock      *StockCount   `json:"in_stock,omitempty"`
+}
+
 type FetchResult struct {
 	Products []Product `json:"products"`
 	Total    int64     `json:"total"`
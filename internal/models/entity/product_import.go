@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	ImportStatusPending    = "pending"
+	ImportStatusProcessing = "processing"
+	ImportStatusCompleted  = "completed"
+	ImportStatusFailed     = "failed"
+
+	ImportFormatCSV  = "csv"
+	ImportFormatXLSX = "xlsx"
+)
+
+// ProductImport is a catalog upload processed in the background. The uploaded
+// file is kept in Payload until the job finishes.
+type ProductImport struct {
+	ID            int64           `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
+	FileName      string          `json:"file_name"`
+	Format        string          `json:"format"`
+	Status        string          `json:"status"`
+	TotalRows     int             `json:"total_rows"`
+	ProcessedRows int             `json:"processed_rows"`
+	CreatedRows   int             `json:"created_rows"`
+	FailedRows    int             `json:"failed_rows"`
+	Message       string          `json:"message,omitempty"`
+	Errors        ImportRowErrors `json:"-" gorm:"type:jsonb"`
+	Payload       []byte          `json:"-"`
+	CreatedBy     string          `json:"created_by"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+	StartedAt     *time.Time      `json:"started_at"`
+	FinishedAt    *time.Time      `json:"finished_at"`
+}
+
+func (ProductImport) TableName() string {
+	return "product_imports"
+}
+
+// ImportRowError lists why a row of an import was not created. Row is the
+// line number in the file, the header being row 1.
+type ImportRowError struct {
+	Row    int      `json:"row"`
+	Errors []string `json:"errors"`
+}
+
+type ImportRowErrors []ImportRowError
+
+func (e ImportRowErrors) Value() (driver.Value, error) {
+	if e == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal(e)
+	return string(b), err
+}
+
+func (e *ImportRowErrors) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*e = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, e)
+	case string:
+		return json.Unmarshal([]byte(v), e)
+	default:
+		return errors.New("unsupported type for ImportRowErrors")
+	}
+}
@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+// Media content types accepted for upload.
+const (
+	MediaJPEG = "image/jpeg"
+	MediaPNG  = "image/png"
+	MediaWebP = "image/webp"
+)
+
+// MaxProductMedia is the number of images a product can have.
+const MaxProductMedia = 50
+
+// ProductMedia is an image of a product. The image and its thumbnail live in
+// the media storage; the keys stay internal and clients get their URLs.
+type ProductMedia struct {
+	ID           int64     `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
+	ProductID    int64     `json:"product_id"`
+	StorageKey   string    `json:"-"`
+	ThumbnailKey string    `json:"-"`
+	URL          string    `json:"url" gorm:"column:url"`
+	ThumbnailURL string    `json:"thumbnail_url" gorm:"column:thumbnail_url"`
+	ContentType  string    `json:"content_type"`
+	Size         int64     `json:"size"`
+	Width        int       `json:"width"`
+	Height       int       `json:"height"`
+	Position     int       `json:"position"`
+	IsPrimary    bool      `json:"is_primary"`
+	CreatedAt    time.Time `json:"created_at"`
+	CreatedBy    string    `json:"created_by"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	UpdatedBy    string    `json:"updated_by"`
+}
+
+func (ProductMedia) TableName() string {
+	return "product_media"
+}
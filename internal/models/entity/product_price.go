@@ -0,0 +1,52 @@
+package entity
+
+import "time"
+
+// Product price states, derived from the validity of a price.
+const (
+	PriceScheduled = "scheduled"
+	PriceCurrent   = "current"
+	PricePast      = "past"
+	// PriceFailed is a scheduled price the scheduler could not apply.
+	PriceFailed = "failed"
+)
+
+// ProductPrice is one entry of the price history of a product, in effect from
+// ValidFrom until ValidTo. A price that is not applied yet is a price change
+// scheduled for ValidFrom.
+type ProductPrice struct {
+	ID        int64      `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
+	ProductID int64      `json:"product_id"`
+	Price     int64      `json:"price"`
+	Currency  string     `json:"currency"`
+	ValidFrom time.Time  `json:"valid_from"`
+	ValidTo   *time.Time `json:"valid_to"`
+	AppliedAt *time.Time `json:"applied_at"`
+	// FailedAt and Failure are only written by the scheduler.
+	FailedAt *time.Time `json:"failed_at" gorm:"<-:update"`
+	// Failure tells why a failed price could not be applied.
+	Failure string `json:"failure,omitempty" gorm:"<-:update"`
+	// Status is scheduled, current, past or failed.
+	Status    string    `json:"status" gorm:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+}
+
+func (ProductPrice) TableName() string {
+	return "product_prices"
+}
+
+// PriceStatus tells whether the price is scheduled, in effect, superseded or
+// could not be applied.
+func (p ProductPrice) PriceStatus() string {
+	switch {
+	case p.FailedAt != nil:
+		return PriceFailed
+	case p.AppliedAt == nil:
+		return PriceScheduled
+	case p.ValidTo == nil:
+		return PriceCurrent
+	default:
+		return PricePast
+	}
+}
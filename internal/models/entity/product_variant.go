@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sort"
+	"time"
+)
+
+// ProductVariant is one purchasable combination of option values of a
+// product, with its own SKU, price and stock.
+type ProductVariant struct {
+	ID        int64          `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
+	ProductID int64          `json:"product_id"`
+	SKU       *string        `json:"sku"`
+	Options   VariantOptions `json:"options" gorm:"type:jsonb" swaggertype:"object,string"`
+	Price     *int64         `json:"price"`
+	Quantity  *int           `json:"quantity"`
+	// Pricing breaks the price down like the pricing of its product.
+	Pricing   *PriceBreakdown `json:"pricing,omitempty" gorm:"-"`
+	CreatedAt time.Time       `json:"created_at"`
+	CreatedBy string          `json:"created_by"`
+	UpdatedAt time.Time       `json:"updated_at"`
+	UpdatedBy string          `json:"updated_by"`
+}
+
+func (ProductVariant) TableName() string {
+	return "product_variants"
+}
+
+// VariantOptions maps each option axis of a variant to its value, e.g.
+// {"color": "Black", "storage": "256GB"}.
+type VariantOptions map[string]string
+
+// Axes returns the option names in alphabetical order.
+func (o VariantOptions) Axes() []string {
+	axes := make([]string, 0, len(o))
+	for axis := range o {
+		axes = append(axes, axis)
+	}
+	sort.Strings(axes)
+	return axes
+}
+
+func (o VariantOptions) Value() (driver.Value, error) {
+	if o == nil {
+		return "{}", nil
+	}
+	b, err := json.Marshal(o)
+	return string(b), err
+}
+
+func (o *VariantOptions) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*o = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, o)
+	case string:
+		return json.Unmarshal([]byte(v), o)
+	default:
+		return errors.New("unsupported type for VariantOptions")
+	}
+}
+
+// ProductOption is an option axis of a product with the values its variants
+// offer.
+type ProductOption struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// OptionsOf collects the option axes of variants, values in the order the
+// variants first use them.
+func OptionsOf(variants []ProductVariant) []ProductOption {
+	if len(variants) == 0 {
+		return nil
+	}
+
+	options := make([]ProductOption, 0, len(variants[0].Options))
+	for _, axis := range variants[0].Options.Axes() {
+		option := ProductOption{Name: axis}
+		for _, variant := range variants {
+			value, ok := variant.Options[axis]
+			if ok && !slices.Contains(option.Values, value) {
+				option.Values = append(option.Values, value)
+			}
+		}
+		options = append(options, option)
+	}
+	return options
+}
@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+// Stock movement types. Receipts and returns add stock, sales remove it and
+// adjustments correct it either way.
+const (
+	StockMovementReceipt    = "receipt"
+	StockMovementSale       = "sale"
+	StockMovementAdjustment = "adjustment"
+	StockMovementReturn     = "return"
+)
+
+// StockMovement is one entry of the append-only stock ledger of a product.
+// Quantity is the signed change and Balance the stock of the product after it.
+type StockMovement struct {
+	ID         int64     `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
+	ProductID  int64     `json:"product_id"`
+	LocationID int64     `json:"location_id"`
+	Type       string    `json:"type"`
+	Quantity   int       `json:"quantity"`
+	Balance    int       `json:"balance"`
+	Reason     string    `json:"reason"`
+	CreatedAt  time.Time `json:"created_at"`
+	CreatedBy  string    `json:"created_by"`
+}
+
+func (StockMovement) TableName() string {
+	return "stock_movements"
+}
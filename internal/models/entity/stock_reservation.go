@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// Stock reservation statuses. A reservation is active until it is committed
+// into a sale, released by the checkout or expired by the reaper.
+const (
+	ReservationActive    = "active"
+	ReservationCommitted = "committed"
+	ReservationReleased  = "released"
+	ReservationExpired   = "expired"
+)
+
+// StockReservation holds stock of a product for a checkout until ExpiresAt.
+type StockReservation struct {
+	ID         int64     `json:"id" gorm:"primaryKey;autoIncrement" readonly:"true"`
+	ProductID  int64     `json:"product_id"`
+	LocationID int64     `json:"location_id"`
+	Quantity   int       `json:"quantity"`
+	Status     string    `json:"status"`
+	Reference  string    `json:"reference"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	CreatedAt  time.Time `json:"created_at"`
+	CreatedBy  string    `json:"created_by"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	UpdatedBy  string    `json:"updated_by"`
+}
+
+func (StockReservation) TableName() string {
+	return "stock_reservations"
+}
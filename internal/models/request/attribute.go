@@ -0,0 +1,100 @@
+package request
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"erajaya-test/shared/constant"
+)
+
+type Attribute struct {
+	Code string `json:"code" validate:"required,max=64"`
+	Name string `json:"name" validate:"required,max=255"`
+	Type string `json:"type" validate:"required,oneof=string number enum boolean unit"`
+	// Unit is required for unit attributes, e.g. "GB" or "inch".
+	Unit string `json:"unit" validate:"max=32"`
+	// Options are the values of an enum attribute.
+	Options   []string `json:"options" validate:"max=100,dive,required,max=255"`
+	CreatedBy string   `json:"created_by" validate:"required"`
+}
+
+// AttributeUpdate renames an attribute and replaces its unit and options. The
+// code and type of an attribute never change.
+type AttributeUpdate struct {
+	Name      string   `json:"name" validate:"required,max=255"`
+	Unit      string   `json:"unit" validate:"max=32"`
+	Options   []string `json:"options" validate:"max=100,dive,required,max=255"`
+	UpdatedBy string   `json:"updated_by" validate:"required"`
+}
+
+// CategoryAttributes replaces the attributes a category defines. An empty list
+// leaves the category with the attributes of its ancestors only.
+type CategoryAttributes struct {
+	AttributeIDs []int64 `json:"attribute_ids" validate:"max=100,dive,gt=0"`
+}
+
+// ProductAttributes replaces the attribute values of a product, by attribute
+// code. An empty map removes every value.
+type ProductAttributes struct {
+	Attributes map[string]interface{} `json:"attributes" validate:"max=100" swaggertype:"object"`
+	UpdatedBy  string                 `json:"updated_by" validate:"required"`
+}
+
+// Operators of an attribute filter.
+const (
+	AttributeEqual        = "="
+	AttributeGreater      = ">"
+	AttributeGreaterEqual = ">="
+	AttributeLess         = "<"
+	AttributeLessEqual    = "<="
+)
+
+// AttributeCodePattern is the form of attribute codes: lower case letters,
+// digits and underscores, starting with a letter.
+var AttributeCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// AttributeCondition is an attribute filter checked against the definition of
+// its attribute. Values hold strings, float64s or bools; "=" matches any of
+// them, the other operators compare with the single one.
+type AttributeCondition struct {
+	Code     string
+	Operator string
+	Values   []interface{}
+}
+
+// ParseAttributeFilter splits a filter such as "ram_gb>=8" or
+// "color=Black,White" into the attribute code, the operator and the values.
+func ParseAttributeFilter(filter string) (string, string, []string, error) {
+	at := strings.IndexAny(filter, "<>=")
+	if at < 0 {
+		return "", "", nil, fmt.Errorf("%w: attribute filter %q needs an operator", constant.ErrValidation, filter)
+	}
+
+	code := strings.TrimSpace(filter[:at])
+	if !AttributeCodePattern.MatchString(code) {
+		return "", "", nil, fmt.Errorf("%w: attribute filter %q names no valid attribute code", constant.ErrValidation, filter)
+	}
+
+	operator := filter[at : at+1]
+	if operator != AttributeEqual && strings.HasPrefix(filter[at+1:], "=") {
+		operator += "="
+	}
+
+	var values []string
+	raw := filter[at+len(operator):]
+	if operator == AttributeEqual {
+		for _, value := range strings.Split(raw, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	} else if value := strings.TrimSpace(raw); value != "" {
+		values = []string{value}
+	}
+	if len(values) == 0 {
+		return "", "", nil, fmt.Errorf("%w: attribute filter %q needs a value", constant.ErrValidation, filter)
+	}
+
+	return code, operator, values, nil
+}
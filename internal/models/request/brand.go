@@ -0,0 +1,11 @@
+package request
+
+type Brand struct {
+	Name      string `json:"name" validate:"required,max=255"`
+	CreatedBy string `json:"created_by" validate:"required"`
+}
+
+type BrandUpdate struct {
+	Name      string `json:"name" validate:"required,max=255"`
+	UpdatedBy string `json:"updated_by" validate:"required"`
+}
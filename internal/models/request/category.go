@@ -0,0 +1,22 @@
+package request
+
+type Category struct {
+	Name      string `json:"name" validate:"required,max=255"`
+	ParentID  *int64 `json:"parent_id" validate:"omitempty,gt=0"`
+	CreatedBy string `json:"created_by" validate:"required"`
+}
+
+// CategoryUpdate renames a category and moves it, with its whole subtree,
+// under ParentID. A nil ParentID makes it a root.
+type CategoryUpdate struct {
+	Name      string `json:"name" validate:"required,max=255"`
+	ParentID  *int64 `json:"parent_id" validate:"omitempty,gt=0"`
+	UpdatedBy string `json:"updated_by" validate:"required"`
+}
+
+// ProductCategories replaces the categories of a product. An empty list
+// removes the product from every category.
+type ProductCategories struct {
+	CategoryIDs []int64 `json:"category_ids" validate:"max=50,dive,gt=0"`
+	UpdatedBy   string  `json:"updated_by" validate:"required"`
+}
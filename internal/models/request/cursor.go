@@ -0,0 +1,30 @@
+package request
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// ProductCursor marks the last product of a keyset page. Values holds the sort
+// fields of that product rendered as text, in sort order.
+type ProductCursor struct {
+	Sort   string   `json:"s"`
+	Values []string `json:"v"`
+}
+
+func EncodeProductCursor(cursor ProductCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeProductCursor(encoded string) (ProductCursor, error) {
+	var cursor ProductCursor
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return cursor, err
+	}
+
+	err = json.Unmarshal(data, &cursor)
+	return cursor, err
+}
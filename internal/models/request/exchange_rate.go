@@ -0,0 +1,16 @@
+package request
+
+// ExchangeRateLoad loads the exchange rates of a set of currencies, adding
+// the currencies not known yet.
+type ExchangeRateLoad struct {
+	Rates     []ExchangeRate `json:"rates" validate:"required,min=1,max=200,dive"`
+	UpdatedBy string         `json:"updated_by" validate:"required"`
+}
+
+// ExchangeRate is what one unit of Currency is worth in the base currency.
+// MinorUnits is the number of decimals prices in the currency are kept in.
+type ExchangeRate struct {
+	Currency   string  `json:"currency" validate:"required,iso4217"`
+	Rate       float64 `json:"rate" validate:"required,gt=0"`
+	MinorUnits *int    `json:"minor_units" validate:"required,min=0,max=4"`
+}
@@ -0,0 +1,17 @@
+package request
+
+type Location struct {
+	Code      string `json:"code" validate:"required,max=32"`
+	Name      string `json:"name" validate:"required,max=255"`
+	Type      string `json:"type" validate:"required,oneof=warehouse store"`
+	Address   string `json:"address"`
+	CreatedBy string `json:"created_by" validate:"required"`
+}
+
+type LocationUpdate struct {
+	Code      string `json:"code" validate:"required,max=32"`
+	Name      string `json:"name" validate:"required,max=255"`
+	Type      string `json:"type" validate:"required,oneof=warehouse store"`
+	Address   string `json:"address"`
+	UpdatedBy string `json:"updated_by" validate:"required"`
+}
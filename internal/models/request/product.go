@@ -1,16 +1,116 @@
 package request
 
+import "time"
+
+// Product creates a product. Quantity is its opening stock, which afterwards
+// only changes through stock movements.
 type Product struct {
-	Name        string `json:"name" validate:"required"`
-	Price       *int64 `json:"price" validate:"required"`
+	Name    string `json:"name" validate:"required"`
+	SKU     string `json:"sku" validate:"omitempty,max=64"`
+	Barcode string `json:"barcode" validate:"omitempty,barcode"`
+	BrandID *int64 `json:"brand_id" validate:"omitempty,gt=0"`
+	Price   *int64 `json:"price" validate:"required"`
+	// Currency of the price, the base currency when omitted.
+	Currency    string `json:"currency" validate:"omitempty,iso4217"`
+	Description string `json:"description" validate:"required"`
+	Quantity    *int   `json:"quantity" validate:"required,min=0"`
+	// Status the product starts in, active when omitted.
+	Status      string     `json:"status" validate:"omitempty,oneof=draft active"`
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+	CreatedBy   string     `json:"created_by" validate:"required"`
+}
+
+const (
+	// BulkModeAtomic inserts every product or none of them.
+	BulkModeAtomic = "atomic"
+	// BulkModeBestEffort inserts the valid products and reports the rest.
+	BulkModeBestEffort = "best_effort"
+)
+
+type ProductBulk struct {
+	Mode     string    `json:"mode" validate:"omitempty,oneof=atomic best_effort"`
+	Products []Product `json:"products" validate:"required,min=1,max=1000"`
+}
+
+type ProductUpdate struct {
+	Name    string `json:"name" validate:"required"`
+	SKU     string `json:"sku" validate:"omitempty,max=64"`
+	Barcode string `json:"barcode" validate:"omitempty,barcode"`
+	BrandID *int64 `json:"brand_id" validate:"omitempty,gt=0"`
+	Price   *int64 `json:"price" validate:"required"`
+	// Currency of the price, unchanged when omitted.
+	Currency    string `json:"currency" validate:"omitempty,iso4217"`
 	Description string `json:"description" validate:"required"`
-	Quantity    *int   `json:"quantity" validate:"required"`
-	CreatedBy   string `json:"created_by" validate:"required"`
+	UpdatedBy   string `json:"updated_by" validate:"required"`
+}
+
+type ProductDelete struct {
+	DeletedBy string `json:"deleted_by" query:"deleted_by" validate:"required"`
+}
+
+type ProductRestore struct {
+	UpdatedBy string `json:"updated_by" validate:"required"`
+}
+
+// ProductStatus moves a product to another status and replaces its publish
+// window; an omitted bound leaves that end of the window open.
+type ProductStatus struct {
+	Status      string     `json:"status" validate:"required,oneof=draft active archived discontinued"`
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+	UpdatedBy   string     `json:"updated_by" validate:"required"`
 }
 
 type ProductFilter struct {
-	Search string `json:"search"`
-	Sort   string `json:"sort"`
-	Page   int    `json:"page"`
-	Limit  int    `json:"limit"`
+	Search         string `json:"search"`
+	Sort           string `json:"sort"`
+	Page           int    `json:"page"`
+	Limit          int    `json:"limit"`
+	IncludeDeleted bool   `json:"include_deleted"`
+	// Published selects only what public callers see: active products inside
+	// their publish window.
+	Published bool   `json:"published"`
+	Cursor    string `json:"cursor"`
+	SkipTotal bool   `json:"skip_total"`
+
+	// MinPrice and MaxPrice bound the price in the base currency.
+	MinPrice    *int64     `json:"min_price" validate:"omitempty,min=0"`
+	MaxPrice    *int64     `json:"max_price" validate:"omitempty,min=0"`
+	InStock     *bool      `json:"in_stock"`
+	CreatedFrom *time.Time `json:"created_from"`
+	CreatedTo   *time.Time `json:"created_to"`
+	CreatedBy   string     `json:"created_by" validate:"max=255"`
+	IDs         []int64    `json:"ids" validate:"max=100,dive,gt=0"`
+	// Category selects the products of a category and of all its descendants.
+	Category *int64  `json:"category" validate:"omitempty,gt=0"`
+	BrandIDs []int64 `json:"brand_id" validate:"max=50,dive,gt=0"`
+	// LocationID selects the products kept at a location and makes InStock
+	// refer to the stock there.
+	LocationID *int64   `json:"location_id" validate:"omitempty,gt=0"`
+	Facets     []string `json:"facets" validate:"max=4,dive,oneof=brand category price in_stock"`
+	Statuses   []string `json:"status" validate:"max=4,dive,oneof=draft active archived discontinued"`
+	// Attributes filters by attribute values, e.g. "ram_gb>=8" or
+	// "color=Black,White" (see ParseAttributeFilter).
+	Attributes []string `json:"attributes" validate:"max=10"`
+	// AttributeConditions are the Attributes checked against the attribute
+	// definitions, set by the usecase and read by the repository.
+	AttributeConditions []AttributeCondition `json:"-" url:"-"`
 }
+
+// Facets a listing can ask counts for.
+const (
+	// FacetBrand counts the products per brand.
+	FacetBrand = "brand"
+	// FacetCategory counts the products per subcategory of the selected
+	// category, or per root category when none is selected.
+	FacetCategory = "category"
+	// FacetPrice counts the products per price bucket of PriceFacetBounds.
+	FacetPrice = "price"
+	// FacetInStock counts the products in and out of stock.
+	FacetInStock = "in_stock"
+)
+
+// PriceFacetBounds are the prices in the base currency at which one price
+// bucket ends and the next begins.
+var PriceFacetBounds = []int64{1000000, 5000000, 10000000, 20000000}
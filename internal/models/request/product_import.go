@@ -0,0 +1,9 @@
+package request
+
+// ProductImport is a catalog upload. FileName and Content come from the
+// multipart file rather than the form fields.
+type ProductImport struct {
+	CreatedBy string `json:"created_by" form:"created_by" validate:"required,max=255"`
+	FileName  string `json:"-" form:"-"`
+	Content   []byte `json:"-" form:"-"`
+}
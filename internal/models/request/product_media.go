@@ -0,0 +1,21 @@
+package request
+
+// ProductMediaUpload is an image uploaded for a product. FileName and Content
+// come from the multipart file rather than the form fields.
+type ProductMediaUpload struct {
+	IsPrimary bool   `json:"is_primary" form:"is_primary"`
+	CreatedBy string `json:"created_by" form:"created_by" validate:"required,max=255"`
+	FileName  string `json:"-" form:"-"`
+	Content   []byte `json:"-" form:"-"`
+}
+
+// ProductMediaOrder lists every image of a product in its new order.
+type ProductMediaOrder struct {
+	MediaIDs  []int64 `json:"media_ids" validate:"required,min=1,max=50,dive,gt=0"`
+	UpdatedBy string  `json:"updated_by" validate:"required"`
+}
+
+// ProductMediaPrimary makes an image the primary image of its product.
+type ProductMediaPrimary struct {
+	UpdatedBy string `json:"updated_by" validate:"required"`
+}
@@ -0,0 +1,13 @@
+package request
+
+import "time"
+
+// ProductPriceSchedule schedules a price change of a product for ValidFrom,
+// which has to be in the future. Currency defaults to the currency of the
+// product.
+type ProductPriceSchedule struct {
+	Price     *int64     `json:"price" validate:"required,min=0"`
+	Currency  string     `json:"currency" validate:"omitempty,iso4217"`
+	ValidFrom *time.Time `json:"valid_from" validate:"required"`
+	CreatedBy string     `json:"created_by" validate:"required"`
+}
@@ -0,0 +1,17 @@
+package request
+
+type ProductVariant struct {
+	SKU       string            `json:"sku" validate:"omitempty,max=64"`
+	Options   map[string]string `json:"options" validate:"required,min=1,max=3,dive,keys,required,max=50,endkeys,required,max=100"`
+	Price     *int64            `json:"price" validate:"required"`
+	Quantity  *int              `json:"quantity" validate:"required"`
+	CreatedBy string            `json:"created_by" validate:"required"`
+}
+
+type ProductVariantUpdate struct {
+	SKU       string            `json:"sku" validate:"omitempty,max=64"`
+	Options   map[string]string `json:"options" validate:"required,min=1,max=3,dive,keys,required,max=50,endkeys,required,max=100"`
+	Price     *int64            `json:"price" validate:"required"`
+	Quantity  *int              `json:"quantity" validate:"required"`
+	UpdatedBy string            `json:"updated_by" validate:"required"`
+}
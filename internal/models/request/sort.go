@@ -0,0 +1,106 @@
+package request
+
+import (
+	"fmt"
+	"strings"
+
+	"erajaya-test/shared/constant"
+)
+
+// productSortColumns whitelists the fields a product listing can be sorted by
+// and the SQL expression each one orders on.
+var productSortColumns = map[string]string{
+	"id":         "id",
+	"name":       "name",
+	"price":      "base_price",
+	"quantity":   "COALESCE(quantity, 0)",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"relevance":  "score",
+}
+
+// ProductSortFields lists the sortable fields in the order they are reported.
+var ProductSortFields = []string{"name", "price", "quantity", "created_at", "updated_at", "id", "relevance"}
+
+// productSortAliases keeps the sort options offered before the field grammar.
+var productSortAliases = map[string]string{
+	"":          "-created_at",
+	"newest":    "-created_at",
+	"cheapest":  "price",
+	"expensive": "-price",
+	"name asc":  "name",
+	"name desc": "-name",
+	"relevance": "-relevance",
+}
+
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// Column returns the SQL expression the field orders on.
+func (f SortField) Column() string {
+	return productSortColumns[f.Field]
+}
+
+// Relevance reports whether the field ranks by search score, which is only
+// defined while searching.
+func (f SortField) Relevance() bool {
+	return f.Field == "relevance"
+}
+
+func (f SortField) String() string {
+	if f.Desc {
+		return "-" + f.Field
+	}
+	return f.Field
+}
+
+// ParseProductSort reads a comma separated list of fields, each optionally
+// prefixed with "-" for descending order, e.g. "price,-created_at". An id
+// tiebreaker in the direction of the last field is appended unless id is
+// already listed, so every order is total. Ranking by relevance needs a search
+// term to score against.
+func ParseProductSort(sort string, search string) ([]SortField, error) {
+	sort = strings.TrimSpace(sort)
+	if alias, ok := productSortAliases[sort]; ok {
+		sort = alias
+	}
+
+	var fields []SortField
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(sort, ",") {
+		part = strings.TrimSpace(part)
+
+		field := SortField{Field: strings.TrimLeft(part, "+-"), Desc: strings.HasPrefix(part, "-")}
+		if _, ok := productSortColumns[field.Field]; !ok {
+			return nil, fmt.Errorf("%w: sort field %q is not allowed, use one of %s", constant.ErrValidation, field.Field, strings.Join(ProductSortFields, ", "))
+		}
+		if seen[field.Field] {
+			return nil, fmt.Errorf("%w: sort field %q is listed more than once", constant.ErrValidation, field.Field)
+		}
+		seen[field.Field] = true
+
+		fields = append(fields, field)
+	}
+
+	if seen["relevance"] && search == "" {
+		return nil, fmt.Errorf("%w: sort field \"relevance\" requires search", constant.ErrValidation)
+	}
+
+	if !seen["id"] {
+		fields = append(fields, SortField{Field: "id", Desc: fields[len(fields)-1].Desc})
+	}
+
+	return fields, nil
+}
+
+// FormatProductSort renders parsed fields back into the canonical grammar, so
+// equivalent sort expressions share cache entries and cursors.
+func FormatProductSort(fields []SortField) string {
+	parts := make([]string, len(fields))
+	for i, field := range fields {
+		parts[i] = field.String()
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,13 @@
+package request
+
+// StockMovement posts a change to the stock of a product. Quantity counts the
+// units received, sold or returned, or is the signed correction of an
+// adjustment, which also needs a reason. Without a location the movement is
+// posted at the default location.
+type StockMovement struct {
+	Type       string `json:"type" validate:"required,oneof=receipt sale adjustment return"`
+	Quantity   int    `json:"quantity" validate:"required"`
+	LocationID *int64 `json:"location_id" validate:"omitempty,gt=0"`
+	Reason     string `json:"reason" validate:"max=255"`
+	CreatedBy  string `json:"created_by" validate:"required"`
+}
@@ -0,0 +1,20 @@
+package request
+
+// DefaultReservationTTL is how long a reservation holds stock, in seconds,
+// when the checkout does not ask for a TTL.
+const DefaultReservationTTL = 15 * 60
+
+// StockReservation holds stock of a product for a checkout, at the default
+// location unless it names one. TTL is in seconds, at most a day.
+type StockReservation struct {
+	Quantity   int    `json:"quantity" validate:"required,min=1"`
+	LocationID *int64 `json:"location_id" validate:"omitempty,gt=0"`
+	TTL        int    `json:"ttl" validate:"omitempty,min=1,max=86400"`
+	Reference  string `json:"reference" validate:"max=255"`
+	CreatedBy  string `json:"created_by" validate:"required"`
+}
+
+// StockReservationResolve commits or releases a reservation.
+type StockReservationResolve struct {
+	UpdatedBy string `json:"updated_by" validate:"required"`
+}
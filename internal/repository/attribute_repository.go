@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type attributeRepository struct {
+	db *gorm.DB
+}
+
+func NewAttributeRepository(db *gorm.DB) interfaces.AttributeRepository {
+	return &attributeRepository{
+		db: db,
+	}
+}
+
+func (r *attributeRepository) Create(ctx context.Context, attribute *entity.Attribute) error {
+	return constraintViolation(r.db.WithContext(ctx).Create(attribute).Error)
+}
+
+func (r *attributeRepository) GetByID(ctx context.Context, id int64) (*entity.Attribute, error) {
+	var attribute entity.Attribute
+	err := r.db.WithContext(ctx).First(&attribute, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return &attribute, nil
+}
+
+// Fetch returns every attribute ordered by code.
+func (r *attributeRepository) Fetch(ctx context.Context) ([]entity.Attribute, error) {
+	var attributes []entity.Attribute
+	err := r.db.WithContext(ctx).Order("code").Find(&attributes).Error
+	return attributes, err
+}
+
+// FetchByCodes returns the attributes with the given codes; unknown codes are
+// left out.
+func (r *attributeRepository) FetchByCodes(ctx context.Context, codes []string) ([]entity.Attribute, error) {
+	var attributes []entity.Attribute
+	err := r.db.WithContext(ctx).Where("code IN ?", codes).Order("code").Find(&attributes).Error
+	return attributes, err
+}
+
+// Update renames an attribute and replaces its unit and options, then reads
+// back the stored row. An option still held by a product, soft deleted ones
+// included, cannot be removed.
+func (r *attributeRepository) Update(ctx context.Context, attribute *entity.Attribute) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current entity.Attribute
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, attribute.ID).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return constant.ErrNotFound
+			}
+			return err
+		}
+
+		var removed []string
+		for _, option := range current.Options {
+			if !slices.Contains(attribute.Options, option) {
+				removed = append(removed, option)
+			}
+		}
+		if len(removed) > 0 {
+			var used int64
+			err := tx.Unscoped().Model(&entity.Product{}).
+				Where("attributes ->> ? IN ?", current.Code, removed).
+				Count(&used).Error
+			if err != nil {
+				return err
+			}
+			if used > 0 {
+				return fmt.Errorf("%w: products still hold an option the update removes", constant.ErrConflict)
+			}
+		}
+
+		return tx.Model(attribute).Clauses(clause.Returning{}).Updates(map[string]interface{}{
+			"name":       attribute.Name,
+			"unit":       attribute.Unit,
+			"options":    attribute.Options,
+			"updated_at": attribute.UpdatedAt,
+			"updated_by": attribute.UpdatedBy,
+		}).Error
+	})
+}
+
+// Delete removes an attribute no product holds a value of anymore, soft
+// deleted products included. Its category links are removed by the foreign
+// key.
+func (r *attributeRepository) Delete(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var attribute entity.Attribute
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&attribute, id).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return constant.ErrNotFound
+			}
+			return err
+		}
+
+		var used int64
+		if err := tx.Unscoped().Model(&entity.Product{}).Where("attributes -> ? IS NOT NULL", attribute.Code).Count(&used).Error; err != nil {
+			return err
+		}
+		if used > 0 {
+			return fmt.Errorf("%w: products still hold a value of the attribute", constant.ErrConflict)
+		}
+
+		return tx.Delete(&attribute).Error
+	})
+}
+
+// FetchByCategory returns the attributes that apply to the products of a
+// category: its own and those of its ancestors.
+func (r *attributeRepository) FetchByCategory(ctx context.Context, categoryID int64) ([]entity.Attribute, error) {
+	db := r.db.WithContext(ctx)
+
+	var categories int64
+	if err := db.Model(&entity.Category{}).Where("id = ?", categoryID).Count(&categories).Error; err != nil {
+		return nil, err
+	}
+	if categories == 0 {
+		return nil, constant.ErrNotFound
+	}
+
+	var attributes []entity.Attribute
+	err := db.Where(`id IN (SELECT category_attributes.attribute_id FROM category_attributes
+		JOIN categories ON category_attributes.category_id = ANY(string_to_array(trim(both '/' from categories.path), '/')::bigint[])
+		WHERE categories.id = ?)`, categoryID).
+		Order("code").
+		Find(&attributes).Error
+	return attributes, err
+}
+
+// SetCategoryAttributes replaces the attributes a category defines.
+func (r *attributeRepository) SetCategoryAttributes(ctx context.Context, categoryID int64, attributeIDs []int64) error {
+	attributeIDs = slices.Compact(slices.Sorted(slices.Values(attributeIDs)))
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var categories int64
+		if err := tx.Model(&entity.Category{}).Where("id = ?", categoryID).Count(&categories).Error; err != nil {
+			return err
+		}
+		if categories == 0 {
+			return constant.ErrNotFound
+		}
+
+		if len(attributeIDs) > 0 {
+			var found []int64
+			if err := tx.Model(&entity.Attribute{}).Where("id IN ?", attributeIDs).Pluck("id", &found).Error; err != nil {
+				return err
+			}
+			for _, id := range attributeIDs {
+				if !slices.Contains(found, id) {
+					return fmt.Errorf("%w: attribute %d does not exist", constant.ErrValidation, id)
+				}
+			}
+		}
+
+		if err := tx.Where("category_id = ?", categoryID).Delete(&entity.CategoryAttribute{}).Error; err != nil {
+			return err
+		}
+		if len(attributeIDs) == 0 {
+			return nil
+		}
+
+		links := make([]entity.CategoryAttribute, len(attributeIDs))
+		for i, id := range attributeIDs {
+			links[i] = entity.CategoryAttribute{CategoryID: categoryID, AttributeID: id}
+		}
+		return tx.Create(&links).Error
+	})
+}
+
+// FetchByProduct returns the attributes that apply to a product: those of its
+// categories and of their ancestors.
+func (r *attributeRepository) FetchByProduct(ctx context.Context, productID int64) ([]entity.Attribute, error) {
+	db := r.db.WithContext(ctx)
+
+	var products int64
+	if err := db.Model(&entity.Product{}).Where("id = ?", productID).Count(&products).Error; err != nil {
+		return nil, err
+	}
+	if products == 0 {
+		return nil, constant.ErrNotFound
+	}
+
+	var attributes []entity.Attribute
+	err := db.Where(`id IN (SELECT category_attributes.attribute_id FROM category_attributes
+		JOIN categories ON category_attributes.category_id = ANY(string_to_array(trim(both '/' from categories.path), '/')::bigint[])
+		JOIN product_categories ON product_categories.category_id = categories.id
+		WHERE product_categories.product_id = ?)`, productID).
+		Order("code").
+		Find(&attributes).Error
+	return attributes, err
+}
+
+// SetProductValues replaces the attribute values of a product and bumps its
+// version, so its ETag changes with them. With a version above 0 the locked
+// row must still be at that version. It goes through the table rather than
+// the model, which only reads the values. The change is audited in the same
+// transaction and the product is returned as written.
+func (r *attributeRepository) SetProductValues(ctx context.Context, productID int64, version int64, values entity.ProductAttributes, updatedBy string, at time.Time) (*entity.Product, error) {
+	var after entity.Product
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		before, err := lockProduct(tx, productID)
+		if err != nil {
+			return err
+		}
+		if version > 0 && before.Version != version {
+			return constant.ErrVersionMismatch
+		}
+
+		err = tx.Table("products").Where("id = ?", productID).UpdateColumns(map[string]interface{}{
+			"attributes": values,
+			"updated_at": at,
+			"updated_by": updatedBy,
+			"version":    gorm.Expr("version + 1"),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		// The row is locked, so the written version follows from the read one.
+		after = *before
+		after.Attributes = values
+		after.UpdatedAt = at
+		after.UpdatedBy = updatedBy
+		after.Version++
+		return recordAudit(tx, []entity.AuditEvent{productAuditEvent(ctx, entity.AuditUpdate, updatedBy, before, &after)})
+	})
+	if errors.Is(err, errNothingUpdated) {
+		return nil, constant.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &after, nil
+}
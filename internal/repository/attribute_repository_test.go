@@ -0,0 +1,304 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/middlewares"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type AttributeSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo interfaces.AttributeRepository
+	db   *sql.DB
+}
+
+func (s *AttributeSuite) SetupTest() {
+	var err error
+
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       s.db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = NewAttributeRepository(gormDB)
+}
+
+func (s *AttributeSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *AttributeSuite) TestCreate() {
+	s.Run("Success", func() {
+		attribute := &entity.Attribute{Code: "color", Name: "Color", Type: entity.AttributeEnum, Options: entity.AttributeOptions{"Black", "White"}}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attributes"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Create(context.Background(), attribute)
+		s.NoError(err)
+		s.Equal(int64(1), attribute.ID)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Duplicate Code", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attributes"`)).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_attributes_code_unique"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Create(context.Background(), &entity.Attribute{Code: "color"})
+		s.ErrorIs(err, constant.ErrConflict)
+	})
+}
+
+func (s *AttributeSuite) TestFetchByCodes() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "attributes" WHERE code IN ($1,$2) ORDER BY code`)).
+		WithArgs("color", "ram_gb").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code", "type", "options"}).
+			AddRow(1, "color", "enum", `["Black","White"]`).
+			AddRow(2, "ram_gb", "unit", `[]`))
+
+	attributes, err := s.repo.FetchByCodes(context.Background(), []string{"color", "ram_gb"})
+	s.NoError(err)
+	s.Len(attributes, 2)
+	s.Equal(entity.AttributeOptions{"Black", "White"}, attributes[0].Options)
+	s.True(attributes[1].Numeric())
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *AttributeSuite) TestUpdate() {
+	lockQuery := `SELECT * FROM "attributes" WHERE "attributes"."id" = $1 ORDER BY "attributes"."id" LIMIT $2 FOR UPDATE`
+	current := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "code", "type", "options"}).AddRow(1, "color", "enum", `["Black","White"]`)
+	}
+
+	s.Run("Success", func() {
+		attribute := &entity.Attribute{ID: 1, Name: "Colour", Options: entity.AttributeOptions{"Black", "White", "Blue"}, UpdatedBy: "arya", UpdatedAt: time.Now()}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).WithArgs(1, 1).WillReturnRows(current())
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "attributes" SET`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "code", "name", "type"}).AddRow(1, "color", "Colour", "enum"))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Update(context.Background(), attribute)
+		s.NoError(err)
+		s.Equal("color", attribute.Code)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Removed Option In Use", func() {
+		attribute := &entity.Attribute{ID: 1, Name: "Color", Options: entity.AttributeOptions{"Black"}}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).WithArgs(1, 1).WillReturnRows(current())
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE attributes ->> $1 IN ($2)`)).
+			WithArgs("color", "White").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Update(context.Background(), attribute)
+		s.ErrorIs(err, constant.ErrConflict)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).WillReturnError(gorm.ErrRecordNotFound)
+		s.mock.ExpectRollback()
+
+		err := s.repo.Update(context.Background(), &entity.Attribute{ID: 9})
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *AttributeSuite) TestDelete() {
+	lockQuery := `SELECT * FROM "attributes" WHERE "attributes"."id" = $1 ORDER BY "attributes"."id" LIMIT $2 FOR UPDATE`
+	usedQuery := `SELECT count(*) FROM "products" WHERE attributes -> $1 IS NOT NULL`
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "code"}).AddRow(1, "color"))
+		s.mock.ExpectQuery(regexp.QuoteMeta(usedQuery)).
+			WithArgs("color").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		s.mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "attributes" WHERE "attributes"."id" = $1`)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Delete(context.Background(), 1)
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("In Use", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "code"}).AddRow(1, "color"))
+		s.mock.ExpectQuery(regexp.QuoteMeta(usedQuery)).
+			WithArgs("color").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Delete(context.Background(), 1)
+		s.ErrorIs(err, constant.ErrConflict)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+}
+
+func (s *AttributeSuite) TestFetchByCategory() {
+	s.Run("Success", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "categories" WHERE id = $1`)).
+			WithArgs(4).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "attributes" WHERE id IN (SELECT category_attributes.attribute_id FROM category_attributes`)).
+			WithArgs(4).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "code"}).AddRow(1, "color").AddRow(2, "ram_gb"))
+
+		attributes, err := s.repo.FetchByCategory(context.Background(), 4)
+		s.NoError(err)
+		s.Len(attributes, 2)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Missing Category", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "categories" WHERE id = $1`)).
+			WithArgs(9).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		_, err := s.repo.FetchByCategory(context.Background(), 9)
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+}
+
+func (s *AttributeSuite) TestSetCategoryAttributes() {
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "categories" WHERE id = $1`)).
+			WithArgs(4).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "attributes" WHERE id IN ($1,$2)`)).
+			WithArgs(1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+		s.mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "category_attributes" WHERE category_id = $1`)).
+			WithArgs(4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "category_attributes" ("category_id","attribute_id") VALUES ($1,$2),($3,$4)`)).
+			WithArgs(4, 1, 4, 2).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		s.mock.ExpectCommit()
+
+		err := s.repo.SetCategoryAttributes(context.Background(), 4, []int64{2, 1, 2})
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Unknown Attribute", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "categories" WHERE id = $1`)).
+			WithArgs(4).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "attributes" WHERE id IN ($1)`)).
+			WithArgs(7).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+
+		err := s.repo.SetCategoryAttributes(context.Background(), 4, []int64{7})
+		s.ErrorIs(err, constant.ErrValidation)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+}
+
+func (s *AttributeSuite) TestFetchByProduct() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE id = $1 AND "products"."deleted_at" IS NULL`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	s.mock.ExpectQuery(regexp.QuoteMeta(`JOIN product_categories ON product_categories.category_id = categories.id`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code"}).AddRow(2, "ram_gb"))
+
+	attributes, err := s.repo.FetchByProduct(context.Background(), 1)
+	s.NoError(err)
+	s.Len(attributes, 1)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *AttributeSuite) TestSetProductValues() {
+	at := time.Now()
+	updateQuery := `UPDATE "products" SET "attributes"=$1,"updated_at"=$2,"updated_by"=$3,"version"=version + 1 WHERE id = $4`
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "attributes", "version"}).AddRow(1, "LG TV", `{"ram_gb":4,"color":"Black"}`, 2))
+		s.mock.ExpectExec(regexp.QuoteMeta(updateQuery)).
+			WithArgs(`{"color":"Black","ram_gb":8}`, at, "arya", 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events" ("entity_type","entity_id","action","actor","request_id","before","after","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+			WithArgs("product", 1, "update", "arya", "req-1", `{"attributes":{"color":"Black","ram_gb":4}}`, `{"attributes":{"color":"Black","ram_gb":8}}`, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		ctx := context.WithValue(context.Background(), middlewares.CtxRequestID, "req-1")
+		product, err := s.repo.SetProductValues(ctx, 1, 2, entity.ProductAttributes{"ram_gb": float64(8), "color": "Black"}, "arya", at)
+		s.NoError(err)
+		s.Equal(int64(3), product.Version)
+		s.Equal(entity.ProductAttributes{"ram_gb": float64(8), "color": "Black"}, product.Attributes)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Version Mismatch", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 3))
+		s.mock.ExpectRollback()
+
+		product, err := s.repo.SetProductValues(context.Background(), 1, 2, entity.ProductAttributes{}, "arya", at)
+		s.ErrorIs(err, constant.ErrVersionMismatch)
+		s.Nil(product)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(9, 1).
+			WillReturnError(gorm.ErrRecordNotFound)
+		s.mock.ExpectRollback()
+
+		_, err := s.repo.SetProductValues(context.Background(), 9, 0, entity.ProductAttributes{}, "arya", at)
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+}
+
+func TestAttributeSuite(t *testing.T) {
+	suite.Run(t, new(AttributeSuite))
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/middlewares"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// purgeActor is recorded as the actor of the products the purge job removes.
+const purgeActor = "system"
+
+// auditBatchSize bounds the audit events inserted per statement.
+const auditBatchSize = 500
+
+// lockProduct reads a product before a write changes it and locks its row
+// until the transaction ends, so the audit event compares against the row the
+// write replaced. A missing product ends the write like one that matched no
+// row.
+func lockProduct(query *gorm.DB, id int64) (*entity.Product, error) {
+	var product entity.Product
+	err := query.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).Take(&product).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errNothingUpdated
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// recordAudit inserts audit events in the transaction of the write they
+// describe.
+func recordAudit(tx *gorm.DB, events []entity.AuditEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return tx.CreateInBatches(&events, auditBatchSize).Error
+}
+
+// productAuditEvent describes a write to a product by actor. before is nil for
+// a created product and after for a purged one; of the others only the fields
+// that differ are kept.
+func productAuditEvent(ctx context.Context, action string, actor string, before *entity.Product, after *entity.Product) entity.AuditEvent {
+	event := entity.AuditEvent{
+		EntityType: entity.AuditProduct,
+		Action:     action,
+		Actor:      actor,
+	}
+	event.RequestID, _ = ctx.Value(middlewares.CtxRequestID).(string)
+
+	var beforeValues, afterValues entity.AuditValues
+	if before != nil {
+		event.EntityID = before.ID
+		beforeValues = productAuditValues(before)
+	}
+	if after != nil {
+		event.EntityID = after.ID
+		afterValues = productAuditValues(after)
+	}
+	event.Before, event.After = auditDiff(beforeValues, afterValues)
+	return event
+}
+
+// productAuditValues holds the columns of a product a write can change.
+// updated_at, updated_by and version change on every write and are already
+// told by the event itself.
+func productAuditValues(product *entity.Product) entity.AuditValues {
+	values := entity.AuditValues{
+		"name":         product.Name,
+		"sku":          product.SKU,
+		"barcode":      product.Barcode,
+		"slug":         product.Slug,
+		"brand_id":     product.BrandID,
+		"price":        product.Price,
+		"currency":     product.Currency,
+		"description":  product.Description,
+		"quantity":     product.Quantity,
+		"status":       product.Status,
+		"publish_at":   product.PublishAt,
+		"unpublish_at": product.UnpublishAt,
+		"attributes":   product.Attributes,
+		"deleted_at":   nil,
+		"deleted_by":   nil,
+	}
+	// Both are NULL in a product that is not deleted.
+	if product.DeletedAt.Valid {
+		values["deleted_at"] = product.DeletedAt.Time
+	}
+	if product.DeletedBy != "" {
+		values["deleted_by"] = product.DeletedBy
+	}
+	return values
+}
+
+// auditDiff keeps the values that differ between before and after, compared
+// by their JSON encoding. A nil side stays nil, so a created or purged row is
+// recorded with every column that is not NULL.
+func auditDiff(before, after entity.AuditValues) (entity.AuditValues, entity.AuditValues) {
+	var changedBefore, changedAfter entity.AuditValues
+	if before != nil {
+		changedBefore = entity.AuditValues{}
+	}
+	if after != nil {
+		changedAfter = entity.AuditValues{}
+	}
+
+	keys := make(map[string]bool, len(before)+len(after))
+	for key := range before {
+		keys[key] = true
+	}
+	for key := range after {
+		keys[key] = true
+	}
+
+	for key := range keys {
+		// Marshalling a nil value gives null, as for a missing key.
+		encodedBefore, _ := json.Marshal(before[key])
+		encodedAfter, _ := json.Marshal(after[key])
+		if bytes.Equal(encodedBefore, encodedAfter) {
+			continue
+		}
+		if changedBefore != nil {
+			changedBefore[key] = before[key]
+		}
+		if changedAfter != nil {
+			changedAfter[key] = after[key]
+		}
+	}
+	return changedBefore, changedAfter
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// The index is a sorted set where every member scores 0, so ZRANGEBYLEX can
+// answer prefix queries. Each product contributes one member per word of its
+// normalized name, "<name from that word>\x00<id>\x00<display name>", which
+// makes "tv" match "LG TV 43 Inch". A hash maps id to the indexed name so the
+// old members can be found again when the product changes.
+const autocompleteSeparator = "\x00"
+
+type autocompleteRepository struct {
+	client *redis.Client
+	key    string
+	names  string
+}
+
+func NewAutocompleteRepository(client *redis.Client) interfaces.AutocompleteRepository {
+	return &autocompleteRepository{
+		client: client,
+		key:    constant.RedisKeyProductAutocomplete,
+		names:  constant.RedisKeyProductAutocomplete + ":names",
+	}
+}
+
+func (r *autocompleteRepository) Index(ctx context.Context, product entity.Product) error {
+	id := strconv.FormatInt(product.ID, 10)
+
+	previous, err := r.client.HGet(ctx, r.names, id).Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if stale := autocompleteMembers(product.ID, previous); len(stale) > 0 {
+			pipe.ZRem(ctx, r.key, stale...)
+		}
+		if entries := autocompleteEntries(product.ID, product.Name); len(entries) > 0 {
+			pipe.ZAdd(ctx, r.key, entries...)
+		}
+		pipe.HSet(ctx, r.names, id, product.Name)
+		return nil
+	})
+	return err
+}
+
+func (r *autocompleteRepository) Remove(ctx context.Context, id int64) error {
+	field := strconv.FormatInt(id, 10)
+
+	previous, err := r.client.HGet(ctx, r.names, field).Result()
+	if err == redis.Nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if stale := autocompleteMembers(id, previous); len(stale) > 0 {
+			pipe.ZRem(ctx, r.key, stale...)
+		}
+		pipe.HDel(ctx, r.names, field)
+		return nil
+	})
+	return err
+}
+
+func (r *autocompleteRepository) Search(ctx context.Context, prefix string, limit int) ([]entity.ProductAutocomplete, error) {
+	prefix = normalizeAutocomplete(prefix)
+	if prefix == "" || limit <= 0 {
+		return []entity.ProductAutocomplete{}, nil
+	}
+
+	// A product can match on several of its words, so read ahead to still
+	// fill the limit after duplicates are dropped.
+	members, err := r.client.ZRangeByLex(ctx, r.key, &redis.ZRangeBy{
+		Min:   "[" + prefix,
+		Max:   "[" + prefix + "\xff",
+		Count: int64(limit * 4),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]entity.ProductAutocomplete, 0, limit)
+	seen := make(map[int64]bool)
+	for _, member := range members {
+		parts := strings.SplitN(member, autocompleteSeparator, 3)
+		if len(parts) != 3 {
+			continue
+		}
+		id, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		results = append(results, entity.ProductAutocomplete{ID: id, Name: parts[2]})
+		if len(results) == limit {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// Rebuild fills a staging index from next until it returns no products, then
+// swaps it in place of the live one so readers never see a partial index.
+func (r *autocompleteRepository) Rebuild(ctx context.Context, next func(ctx context.Context) ([]entity.Product, error)) (int64, error) {
+	stagingKey, stagingNames := r.key+":rebuild", r.names+":rebuild"
+
+	if err := r.client.Del(ctx, stagingKey, stagingNames).Err(); err != nil {
+		return 0, err
+	}
+
+	var indexed int64
+	for {
+		products, err := next(ctx)
+		if err != nil {
+			return indexed, err
+		}
+		if len(products) == 0 {
+			break
+		}
+
+		_, err = r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for _, product := range products {
+				if entries := autocompleteEntries(product.ID, product.Name); len(entries) > 0 {
+					pipe.ZAdd(ctx, stagingKey, entries...)
+				}
+				pipe.HSet(ctx, stagingNames, strconv.FormatInt(product.ID, 10), product.Name)
+			}
+			return nil
+		})
+		if err != nil {
+			return indexed, err
+		}
+		indexed += int64(len(products))
+	}
+
+	if indexed == 0 {
+		return 0, r.client.Del(ctx, r.key, r.names).Err()
+	}
+
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Rename(ctx, stagingKey, r.key)
+		pipe.Rename(ctx, stagingNames, r.names)
+		return nil
+	})
+	return indexed, err
+}
+
+func normalizeAutocomplete(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+func autocompleteMembers(id int64, name string) []interface{} {
+	words := strings.Fields(normalizeAutocomplete(name))
+	suffix := autocompleteSeparator + strconv.FormatInt(id, 10) + autocompleteSeparator + name
+
+	members := make([]interface{}, 0, len(words))
+	seen := make(map[string]bool)
+	for i := range words {
+		member := strings.Join(words[i:], " ") + suffix
+		if !seen[member] {
+			seen[member] = true
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+func autocompleteEntries(id int64, name string) []redis.Z {
+	members := autocompleteMembers(id, name)
+
+	entries := make([]redis.Z, len(members))
+	for i, member := range members {
+		entries[i] = redis.Z{Member: member}
+	}
+	return entries
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+	"errors"
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type AutocompleteSuite struct {
+	suite.Suite
+	client *redis.Client
+	mock   redismock.ClientMock
+	repo   interfaces.AutocompleteRepository
+}
+
+var (
+	autocompleteKey   = constant.RedisKeyProductAutocomplete
+	autocompleteNames = constant.RedisKeyProductAutocomplete + ":names"
+)
+
+func (s *AutocompleteSuite) SetupTest() {
+	s.client, s.mock = redismock.NewClientMock()
+	s.repo = NewAutocompleteRepository(s.client)
+}
+
+func (s *AutocompleteSuite) TearDownTest() {
+	s.client.Close()
+}
+
+func (s *AutocompleteSuite) TestIndex() {
+	ctx := context.Background()
+
+	s.Run("New Product", func() {
+		s.mock.ExpectHGet(autocompleteNames, "1").RedisNil()
+		s.mock.ExpectTxPipeline()
+		s.mock.ExpectZAdd(autocompleteKey,
+			redis.Z{Member: "lg tv\x001\x00LG  TV"},
+			redis.Z{Member: "tv\x001\x00LG  TV"},
+		).SetVal(2)
+		s.mock.ExpectHSet(autocompleteNames, "1", "LG  TV").SetVal(1)
+		s.mock.ExpectTxPipelineExec()
+
+		err := s.repo.Index(ctx, entity.Product{ID: 1, Name: "LG  TV"})
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Renamed Product", func() {
+		s.mock.ExpectHGet(autocompleteNames, "1").SetVal("LG TV")
+		s.mock.ExpectTxPipeline()
+		s.mock.ExpectZRem(autocompleteKey, "lg tv\x001\x00LG TV", "tv\x001\x00LG TV").SetVal(2)
+		s.mock.ExpectZAdd(autocompleteKey, redis.Z{Member: "oled\x001\x00OLED"}).SetVal(1)
+		s.mock.ExpectHSet(autocompleteNames, "1", "OLED").SetVal(0)
+		s.mock.ExpectTxPipelineExec()
+
+		err := s.repo.Index(ctx, entity.Product{ID: 1, Name: "OLED"})
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Error", func() {
+		s.mock.ExpectHGet(autocompleteNames, "1").SetErr(errors.New("redis down"))
+
+		err := s.repo.Index(ctx, entity.Product{ID: 1, Name: "LG TV"})
+		s.Error(err)
+	})
+}
+
+func (s *AutocompleteSuite) TestRemove() {
+	ctx := context.Background()
+
+	s.Run("Indexed Product", func() {
+		s.mock.ExpectHGet(autocompleteNames, "1").SetVal("TV")
+		s.mock.ExpectTxPipeline()
+		s.mock.ExpectZRem(autocompleteKey, "tv\x001\x00TV").SetVal(1)
+		s.mock.ExpectHDel(autocompleteNames, "1").SetVal(1)
+		s.mock.ExpectTxPipelineExec()
+
+		err := s.repo.Remove(ctx, 1)
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Indexed", func() {
+		s.mock.ExpectHGet(autocompleteNames, "2").RedisNil()
+
+		err := s.repo.Remove(ctx, 2)
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+}
+
+func (s *AutocompleteSuite) TestSearch() {
+	ctx := context.Background()
+
+	s.Run("Deduplicates Products", func() {
+		s.mock.ExpectZRangeByLex(autocompleteKey, &redis.ZRangeBy{Min: "[tv", Max: "[tv\xff", Count: 8}).
+			SetVal([]string{"tv\x001\x00LG TV", "tv box\x002\x00Mi TV Box", "tv box\x002\x00TV Box", "malformed"})
+
+		res, err := s.repo.Search(ctx, " TV ", 2)
+		s.NoError(err)
+		s.Equal([]entity.ProductAutocomplete{{ID: 1, Name: "LG TV"}, {ID: 2, Name: "Mi TV Box"}}, res)
+	})
+
+	s.Run("Blank Prefix", func() {
+		res, err := s.repo.Search(ctx, "  ", 5)
+		s.NoError(err)
+		s.Empty(res)
+	})
+
+	s.Run("Error", func() {
+		s.mock.ExpectZRangeByLex(autocompleteKey, &redis.ZRangeBy{Min: "[tv", Max: "[tv\xff", Count: 20}).
+			SetErr(errors.New("redis down"))
+
+		res, err := s.repo.Search(ctx, "tv", 5)
+		s.Error(err)
+		s.Nil(res)
+	})
+}
+
+func (s *AutocompleteSuite) TestRebuild() {
+	ctx := context.Background()
+	stagingKey, stagingNames := autocompleteKey+":rebuild", autocompleteNames+":rebuild"
+
+	pages := func(batches ...[]entity.Product) func(ctx context.Context) ([]entity.Product, error) {
+		return func(ctx context.Context) ([]entity.Product, error) {
+			if len(batches) == 0 {
+				return nil, nil
+			}
+			batch := batches[0]
+			batches = batches[1:]
+			return batch, nil
+		}
+	}
+
+	s.Run("Swaps Staging Index", func() {
+		s.mock.ExpectDel(stagingKey, stagingNames).SetVal(0)
+		s.mock.ExpectZAdd(stagingKey, redis.Z{Member: "tv\x001\x00TV"}).SetVal(1)
+		s.mock.ExpectHSet(stagingNames, "1", "TV").SetVal(1)
+		s.mock.ExpectZAdd(stagingKey, redis.Z{Member: "oled\x002\x00OLED"}).SetVal(1)
+		s.mock.ExpectHSet(stagingNames, "2", "OLED").SetVal(1)
+		s.mock.ExpectTxPipeline()
+		s.mock.ExpectRename(stagingKey, autocompleteKey).SetVal("OK")
+		s.mock.ExpectRename(stagingNames, autocompleteNames).SetVal("OK")
+		s.mock.ExpectTxPipelineExec()
+
+		indexed, err := s.repo.Rebuild(ctx, pages(
+			[]entity.Product{{ID: 1, Name: "TV"}},
+			[]entity.Product{{ID: 2, Name: "OLED"}},
+		))
+		s.NoError(err)
+		s.Equal(int64(2), indexed)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("No Products", func() {
+		s.mock.ExpectDel(stagingKey, stagingNames).SetVal(0)
+		s.mock.ExpectDel(autocompleteKey, autocompleteNames).SetVal(2)
+
+		indexed, err := s.repo.Rebuild(ctx, pages())
+		s.NoError(err)
+		s.Equal(int64(0), indexed)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Source Error", func() {
+		s.mock.ExpectDel(stagingKey, stagingNames).SetVal(0)
+
+		indexed, err := s.repo.Rebuild(ctx, func(ctx context.Context) ([]entity.Product, error) {
+			return nil, errors.New("db error")
+		})
+		s.Error(err)
+		s.Equal(int64(0), indexed)
+	})
+}
+
+func TestAutocompleteSuite(t *testing.T) {
+	suite.Run(t, new(AutocompleteSuite))
+}
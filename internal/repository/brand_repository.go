@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type brandRepository struct {
+	db *gorm.DB
+}
+
+func NewBrandRepository(db *gorm.DB) interfaces.BrandRepository {
+	return &brandRepository{
+		db: db,
+	}
+}
+
+func (r *brandRepository) Create(ctx context.Context, brand *entity.Brand) error {
+	return constraintViolation(r.db.WithContext(ctx).Create(brand).Error)
+}
+
+func (r *brandRepository) GetByID(ctx context.Context, id int64) (*entity.Brand, error) {
+	var brand entity.Brand
+	err := r.db.WithContext(ctx).First(&brand, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return &brand, nil
+}
+
+// Fetch returns every brand ordered by name.
+func (r *brandRepository) Fetch(ctx context.Context) ([]entity.Brand, error) {
+	var brands []entity.Brand
+	err := r.db.WithContext(ctx).Order("name, id").Find(&brands).Error
+	return brands, err
+}
+
+// Update renames a brand and reads back the stored row.
+func (r *brandRepository) Update(ctx context.Context, brand *entity.Brand) error {
+	result := r.db.WithContext(ctx).Model(brand).Clauses(clause.Returning{}).Updates(map[string]interface{}{
+		"name":       brand.Name,
+		"updated_at": brand.UpdatedAt,
+		"updated_by": brand.UpdatedBy,
+	})
+	if result.Error != nil {
+		return constraintViolation(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return constant.ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes a brand no product refers to anymore, soft deleted products
+// included.
+func (r *brandRepository) Delete(ctx context.Context, id int64) error {
+	result := r.db.WithContext(ctx).Delete(&entity.Brand{}, id)
+	if result.Error != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(result.Error, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return fmt.Errorf("%w: the brand is still used by products", constant.ErrConflict)
+		}
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return constant.ErrNotFound
+	}
+	return nil
+}
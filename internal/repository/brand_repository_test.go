@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type BrandSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo interfaces.BrandRepository
+	db   *sql.DB
+}
+
+func (s *BrandSuite) SetupTest() {
+	var err error
+
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       s.db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = NewBrandRepository(gormDB)
+}
+
+func (s *BrandSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *BrandSuite) TestCreate() {
+	s.Run("Success", func() {
+		brand := &entity.Brand{Name: "Samsung", CreatedBy: "arya"}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "brands" ("name","created_at","created_by","updated_at","updated_by") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+			WithArgs("Samsung", sqlmock.AnyArg(), "arya", sqlmock.AnyArg(), "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Create(context.Background(), brand)
+		s.NoError(err)
+		s.Equal(int64(1), brand.ID)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Duplicate Name", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "brands"`)).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_brands_name_unique"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Create(context.Background(), &entity.Brand{Name: "samsung"})
+		s.ErrorIs(err, constant.ErrConflict)
+		s.EqualError(err, "conflict: a brand with this name already exists")
+	})
+}
+
+func (s *BrandSuite) TestGetByID() {
+	query := regexp.QuoteMeta(`SELECT * FROM "brands" WHERE "brands"."id" = $1 ORDER BY "brands"."id" LIMIT $2`)
+
+	s.Run("Found", func() {
+		s.mock.ExpectQuery(query).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "Samsung"))
+
+		brand, err := s.repo.GetByID(context.Background(), 1)
+		s.NoError(err)
+		s.Equal("Samsung", brand.Name)
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectQuery(query).
+			WithArgs(9, 1).
+			WillReturnError(gorm.ErrRecordNotFound)
+
+		brand, err := s.repo.GetByID(context.Background(), 9)
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(brand)
+	})
+}
+
+func (s *BrandSuite) TestFetch() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "brands" ORDER BY name, id`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "Apple").AddRow(1, "Samsung"))
+
+	brands, err := s.repo.Fetch(context.Background())
+	s.NoError(err)
+	s.Len(brands, 2)
+	s.Equal("Apple", brands[0].Name)
+}
+
+func (s *BrandSuite) TestUpdate() {
+	query := regexp.QuoteMeta(`UPDATE "brands" SET "name"=$1,"updated_at"=$2,"updated_by"=$3 WHERE "id" = $4 RETURNING *`)
+
+	s.Run("Success", func() {
+		brand := &entity.Brand{ID: 1, Name: "Samsung Electronics", UpdatedBy: "arya", UpdatedAt: time.Now()}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(query).
+			WithArgs("Samsung Electronics", sqlmock.AnyArg(), "arya", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_by"}).AddRow(1, "Samsung Electronics", "budi"))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Update(context.Background(), brand)
+		s.NoError(err)
+		s.Equal("budi", brand.CreatedBy)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(query).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Update(context.Background(), &entity.Brand{ID: 9, Name: "Sony"})
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+
+	s.Run("Duplicate Name", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(query).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_brands_name_unique"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Update(context.Background(), &entity.Brand{ID: 1, Name: "Apple"})
+		s.ErrorIs(err, constant.ErrConflict)
+	})
+}
+
+func (s *BrandSuite) TestDelete() {
+	query := regexp.QuoteMeta(`DELETE FROM "brands" WHERE "brands"."id" = $1`)
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(query).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Delete(context.Background(), 1)
+		s.NoError(err)
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(query).
+			WithArgs(9).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Delete(context.Background(), 9)
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+
+	s.Run("Still Used", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(query).
+			WithArgs(2).
+			WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "fk_products_brand"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Delete(context.Background(), 2)
+		s.ErrorIs(err, constant.ErrConflict)
+	})
+}
+
+func TestBrandSuite(t *testing.T) {
+	suite.Run(t, new(BrandSuite))
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type categoryRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) interfaces.CategoryRepository {
+	return &categoryRepository{
+		db: db,
+	}
+}
+
+// Create inserts a category below its parent. The path needs the new id, so
+// it is written right after the insert. The parent row is share locked so it
+// cannot be moved before the path derived from it is committed.
+func (r *categoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		parentPath, depth, err := r.parentPath(tx, category.ParentID, clause.Locking{Strength: "SHARE"})
+		if err != nil {
+			return err
+		}
+
+		category.Path = parentPath
+		category.Depth = depth
+		if err := tx.Create(category).Error; err != nil {
+			return constraintViolation(err)
+		}
+
+		category.Path = parentPath + strconv.FormatInt(category.ID, 10) + "/"
+		return tx.Model(category).UpdateColumn("path", category.Path).Error
+	})
+}
+
+func (r *categoryRepository) GetByID(ctx context.Context, id int64) (*entity.Category, error) {
+	var category entity.Category
+	err := r.db.WithContext(ctx).First(&category, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+// Fetch returns every category, parents before their children.
+func (r *categoryRepository) Fetch(ctx context.Context) ([]entity.Category, error) {
+	var categories []entity.Category
+	err := r.db.WithContext(ctx).Order("depth, name, id").Find(&categories).Error
+	return categories, err
+}
+
+// Update renames a category and moves it under ParentID. Moving rewrites the
+// path prefix and depth of the whole subtree in one statement; a category
+// cannot be moved below itself or one of its descendants.
+func (r *categoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current entity.Category
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, category.ID).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return constant.ErrNotFound
+			}
+			return err
+		}
+
+		parentPath, depth, err := r.parentPath(tx, category.ParentID, clause.Locking{Strength: "SHARE"})
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(parentPath, current.Path) {
+			return fmt.Errorf("%w: a category cannot be moved below itself or its descendants", constant.ErrValidation)
+		}
+
+		category.Path = parentPath + strconv.FormatInt(category.ID, 10) + "/"
+		category.Depth = depth
+		if category.Path != current.Path {
+			err := tx.Exec(`UPDATE categories SET path = ? || substr(path, ?), depth = depth + ? WHERE path LIKE ?`,
+				category.Path, len(current.Path)+1, depth-current.Depth, current.Path+"%").Error
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Model(category).Clauses(clause.Returning{}).Updates(map[string]interface{}{
+			"name":       category.Name,
+			"parent_id":  category.ParentID,
+			"updated_at": category.UpdatedAt,
+			"updated_by": category.UpdatedBy,
+		}).Error
+		return constraintViolation(err)
+	})
+}
+
+// Delete removes a category that has no subcategories. Its product links are
+// removed by the foreign key.
+func (r *categoryRepository) Delete(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var children int64
+		if err := tx.Model(&entity.Category{}).Where("parent_id = ?", id).Count(&children).Error; err != nil {
+			return err
+		}
+		if children > 0 {
+			return fmt.Errorf("%w: the category still has subcategories", constant.ErrConflict)
+		}
+
+		result := tx.Delete(&entity.Category{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return constant.ErrNotFound
+		}
+		return nil
+	})
+}
+
+// GetByProduct returns the categories a product is linked to.
+func (r *categoryRepository) GetByProduct(ctx context.Context, productID int64) ([]entity.Category, error) {
+	var categories []entity.Category
+	err := r.db.WithContext(ctx).
+		Joins("JOIN product_categories ON product_categories.category_id = categories.id").
+		Where("product_categories.product_id = ?", productID).
+		Order("categories.path").
+		Find(&categories).Error
+	return categories, err
+}
+
+// SetProductCategories replaces the category links of a product. The
+// product row is locked and the change audited as an update of its
+// category_ids.
+func (r *categoryRepository) SetProductCategories(ctx context.Context, productID int64, categoryIDs []int64, updatedBy string) error {
+	categoryIDs = slices.Compact(slices.Sorted(slices.Values(categoryIDs)))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		product, err := lockProduct(tx, productID)
+		if err != nil {
+			return err
+		}
+
+		if len(categoryIDs) > 0 {
+			var found []int64
+			if err := tx.Model(&entity.Category{}).Where("id IN ?", categoryIDs).Pluck("id", &found).Error; err != nil {
+				return err
+			}
+			for _, id := range categoryIDs {
+				if !slices.Contains(found, id) {
+					return fmt.Errorf("%w: category %d does not exist", constant.ErrValidation, id)
+				}
+			}
+		}
+
+		previous := []int64{}
+		if err := tx.Model(&entity.ProductCategory{}).Where("product_id = ?", productID).Order("category_id").Pluck("category_id", &previous).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("product_id = ?", productID).Delete(&entity.ProductCategory{}).Error; err != nil {
+			return err
+		}
+		if len(categoryIDs) > 0 {
+			links := make([]entity.ProductCategory, len(categoryIDs))
+			for i, id := range categoryIDs {
+				links[i] = entity.ProductCategory{ProductID: productID, CategoryID: id}
+			}
+			if err := tx.Create(&links).Error; err != nil {
+				return err
+			}
+		}
+
+		// The links are no column of the product, so the event carries them
+		// in place of the unchanged columns.
+		event := productAuditEvent(ctx, entity.AuditUpdate, updatedBy, product, product)
+		event.Before, event.After = auditDiff(
+			entity.AuditValues{"category_ids": previous},
+			entity.AuditValues{"category_ids": append([]int64{}, categoryIDs...)},
+		)
+		return recordAudit(tx, []entity.AuditEvent{event})
+	})
+	if errors.Is(err, errNothingUpdated) {
+		return constant.ErrNotFound
+	}
+	return err
+}
+
+// parentPath returns the path and depth a child of parentID starts from,
+// locking the parent row with lock.
+func (r *categoryRepository) parentPath(tx *gorm.DB, parentID *int64, lock clause.Locking) (string, int, error) {
+	if parentID == nil {
+		return "/", 0, nil
+	}
+
+	var parent entity.Category
+	err := tx.Clauses(lock).First(&parent, *parentID).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return "", 0, fmt.Errorf("%w: parent category %d does not exist", constant.ErrValidation, *parentID)
+		}
+		return "", 0, err
+	}
+	return parent.Path, parent.Depth + 1, nil
+}
@@ -0,0 +1,307 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/middlewares"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type CategorySuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo interfaces.CategoryRepository
+	db   *sql.DB
+}
+
+func (s *CategorySuite) SetupTest() {
+	var err error
+
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       s.db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = NewCategoryRepository(gormDB)
+}
+
+func (s *CategorySuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *CategorySuite) TestCreate() {
+	s.Run("Root", func() {
+		category := &entity.Category{Name: "Electronics", CreatedBy: "arya"}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "categories"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "categories" SET "path"=$1 WHERE "id" = $2`)).
+			WithArgs("/1/", 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Create(context.Background(), category)
+		s.NoError(err)
+		s.Equal("/1/", category.Path)
+		s.Equal(0, category.Depth)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Below Parent", func() {
+		parentID := int64(1)
+		category := &entity.Category{ParentID: &parentID, Name: "TV", CreatedBy: "arya"}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories" WHERE "categories"."id" = $1 ORDER BY "categories"."id" LIMIT $2 FOR SHARE`)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "path", "depth"}).AddRow(1, "/1/", 0))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "categories"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "categories" SET "path"=$1 WHERE "id" = $2`)).
+			WithArgs("/1/4/", 4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Create(context.Background(), category)
+		s.NoError(err)
+		s.Equal("/1/4/", category.Path)
+		s.Equal(1, category.Depth)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Missing Parent", func() {
+		parentID := int64(9)
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories"`)).
+			WillReturnError(gorm.ErrRecordNotFound)
+		s.mock.ExpectRollback()
+
+		err := s.repo.Create(context.Background(), &entity.Category{ParentID: &parentID, Name: "TV"})
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+
+	s.Run("Duplicate Name", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "categories"`)).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_categories_parent_name_unique"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Create(context.Background(), &entity.Category{Name: "Electronics"})
+		s.ErrorIs(err, constant.ErrConflict)
+	})
+}
+
+func (s *CategorySuite) TestFetch() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories" ORDER BY depth, name, id`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "parent_id", "name", "path"}).
+			AddRow(1, nil, "Electronics", "/1/").
+			AddRow(4, 1, "TV", "/1/4/"))
+
+	categories, err := s.repo.Fetch(context.Background())
+	s.NoError(err)
+	s.Len(categories, 2)
+	s.Equal(int64(1), *categories[1].ParentID)
+}
+
+func (s *CategorySuite) TestUpdate() {
+	current := sqlmock.NewRows([]string{"id", "parent_id", "name", "path", "depth"}).AddRow(4, 1, "TV", "/1/4/", 1)
+	lockQuery := `SELECT * FROM "categories" WHERE "categories"."id" = $1 ORDER BY "categories"."id" LIMIT $2 FOR UPDATE`
+
+	s.Run("Move Subtree", func() {
+		parentID := int64(2)
+		category := &entity.Category{ID: 4, ParentID: &parentID, Name: "Television", UpdatedBy: "arya", UpdatedAt: time.Now()}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).
+			WithArgs(4, 1).
+			WillReturnRows(current)
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories" WHERE "categories"."id" = $1 ORDER BY "categories"."id" LIMIT $2 FOR SHARE`)).
+			WithArgs(2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "path", "depth"}).AddRow(2, "/7/2/", 1))
+		s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE categories SET path = $1 || substr(path, $2), depth = depth + $3 WHERE path LIKE $4`)).
+			WithArgs("/7/2/4/", 6, 1, "/1/4/%").
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "categories" SET "name"=$1,"parent_id"=$2,"updated_at"=$3,"updated_by"=$4 WHERE "id" = $5 RETURNING *`)).
+			WithArgs("Television", &parentID, sqlmock.AnyArg(), "arya", 4).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "path", "depth", "created_by"}).AddRow(4, "/7/2/4/", 2, "budi"))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Update(context.Background(), category)
+		s.NoError(err)
+		s.Equal("/7/2/4/", category.Path)
+		s.Equal("budi", category.CreatedBy)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Below Own Descendant", func() {
+		parentID := int64(5)
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "path", "depth"}).AddRow(4, "/1/4/", 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "categories"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "path", "depth"}).AddRow(5, "/1/4/5/", 2))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Update(context.Background(), &entity.Category{ID: 4, ParentID: &parentID, Name: "TV"})
+		s.ErrorIs(err, constant.ErrValidation)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockQuery)).
+			WillReturnError(gorm.ErrRecordNotFound)
+		s.mock.ExpectRollback()
+
+		err := s.repo.Update(context.Background(), &entity.Category{ID: 9, Name: "TV"})
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *CategorySuite) TestDelete() {
+	countQuery := `SELECT count(*) FROM "categories" WHERE parent_id = $1`
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(countQuery)).
+			WithArgs(4).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		s.mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "categories" WHERE "categories"."id" = $1`)).
+			WithArgs(4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Delete(context.Background(), 4)
+		s.NoError(err)
+	})
+
+	s.Run("Has Subcategories", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(countQuery)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Delete(context.Background(), 1)
+		s.ErrorIs(err, constant.ErrConflict)
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(countQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		s.mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "categories"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Delete(context.Background(), 9)
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *CategorySuite) TestGetByProduct() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "categories"."id","categories"."parent_id","categories"."name","categories"."path","categories"."depth","categories"."created_at","categories"."created_by","categories"."updated_at","categories"."updated_by" FROM "categories" JOIN product_categories ON product_categories.category_id = categories.id WHERE product_categories.product_id = $1 ORDER BY categories.path`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "path"}).AddRow(4, "TV", "/1/4/"))
+
+	categories, err := s.repo.GetByProduct(context.Background(), 1)
+	s.NoError(err)
+	s.Len(categories, 1)
+}
+
+func (s *CategorySuite) TestSetProductCategories() {
+	linksQuery := `SELECT "category_id" FROM "product_categories" WHERE product_id = $1 ORDER BY category_id`
+	auditQuery := `INSERT INTO "audit_events" ("entity_type","entity_id","action","actor","request_id","before","after","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`
+
+	s.Run("Replace", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "LG TV"))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "categories" WHERE id IN ($1,$2)`)).
+			WithArgs(4, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4).AddRow(5))
+		s.mock.ExpectQuery(regexp.QuoteMeta(linksQuery)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"category_id"}).AddRow(4))
+		s.mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "product_categories" WHERE product_id = $1`)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "product_categories" ("product_id","category_id") VALUES ($1,$2),($3,$4)`)).
+			WithArgs(1, 4, 1, 5).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(auditQuery)).
+			WithArgs("product", 1, "update", "arya", "req-1", `{"category_ids":[4]}`, `{"category_ids":[4,5]}`, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		ctx := context.WithValue(context.Background(), middlewares.CtxRequestID, "req-1")
+		err := s.repo.SetProductCategories(ctx, 1, []int64{5, 4, 5}, "arya")
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Clear", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(linksQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"category_id"}).AddRow(4).AddRow(5))
+		s.mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "product_categories" WHERE product_id = $1`)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(auditQuery)).
+			WithArgs("product", 1, "update", "arya", "", `{"category_ids":[4,5]}`, `{"category_ids":[]}`, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.SetProductCategories(context.Background(), 1, nil, "arya")
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Unknown Category", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "categories"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		s.mock.ExpectRollback()
+
+		err := s.repo.SetProductCategories(context.Background(), 1, []int64{4, 9}, "arya")
+		s.ErrorIs(err, constant.ErrValidation)
+		s.EqualError(err, "validation error: category 9 does not exist")
+	})
+
+	s.Run("Product Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WillReturnError(gorm.ErrRecordNotFound)
+		s.mock.ExpectRollback()
+
+		err := s.repo.SetProductCategories(context.Background(), 9, []int64{4}, "arya")
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func TestCategorySuite(t *testing.T) {
+	suite.Run(t, new(CategorySuite))
+}
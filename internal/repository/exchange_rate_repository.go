@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type exchangeRateRepository struct {
+	db *gorm.DB
+}
+
+func NewExchangeRateRepository(db *gorm.DB) interfaces.ExchangeRateRepository {
+	return &exchangeRateRepository{
+		db: db,
+	}
+}
+
+// Fetch returns every exchange rate ordered by currency.
+func (r *exchangeRateRepository) Fetch(ctx context.Context) ([]entity.ExchangeRate, error) {
+	var rates []entity.ExchangeRate
+	err := r.db.WithContext(ctx).Order("currency").Find(&rates).Error
+	return rates, err
+}
+
+// Upsert writes the rates in one statement, adding the currencies not known
+// yet and replacing the rates of the others. The minor units of a known
+// currency are kept: the prices stored in it are counted in them.
+func (r *exchangeRateRepository) Upsert(ctx context.Context, rates []entity.ExchangeRate) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "currency"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at", "updated_by"}),
+	}).Create(&rates).Error
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type ExchangeRateSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo interfaces.ExchangeRateRepository
+	db   *sql.DB
+}
+
+func (s *ExchangeRateSuite) SetupTest() {
+	var err error
+
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       s.db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = NewExchangeRateRepository(gormDB)
+}
+
+func (s *ExchangeRateSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *ExchangeRateSuite) TestFetch() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "exchange_rates" ORDER BY currency`)).
+		WillReturnRows(sqlmock.NewRows([]string{"currency", "rate", "minor_units"}).AddRow("IDR", 1, 0).AddRow("USD", 16000, 2))
+
+	rates, err := s.repo.Fetch(context.Background())
+	s.NoError(err)
+	s.Len(rates, 2)
+	s.Equal(16000.0, rates[1].Rate)
+	s.Equal(2, rates[1].MinorUnits)
+}
+
+func (s *ExchangeRateSuite) TestUpsert() {
+	now := time.Now()
+	rates := []entity.ExchangeRate{
+		{Currency: "SGD", Rate: 12000, MinorUnits: 2, UpdatedAt: now, UpdatedBy: "arya"},
+		{Currency: "USD", Rate: 16250, MinorUnits: 2, UpdatedAt: now, UpdatedBy: "arya"},
+	}
+	query := regexp.QuoteMeta(`INSERT INTO "exchange_rates" ("currency","rate","minor_units","updated_at","updated_by") VALUES ($1,$2,$3,$4,$5),($6,$7,$8,$9,$10) ON CONFLICT ("currency") DO UPDATE SET "rate"="excluded"."rate","updated_at"="excluded"."updated_at","updated_by"="excluded"."updated_by"`)
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(query).
+			WithArgs("SGD", 12000.0, 2, now, "arya", "USD", 16250.0, 2, now, "arya").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Upsert(context.Background(), rates)
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Error", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(query).WillReturnError(errors.New("db error"))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Upsert(context.Background(), rates)
+		s.EqualError(err, "db error")
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+}
+
+func TestExchangeRateSuite(t *testing.T) {
+	suite.Run(t, new(ExchangeRateSuite))
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type locationRepository struct {
+	db *gorm.DB
+}
+
+func NewLocationRepository(db *gorm.DB) interfaces.LocationRepository {
+	return &locationRepository{
+		db: db,
+	}
+}
+
+func (r *locationRepository) Create(ctx context.Context, location *entity.Location) error {
+	return constraintViolation(r.db.WithContext(ctx).Create(location).Error)
+}
+
+func (r *locationRepository) GetByID(ctx context.Context, id int64) (*entity.Location, error) {
+	var location entity.Location
+	err := r.db.WithContext(ctx).First(&location, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return &location, nil
+}
+
+// Fetch returns every location ordered by code.
+func (r *locationRepository) Fetch(ctx context.Context) ([]entity.Location, error) {
+	var locations []entity.Location
+	err := r.db.WithContext(ctx).Order("code, id").Find(&locations).Error
+	return locations, err
+}
+
+// Update replaces the details of a location and reads back the stored row.
+func (r *locationRepository) Update(ctx context.Context, location *entity.Location) error {
+	result := r.db.WithContext(ctx).Model(location).Clauses(clause.Returning{}).Updates(map[string]interface{}{
+		"code":       location.Code,
+		"name":       location.Name,
+		"type":       location.Type,
+		"address":    location.Address,
+		"updated_at": location.UpdatedAt,
+		"updated_by": location.UpdatedBy,
+	})
+	if result.Error != nil {
+		return constraintViolation(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return constant.ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes a location that never kept stock. The default location
+// cannot be deleted, and neither can one with stock levels or stock history.
+func (r *locationRepository) Delete(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var location entity.Location
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&location, id).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constant.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if location.IsDefault {
+			return fmt.Errorf("%w: the default location cannot be deleted", constant.ErrConflict)
+		}
+
+		err = tx.Delete(&location).Error
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return fmt.Errorf("%w: the location still has stock or stock history", constant.ErrConflict)
+		}
+		return err
+	})
+}
+
+// FetchStocks returns the stock of a product at every location that ever
+// kept it, ordered by location.
+func (r *locationRepository) FetchStocks(ctx context.Context, productID int64) ([]entity.ProductStock, error) {
+	db := r.db.WithContext(ctx)
+	if err := db.Select("id").First(&entity.Product{}, productID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+
+	var stocks []entity.ProductStock
+	err := db.Where("product_id = ?", productID).Order("location_id").Find(&stocks).Error
+	if err != nil {
+		return nil, err
+	}
+	for i := range stocks {
+		stocks[i].Available = stocks[i].Quantity - stocks[i].Reserved
+	}
+	return stocks, nil
+}
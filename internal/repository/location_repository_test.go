@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type LocationSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo interfaces.LocationRepository
+	db   *sql.DB
+}
+
+func (s *LocationSuite) SetupTest() {
+	var err error
+
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       s.db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = NewLocationRepository(gormDB)
+}
+
+func (s *LocationSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *LocationSuite) TestCreate() {
+	s.Run("Success", func() {
+		location := &entity.Location{Code: "JKT-01", Name: "Jakarta store", Type: entity.LocationStore, CreatedBy: "arya"}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "locations" ("code","name","type","address","created_at","created_by","updated_at","updated_by") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+			WithArgs("JKT-01", "Jakarta store", entity.LocationStore, "", sqlmock.AnyArg(), "arya", sqlmock.AnyArg(), "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Create(context.Background(), location)
+		s.NoError(err)
+		s.Equal(int64(2), location.ID)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Duplicate Code", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "locations"`)).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_locations_code_unique"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Create(context.Background(), &entity.Location{Code: "jkt-01"})
+		s.ErrorIs(err, constant.ErrConflict)
+		s.EqualError(err, "conflict: a location with this code already exists")
+	})
+}
+
+func (s *LocationSuite) TestGetByID() {
+	query := regexp.QuoteMeta(`SELECT * FROM "locations" WHERE "locations"."id" = $1 ORDER BY "locations"."id" LIMIT $2`)
+
+	s.Run("Found", func() {
+		s.mock.ExpectQuery(query).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "code", "is_default"}).AddRow(1, "MAIN", true))
+
+		location, err := s.repo.GetByID(context.Background(), 1)
+		s.NoError(err)
+		s.Equal("MAIN", location.Code)
+		s.True(location.IsDefault)
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectQuery(query).
+			WithArgs(9, 1).
+			WillReturnError(gorm.ErrRecordNotFound)
+
+		location, err := s.repo.GetByID(context.Background(), 9)
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(location)
+	})
+}
+
+func (s *LocationSuite) TestFetch() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "locations" ORDER BY code, id`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code"}).AddRow(2, "JKT-01").AddRow(1, "MAIN"))
+
+	locations, err := s.repo.Fetch(context.Background())
+	s.NoError(err)
+	s.Len(locations, 2)
+	s.Equal("JKT-01", locations[0].Code)
+}
+
+func (s *LocationSuite) TestUpdate() {
+	query := regexp.QuoteMeta(`UPDATE "locations" SET "address"=$1,"code"=$2,"name"=$3,"type"=$4,"updated_at"=$5,"updated_by"=$6 WHERE "id" = $7 RETURNING *`)
+
+	s.Run("Success", func() {
+		location := &entity.Location{ID: 2, Code: "JKT-01", Name: "Jakarta flagship", Type: entity.LocationStore, UpdatedBy: "arya", UpdatedAt: time.Now()}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(query).
+			WithArgs("", "JKT-01", "Jakarta flagship", entity.LocationStore, sqlmock.AnyArg(), "arya", 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "code", "created_by"}).AddRow(2, "JKT-01", "budi"))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Update(context.Background(), location)
+		s.NoError(err)
+		s.Equal("budi", location.CreatedBy)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(query).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Update(context.Background(), &entity.Location{ID: 9, Code: "SBY-01"})
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *LocationSuite) TestDelete() {
+	lock := regexp.QuoteMeta(`SELECT * FROM "locations" WHERE "locations"."id" = $1 ORDER BY "locations"."id" LIMIT $2 FOR UPDATE`)
+	query := regexp.QuoteMeta(`DELETE FROM "locations" WHERE "locations"."id" = $1`)
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lock).
+			WithArgs(2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "is_default"}).AddRow(2, false))
+		s.mock.ExpectExec(query).
+			WithArgs(2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Delete(context.Background(), 2)
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Default Location", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lock).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "is_default"}).AddRow(1, true))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Delete(context.Background(), 1)
+		s.EqualError(err, "conflict: the default location cannot be deleted")
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Has Stock", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lock).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "is_default"}).AddRow(2, false))
+		s.mock.ExpectExec(query).
+			WillReturnError(&pgconn.PgError{Code: "23503"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Delete(context.Background(), 2)
+		s.ErrorIs(err, constant.ErrConflict)
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lock).
+			WillReturnError(gorm.ErrRecordNotFound)
+		s.mock.ExpectRollback()
+
+		err := s.repo.Delete(context.Background(), 9)
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *LocationSuite) TestFetchStocks() {
+	product := regexp.QuoteMeta(`SELECT "id" FROM "products" WHERE "products"."id" = $1 AND "products"."deleted_at" IS NULL ORDER BY "products"."id" LIMIT $2`)
+
+	s.Run("Success", func() {
+		s.mock.ExpectQuery(product).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_stocks" WHERE product_id = $1 ORDER BY location_id`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"product_id", "location_id", "quantity", "reserved"}).AddRow(1, 1, 6, 2).AddRow(1, 2, 4, 0))
+
+		stocks, err := s.repo.FetchStocks(context.Background(), 1)
+		s.NoError(err)
+		s.Len(stocks, 2)
+		s.Equal(4, stocks[0].Available)
+		s.Equal(4, stocks[1].Available)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Product Not Found", func() {
+		s.mock.ExpectQuery(product).
+			WillReturnError(gorm.ErrRecordNotFound)
+
+		stocks, err := s.repo.FetchStocks(context.Background(), 9)
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(stocks)
+	})
+}
+
+func TestLocationSuite(t *testing.T) {
+	suite.Run(t, new(LocationSuite))
+}
@@ -2,77 +2,943 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"erajaya-test/internal/interfaces"
 	"erajaya-test/internal/models/entity"
 	"erajaya-test/internal/models/request"
 	"erajaya-test/shared/constant"
+	"erajaya-test/shared/utils"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// SQLSTATEs Postgres reports for a unique index clash and for a reference to
+// a missing row.
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+// errNothingUpdated rolls back a write whose update matched no row, so the
+// reason can be looked up outside the transaction.
+var errNothingUpdated = errors.New("nothing updated")
+
 type productRepository struct {
 	db *gorm.DB
+	// textSearchConfig names the Postgres text search configuration (e.g.
+	// "english", "indonesian") blended into the relevance score. Empty ranks
+	// by trigram similarity alone.
+	textSearchConfig string
 }
 
-func NewProductRepository(db *gorm.DB) interfaces.ProductRepository {
+func NewProductRepository(db *gorm.DB, textSearchConfig string) interfaces.ProductRepository {
 	return &productRepository{
-		db: db,
+		db:               db,
+		textSearchConfig: textSearchConfig,
 	}
 }
 
+// Create inserts a product and opens its stock ledger and price history with
+// the quantity and price it starts with.
 func (r *productRepository) Create(ctx context.Context, product *entity.Product) error {
-	return r.db.WithContext(ctx).Create(product).Error
+	if err := r.assignSlugs(ctx, []*entity.Product{product}); err != nil {
+		return err
+	}
+	return constraintViolation(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(product).Error; err != nil {
+			return err
+		}
+		if err := openingMovements(tx, []*entity.Product{product}); err != nil {
+			return err
+		}
+		if err := openingPrices(tx, []*entity.Product{product}); err != nil {
+			return err
+		}
+		return recordAudit(tx, []entity.AuditEvent{productAuditEvent(ctx, entity.AuditCreate, product.CreatedBy, nil, product)})
+	}))
+}
+
+// CreateBatch inserts products batchSize rows per statement, together with
+// their opening stock movements and prices, in one transaction, so either every product
+// is stored or none.
+func (r *productRepository) CreateBatch(ctx context.Context, products []*entity.Product, batchSize int) error {
+	if err := r.assignSlugs(ctx, products); err != nil {
+		return err
+	}
+	return constraintViolation(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Already in a transaction, so the batches need no savepoint of their own.
+		err := tx.Session(&gorm.Session{SkipDefaultTransaction: true}).CreateInBatches(products, batchSize).Error
+		if err != nil {
+			return err
+		}
+		if err := openingMovements(tx, products); err != nil {
+			return err
+		}
+		if err := openingPrices(tx, products); err != nil {
+			return err
+		}
+
+		events := make([]entity.AuditEvent, len(products))
+		for i, product := range products {
+			events[i] = productAuditEvent(ctx, entity.AuditCreate, product.CreatedBy, nil, product)
+		}
+		return recordAudit(tx, events)
+	}))
 }
 
+// assignSlugs derives a slug from the name of each product without one and
+// keeps it unique by appending -2, -3, ... when it is already taken, by a
+// stored product (deleted ones included) or earlier in the same batch. The
+// unique index still guards against a concurrent insert taking it first.
+func (r *productRepository) assignSlugs(ctx context.Context, products []*entity.Product) error {
+	var conditions []string
+	var args []interface{}
+	seen := make(map[string]bool, len(products))
+	for _, product := range products {
+		if product.Slug == "" {
+			product.Slug = utils.Slugify(product.Name)
+		}
+		if !seen[product.Slug] {
+			seen[product.Slug] = true
+			conditions = append(conditions, "slug = ? OR slug LIKE ?")
+			args = append(args, product.Slug, product.Slug+"-%")
+		}
+	}
+
+	var taken []string
+	err := r.db.WithContext(ctx).Unscoped().Model(&entity.Product{}).
+		Where(strings.Join(conditions, " OR "), args...).
+		Pluck("slug", &taken).Error
+	if err != nil {
+		return err
+	}
+
+	used := make(map[string]bool, len(taken)+len(products))
+	for _, slug := range taken {
+		used[slug] = true
+	}
+	for _, product := range products {
+		base, slug := product.Slug, product.Slug
+		for n := 2; used[slug]; n++ {
+			slug = base + "-" + strconv.Itoa(n)
+		}
+		used[slug] = true
+		product.Slug = slug
+	}
+	return nil
+}
+
+// GetByID returns the product detail: the product with its variants, its
+// images and the stock available besides the reserved.
 func (r *productRepository) GetByID(ctx context.Context, id int64) (*entity.Product, error) {
 	var product entity.Product
-	err := r.db.WithContext(ctx).First(&product, id).Error
+	err := r.db.WithContext(ctx).Preload("Variants", orderVariants).Preload("Media", orderMedia).First(&product, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	product.Options = entity.OptionsOf(product.Variants)
+	available := product.Stock() - product.Reserved
+	product.Available = &available
+	return &product, nil
+}
+
+func (r *productRepository) GetBySKU(ctx context.Context, sku string) (*entity.Product, error) {
+	return r.getBy(ctx, "sku", sku)
+}
+
+func (r *productRepository) GetBySlug(ctx context.Context, slug string) (*entity.Product, error) {
+	return r.getBy(ctx, "slug", slug)
+}
+
+func (r *productRepository) getBy(ctx context.Context, column string, value string) (*entity.Product, error) {
+	var product entity.Product
+	err := r.db.WithContext(ctx).Preload("Variants", orderVariants).Preload("Media", orderMedia).Where(column+" = ?", value).First(&product).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, constant.ErrNotFound
 		}
 		return nil, err
 	}
+	product.Options = entity.OptionsOf(product.Variants)
+	available := product.Stock() - product.Reserved
+	product.Available = &available
 	return &product, nil
 }
 
+func orderVariants(db *gorm.DB) *gorm.DB {
+	return db.Order("product_variants.id")
+}
+
 func (r *productRepository) Fetch(ctx context.Context, filter request.ProductFilter) ([]entity.Product, int64, error) {
 	var products []entity.Product
 	var total int64
 
-	query := r.db.Model(&entity.Product{})
+	sortFields, err := request.ParseProductSort(filter.Sort, filter.Search)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := r.filterProducts(r.db.Model(&entity.Product{}), filter)
+
+	if !filter.SkipTotal {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
+	}
+
+	query, columns := r.sortProducts(query, filter.Search, sortFields)
+
+	if filter.Cursor != "" {
+		seek, err := productCursorCondition(filter.Cursor, sortFields, columns)
+		if err != nil {
+			return nil, 0, err
+		}
+		query = query.Where(seek)
+	} else {
+		offset := (filter.Page - 1) * filter.Limit
+		query = query.Offset(offset)
+	}
+
+	query = query.Limit(filter.Limit)
+
+	if err := query.Find(&products).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if filter.LocationID != nil {
+		if err := r.attachLocationStock(ctx, products, *filter.LocationID); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return products, total, nil
+}
+
+// attachLocationStock sets the stock each product keeps at a location.
+func (r *productRepository) attachLocationStock(ctx context.Context, products []entity.Product, locationID int64) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(products))
+	for i, product := range products {
+		ids[i] = product.ID
+	}
+
+	var stocks []entity.ProductStock
+	err := r.db.WithContext(ctx).Where("location_id = ? AND product_id IN ?", locationID, ids).Find(&stocks).Error
+	if err != nil {
+		return err
+	}
+
+	byProduct := make(map[int64]*entity.ProductStock, len(stocks))
+	for i := range stocks {
+		stocks[i].Available = stocks[i].Quantity - stocks[i].Reserved
+		byProduct[stocks[i].ProductID] = &stocks[i]
+	}
+	for i := range products {
+		products[i].LocationStock = byProduct[products[i].ID]
+	}
+	return nil
+}
+
+// Stream calls fn for every product matching filter, in sort order, ignoring
+// the paging fields. Rows are scanned one at a time off the open result set
+// so memory stays flat however many products match.
+func (r *productRepository) Stream(ctx context.Context, filter request.ProductFilter, fn func(product entity.Product) error) error {
+	sortFields, err := request.ParseProductSort(filter.Sort, filter.Search)
+	if err != nil {
+		return err
+	}
+
+	query := r.filterProducts(r.db.WithContext(ctx).Model(&entity.Product{}), filter)
+	query, _ = r.sortProducts(query, filter.Search, sortFields)
+
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var product entity.Product
+		if err := query.ScanRows(rows, &product); err != nil {
+			return err
+		}
+		if err := fn(product); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// filterProducts narrows query to the products a listing filter selects.
+func (r *productRepository) filterProducts(query *gorm.DB, filter request.ProductFilter) *gorm.DB {
+	if filter.IncludeDeleted {
+		query = query.Unscoped()
+	}
 
 	if filter.Search != "" {
 		search := "%" + filter.Search + "%"
 		query = query.Where("name ILIKE ? OR description ILIKE ?", search, search)
 	}
 
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	return applyProductFilter(query, filter)
+}
+
+// BrandFacets counts the products matching filter per brand, most common
+// first. The brand filter itself is left out so that the other brands keep
+// their counts while one is selected.
+func (r *productRepository) BrandFacets(ctx context.Context, filter request.ProductFilter) ([]entity.FacetCount, error) {
+	filter.BrandIDs = nil
+	products := r.filterProducts(r.db.Model(&entity.Product{}).Select("brand_id"), filter)
+
+	var counts []entity.FacetCount
+	err := r.db.WithContext(ctx).Table("(?) AS p", products).
+		Select("brands.id, brands.name, count(*) AS count").
+		Joins("JOIN brands ON brands.id = p.brand_id").
+		Group("brands.id, brands.name").
+		Order("count DESC, brands.name").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// CategoryFacets counts the products matching filter per subcategory of the
+// filtered category, or per root category without one. A product counts for
+// a category when it is linked to the category or one of its descendants.
+func (r *productRepository) CategoryFacets(ctx context.Context, filter request.ProductFilter) ([]entity.FacetCount, error) {
+	products := r.filterProducts(r.db.Model(&entity.Product{}).Select("id"), filter)
+
+	query := r.db.WithContext(ctx).Table("(?) AS p", products).
+		Select("categories.id, categories.name, count(DISTINCT p.id) AS count").
+		Joins("JOIN product_categories ON product_categories.product_id = p.id").
+		Joins("JOIN categories AS linked ON linked.id = product_categories.category_id").
+		Joins("JOIN categories ON linked.path LIKE categories.path || '%'")
+	if filter.Category != nil {
+		query = query.Where("categories.parent_id = ?", *filter.Category)
+	} else {
+		query = query.Where("categories.parent_id IS NULL")
 	}
 
-	switch filter.Sort {
-	case "newest":
-		query = query.Order("created_at DESC")
-	case "cheapest":
-		query = query.Order("price ASC")
-	case "expensive":
-		query = query.Order("price DESC")
-	case "name asc":
-		query = query.Order("name ASC")
-	case "name desc":
-		query = query.Order("name DESC")
+	var counts []entity.FacetCount
+	err := query.Group("categories.id, categories.name").
+		Order("count DESC, categories.name").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// PriceFacets counts the products matching filter per price bucket, where
+// bounds are the ascending prices in the base currency at which one bucket
+// ends and the next begins. Every bucket is returned, empty ones included.
+// The price range filter is left out so that the other buckets keep their
+// counts.
+func (r *productRepository) PriceFacets(ctx context.Context, filter request.ProductFilter, bounds []int64) ([]entity.PriceBucket, error) {
+	filter.MinPrice, filter.MaxPrice = nil, nil
+	products := r.filterProducts(r.db.Model(&entity.Product{}).Select("base_price"), filter)
+
+	// The bounds go in as one array literal, as a slice argument would be
+	// expanded into a list.
+	literal := make([]string, len(bounds))
+	for i, bound := range bounds {
+		literal[i] = strconv.FormatInt(bound, 10)
+	}
+
+	var rows []struct {
+		Bucket int
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).Table("(?) AS p", products).
+		Select("width_bucket(p.base_price, ?::bigint[]) AS bucket, count(*) AS count", "{"+strings.Join(literal, ",")+"}").
+		Where("p.base_price IS NOT NULL").
+		Group("bucket").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// width_bucket numbers the bucket below the first bound 0 and the one
+	// from the last bound on len(bounds).
+	buckets := make([]entity.PriceBucket, len(bounds)+1)
+	for i := range buckets {
+		if i > 0 {
+			buckets[i].Min = bounds[i-1]
+		}
+		if i < len(bounds) {
+			bound := bounds[i]
+			buckets[i].Max = &bound
+		}
+	}
+	for _, row := range rows {
+		buckets[row.Bucket].Count = row.Count
+	}
+	return buckets, nil
+}
+
+// StockFacets counts the products matching filter in and out of stock, at the
+// location of the filter when it has one. The stock filter is left out so
+// that both counts stay available.
+func (r *productRepository) StockFacets(ctx context.Context, filter request.ProductFilter) (*entity.StockCount, error) {
+	filter.InStock = nil
+	products := r.db.Model(&entity.Product{}).Select("quantity")
+	if filter.LocationID != nil {
+		products = r.db.Model(&entity.Product{}).Select("(SELECT product_stocks.quantity FROM product_stocks WHERE product_stocks.product_id = products.id AND product_stocks.location_id = ?) AS quantity", *filter.LocationID)
+	}
+	products = r.filterProducts(products, filter)
+
+	var counts entity.StockCount
+	err := r.db.WithContext(ctx).Table("(?) AS p", products).
+		Select("count(*) FILTER (WHERE p.quantity > 0) AS in_stock, count(*) FILTER (WHERE COALESCE(p.quantity, 0) <= 0) AS out_of_stock").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return &counts, nil
+}
+
+// sortProducts orders query by the sort fields, selecting the relevance score
+// while searching. It also returns the SQL each field orders on, for cursors.
+func (r *productRepository) sortProducts(query *gorm.DB, search string, sortFields []request.SortField) (*gorm.DB, []clause.Expr) {
+	if search != "" {
+		score := r.scoreExpr(search)
+		query = query.Select("*, ("+score.SQL+") AS score", score.Vars...)
+	}
+
+	columns := make([]clause.Expr, len(sortFields))
+	for i, field := range sortFields {
+		direction := "ASC"
+		if field.Desc {
+			direction = "DESC"
+		}
+		query = query.Order(field.Column() + " " + direction)
+
+		columns[i] = clause.Expr{SQL: field.Column()}
+		if field.Relevance() {
+			score := r.scoreExpr(search)
+			columns[i] = clause.Expr{SQL: "(" + score.SQL + ")", Vars: score.Vars}
+		}
+	}
+
+	return query, columns
+}
+
+// Suggest returns distinct names of published products similar to term, most
+// similar first. The trigram operator tolerates typos and is served by the GIN
+// index.
+func (r *productRepository) Suggest(ctx context.Context, term string, limit int) ([]entity.ProductSuggestion, error) {
+	var suggestions []entity.ProductSuggestion
+	query := applyProductFilter(r.db.WithContext(ctx).Model(&entity.Product{}), request.ProductFilter{Published: true})
+	err := query.
+		Select("name, MAX(similarity(name, ?)) AS score", term).
+		Where("name % ?", term).
+		Group("name").
+		Order("score DESC, name").
+		Limit(limit).
+		Scan(&suggestions).Error
+	if err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
+
+// scoreExpr ranks a product against a search term by trigram similarity of
+// name and, at half weight, description. With a text search configuration the
+// full-text rank of the term is added on top.
+func (r *productRepository) scoreExpr(search string) clause.Expr {
+	score := clause.Expr{
+		SQL:  "similarity(name, ?) + similarity(COALESCE(description, ''), ?) / 2",
+		Vars: []interface{}{search, search},
+	}
+	if r.textSearchConfig != "" {
+		score.SQL += " + ts_rank(to_tsvector(?::regconfig, name || ' ' || COALESCE(description, '')), plainto_tsquery(?::regconfig, ?))"
+		score.Vars = append(score.Vars, r.textSearchConfig, r.textSearchConfig, search)
+	}
+	return score
+}
+
+// constraintViolation turns a clash on one of the unique identifiers into
+// ErrConflict naming the identifier, and a reference to a missing brand or to
+// a currency without an exchange rate into ErrValidation. Other errors pass
+// through.
+func constraintViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	if pgErr.Code == pgForeignKeyViolation && pgErr.ConstraintName == "fk_products_brand" {
+		return fmt.Errorf("%w: brand_id does not refer to an existing brand", constant.ErrValidation)
+	}
+	if pgErr.Code == pgForeignKeyViolation && (pgErr.ConstraintName == "fk_products_currency" || pgErr.ConstraintName == "fk_product_prices_currency") {
+		return fmt.Errorf("%w: currency has no exchange rate", constant.ErrValidation)
+	}
+	if pgErr.Code != pgUniqueViolation {
+		return err
+	}
+
+	switch pgErr.ConstraintName {
+	case "idx_products_sku_unique":
+		return fmt.Errorf("%w: sku is already used by another product", constant.ErrConflict)
+	case "idx_products_barcode_unique":
+		return fmt.Errorf("%w: barcode is already used by another product", constant.ErrConflict)
+	case "idx_products_slug_unique":
+		return fmt.Errorf("%w: slug is already used by another product, please retry", constant.ErrConflict)
+	case "idx_categories_parent_name_unique":
+		return fmt.Errorf("%w: a category with this name already exists under the same parent", constant.ErrConflict)
+	case "idx_product_variants_sku_unique":
+		return fmt.Errorf("%w: sku is already used by another variant", constant.ErrConflict)
+	case "idx_product_variants_options_unique":
+		return fmt.Errorf("%w: the product already has a variant with these options", constant.ErrConflict)
+	case "idx_brands_name_unique":
+		return fmt.Errorf("%w: a brand with this name already exists", constant.ErrConflict)
+	case "idx_locations_code_unique":
+		return fmt.Errorf("%w: a location with this code already exists", constant.ErrConflict)
+	case "idx_attributes_code_unique":
+		return fmt.Errorf("%w: an attribute with this code already exists", constant.ErrConflict)
 	default:
-		query = query.Order("created_at DESC")
+		return fmt.Errorf("%w: %s", constant.ErrConflict, pgErr.Detail)
 	}
+}
 
-	offset := (filter.Page - 1) * filter.Limit
-	query = query.Offset(offset).Limit(filter.Limit)
+func applyProductFilter(query *gorm.DB, filter request.ProductFilter) *gorm.DB {
+	if filter.Published {
+		query = query.Where("status = ? AND (publish_at IS NULL OR publish_at <= now()) AND (unpublish_at IS NULL OR unpublish_at > now())", entity.ProductActive)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+	// Price bounds are in the base currency, whatever a product is priced in.
+	if filter.MinPrice != nil {
+		query = query.Where("base_price >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		query = query.Where("base_price <= ?", *filter.MaxPrice)
+	}
+	if filter.LocationID != nil {
+		// At a location, being in stock refers to the stock kept there.
+		stocked := ""
+		if filter.InStock != nil {
+			if *filter.InStock {
+				stocked = " AND quantity > 0"
+			} else {
+				stocked = " AND quantity <= 0"
+			}
+		}
+		query = query.Where("id IN (SELECT product_id FROM product_stocks WHERE location_id = ?"+stocked+")", *filter.LocationID)
+	} else if filter.InStock != nil {
+		if *filter.InStock {
+			query = query.Where("quantity > 0")
+		} else {
+			query = query.Where("COALESCE(quantity, 0) <= 0")
+		}
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("created_at <= ?", *filter.CreatedTo)
+	}
+	if filter.CreatedBy != "" {
+		query = query.Where("created_by = ?", filter.CreatedBy)
+	}
+	if len(filter.IDs) > 0 {
+		query = query.Where("id IN ?", filter.IDs)
+	}
+	if len(filter.BrandIDs) > 0 {
+		query = query.Where("brand_id IN ?", filter.BrandIDs)
+	}
+	if filter.Category != nil {
+		// Descendants share the path of the category as prefix.
+		query = query.Where(`id IN (SELECT product_categories.product_id FROM product_categories
+			JOIN categories ON categories.id = product_categories.category_id
+			WHERE categories.path LIKE (SELECT path FROM categories WHERE id = ?) || '%')`, *filter.Category)
+	}
+	for _, condition := range filter.AttributeConditions {
+		query = applyAttributeCondition(query, condition)
+	}
+	return query
+}
 
-	if err := query.Find(&products).Error; err != nil {
+// applyAttributeCondition narrows query to the products whose attribute value
+// meets condition. Equality is a containment test and ranges a jsonpath
+// predicate, both served by the GIN index on the attributes. The code was
+// checked against the attribute definitions, so it is safe to quote into the
+// path.
+func applyAttributeCondition(query *gorm.DB, condition request.AttributeCondition) *gorm.DB {
+	if condition.Operator == request.AttributeEqual {
+		matches := make([]string, len(condition.Values))
+		args := make([]interface{}, len(condition.Values))
+		for i, value := range condition.Values {
+			contained, _ := json.Marshal(map[string]interface{}{condition.Code: value})
+			matches[i] = "attributes @> ?::jsonb"
+			args[i] = string(contained)
+		}
+		return query.Where(strings.Join(matches, " OR "), args...)
+	}
+
+	value, _ := json.Marshal(condition.Values[0])
+	return query.Where("attributes @@ ?::jsonpath", fmt.Sprintf(`$."%s" %s %s`, condition.Code, condition.Operator, value))
+}
+
+// productCursorCondition seeks past the row a cursor points at. columns holds
+// the SQL each sort field orders on. When every field sorts the same way a
+// single row value comparison is used, which the composite sort indexes can
+// serve directly; mixed directions expand into the equivalent OR of prefix
+// equalities.
+func productCursorCondition(encoded string, fields []request.SortField, columns []clause.Expr) (clause.Expr, error) {
+	invalid := fmt.Errorf("%w: invalid cursor", constant.ErrValidation)
+
+	cursor, err := request.DecodeProductCursor(encoded)
+	if err != nil || len(cursor.Values) != len(fields) {
+		return clause.Expr{}, invalid
+	}
+
+	values := make([]interface{}, len(fields))
+	for i, field := range fields {
+		switch field.Field {
+		case "id", "price", "quantity":
+			values[i], err = strconv.ParseInt(cursor.Values[i], 10, 64)
+		case "relevance":
+			values[i], err = strconv.ParseFloat(cursor.Values[i], 64)
+		case "name":
+			values[i] = cursor.Values[i]
+		default:
+			values[i], err = time.Parse(time.RFC3339Nano, cursor.Values[i])
+		}
+		if err != nil {
+			return clause.Expr{}, invalid
+		}
+	}
+
+	operator := func(desc bool) string {
+		if desc {
+			return "<"
+		}
+		return ">"
+	}
+
+	uniform := true
+	for _, field := range fields {
+		uniform = uniform && field.Desc == fields[0].Desc
+	}
+
+	if uniform {
+		sqls := make([]string, len(fields))
+		placeholders := make([]string, len(fields))
+		var vars []interface{}
+		for i, column := range columns {
+			sqls[i] = column.SQL
+			placeholders[i] = "?"
+			vars = append(vars, column.Vars...)
+		}
+		return clause.Expr{
+			SQL:  fmt.Sprintf("(%s) %s (%s)", strings.Join(sqls, ", "), operator(fields[0].Desc), strings.Join(placeholders, ", ")),
+			Vars: append(vars, values...),
+		}, nil
+	}
+
+	var branches []string
+	var vars []interface{}
+	for i, field := range fields {
+		var terms []string
+		for j := 0; j < i; j++ {
+			terms = append(terms, columns[j].SQL+" = ?")
+			vars = append(vars, columns[j].Vars...)
+			vars = append(vars, values[j])
+		}
+		terms = append(terms, fmt.Sprintf("%s %s ?", columns[i].SQL, operator(field.Desc)))
+		vars = append(vars, columns[i].Vars...)
+		vars = append(vars, values[i])
+		branches = append(branches, "("+strings.Join(terms, " AND ")+")")
+	}
+
+	return clause.Expr{
+		SQL:  strings.Join(branches, " OR "),
+		Vars: vars,
+	}, nil
+}
+
+// Update replaces the editable columns of a product. A new price or currency
+// is recorded in its price history.
+func (r *productRepository) Update(ctx context.Context, product *entity.Product) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		before, err := lockProduct(tx, product.ID)
+		if err != nil {
+			return err
+		}
+
+		query := tx.Model(product).Clauses(clause.Returning{})
+		if product.Version > 0 {
+			query = query.Where("version = ?", product.Version)
+		}
+
+		fields := map[string]interface{}{
+			"name":        product.Name,
+			"sku":         product.SKU,
+			"barcode":     product.Barcode,
+			"brand_id":    product.BrandID,
+			"price":       product.Price,
+			"description": product.Description,
+			"updated_at":  product.UpdatedAt,
+			"updated_by":  product.UpdatedBy,
+			"version":     gorm.Expr("version + 1"),
+		}
+		// An update that names no currency keeps the current one.
+		if product.Currency != "" {
+			fields["currency"] = product.Currency
+		}
+
+		result := query.Updates(fields)
+		if result.Error != nil {
+			return constraintViolation(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errNothingUpdated
+		}
+		if err := recordPrice(tx, product); err != nil {
+			return err
+		}
+		return recordAudit(tx, []entity.AuditEvent{productAuditEvent(ctx, entity.AuditUpdate, product.UpdatedBy, before, product)})
+	})
+	if errors.Is(err, errNothingUpdated) {
+		return r.mutationMissError(ctx, product.ID, product.Version)
+	}
+	return err
+}
+
+// Patch writes the given columns of a product. A new price or currency is
+// recorded in its price history.
+func (r *productRepository) Patch(ctx context.Context, id int64, version int64, fields map[string]interface{}) (*entity.Product, error) {
+	var product entity.Product
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		before, err := lockProduct(tx, id)
+		if err != nil {
+			return err
+		}
+
+		query := tx.Model(&product).
+			Clauses(clause.Returning{}).
+			Where("id = ?", id)
+		if version > 0 {
+			query = query.Where("version = ?", version)
+		}
+
+		fields["version"] = gorm.Expr("version + 1")
+
+		result := query.Updates(fields)
+		if result.Error != nil {
+			return constraintViolation(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errNothingUpdated
+		}
+		_, repriced := fields["price"]
+		if _, ok := fields["currency"]; ok {
+			repriced = true
+		}
+		if repriced {
+			if err := recordPrice(tx, &product); err != nil {
+				return err
+			}
+		}
+		return recordAudit(tx, []entity.AuditEvent{productAuditEvent(ctx, entity.AuditUpdate, product.UpdatedBy, before, &product)})
+	})
+	if errors.Is(err, errNothingUpdated) {
+		return nil, r.mutationMissError(ctx, id, version)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+func (r *productRepository) Delete(ctx context.Context, id int64, version int64, deletedBy string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		before, err := lockProduct(tx, id)
+		if err != nil {
+			return err
+		}
+
+		var product entity.Product
+		query := tx.Model(&product).
+			Clauses(clause.Returning{}).
+			Where("id = ?", id)
+		if version > 0 {
+			query = query.Where("version = ?", version)
+		}
+
+		result := query.Updates(map[string]interface{}{
+			"deleted_at": time.Now(),
+			"deleted_by": deletedBy,
+			"version":    gorm.Expr("version + 1"),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errNothingUpdated
+		}
+		return recordAudit(tx, []entity.AuditEvent{productAuditEvent(ctx, entity.AuditDelete, deletedBy, before, &product)})
+	})
+	if errors.Is(err, errNothingUpdated) {
+		return r.mutationMissError(ctx, id, version)
+	}
+	return err
+}
+
+// Restore brings back a soft-deleted product. With a version above 0 the
+// locked row must still be at that version.
+func (r *productRepository) Restore(ctx context.Context, id int64, version int64, updatedBy string) (*entity.Product, error) {
+	var product entity.Product
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		before, err := lockProduct(tx.Unscoped().Where("deleted_at IS NOT NULL"), id)
+		if err != nil {
+			return err
+		}
+		if version > 0 && before.Version != version {
+			return constant.ErrVersionMismatch
+		}
+
+		result := tx.Unscoped().Model(&product).
+			Clauses(clause.Returning{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Updates(map[string]interface{}{
+				"deleted_at": nil,
+				"deleted_by": nil,
+				"updated_at": time.Now(),
+				"updated_by": updatedBy,
+				"version":    gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errNothingUpdated
+		}
+		return recordAudit(tx, []entity.AuditEvent{productAuditEvent(ctx, entity.AuditRestore, updatedBy, before, &product)})
+	})
+	if errors.Is(err, errNothingUpdated) {
+		return nil, constant.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// Purge hard-deletes the products soft-deleted before deletedBefore. Their
+// audit history is kept, ending with the purge. The storage keys of their
+// media are returned so the caller can remove the files the cascade leaves
+// behind.
+func (r *productRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, []string, error) {
+	var products []entity.Product
+	var keys []string
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The rows are locked first so a restore cannot slip in between
+		// reading the media and deleting the products.
+		var ids []int64
+		err := tx.Unscoped().Model(&entity.Product{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		var media []entity.ProductMedia
+		err = tx.Select("storage_key", "thumbnail_key").Where("product_id IN ?", ids).Find(&media).Error
+		if err != nil {
+			return err
+		}
+		for _, m := range media {
+			keys = append(keys, m.StorageKey, m.ThumbnailKey)
+		}
+
+		err = tx.Unscoped().
+			Clauses(clause.Returning{}).
+			Where("id IN ?", ids).
+			Delete(&products).Error
+		if err != nil {
+			return err
+		}
+
+		events := make([]entity.AuditEvent, len(products))
+		for i := range products {
+			events[i] = productAuditEvent(ctx, entity.AuditPurge, purgeActor, &products[i], nil)
+		}
+		return recordAudit(tx, events)
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return int64(len(products)), keys, nil
+}
+
+// FetchPublishChanges returns the active products whose publish window opened
+// or closed after from and no later than to. Nothing is written when a window
+// passes, so this is how the change is noticed.
+func (r *productRepository) FetchPublishChanges(ctx context.Context, from time.Time, to time.Time) ([]entity.Product, error) {
+	var products []entity.Product
+	err := r.db.WithContext(ctx).
+		Where("status = ?", entity.ProductActive).
+		Where("(publish_at > ? AND publish_at <= ?) OR (unpublish_at > ? AND unpublish_at <= ?)", from, to, from, to).
+		Order("id").
+		Find(&products).Error
+	if err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// FetchHistory returns a page of the audit events of a product, latest first,
+// with the number of events in total. The events outlive the product, so the
+// history of a purged product can still be read.
+func (r *productRepository) FetchHistory(ctx context.Context, id int64, page int, limit int) ([]entity.AuditEvent, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entity.AuditEvent{}).
+		Where("entity_type = ? AND entity_id = ?", entity.AuditProduct, id)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	return products, total, nil
+	var events []entity.AuditEvent
+	err := query.Order("id DESC").Offset((page - 1) * limit).Limit(limit).Find(&events).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+// mutationMissError explains why a conditional write touched no rows: either
+// the product is gone or its version moved on since the caller read it.
+func (r *productRepository) mutationMissError(ctx context.Context, id int64, version int64) error {
+	if version <= 0 {
+		return constant.ErrNotFound
+	}
+
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&entity.Product{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return constant.ErrNotFound
+	}
+	return constant.ErrVersionMismatch
 }
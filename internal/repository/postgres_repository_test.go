@@ -7,26 +7,33 @@ import (
 	"erajaya-test/internal/models/entity"
 	"erajaya-test/internal/models/request"
 	"erajaya-test/shared/constant"
+	"erajaya-test/shared/middlewares"
+	"errors"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
+// lockProductSQL is the read that locks a product before a write audits it.
+const lockProductSQL = `SELECT * FROM "products" WHERE id = $1 AND "products"."deleted_at" IS NULL LIMIT $2 FOR UPDATE`
+
 type PostgresSuite struct {
 	suite.Suite
-	mock sqlmock.Sqlmock
-	repo interfaces.ProductRepository
-	db   *sql.DB
+	mock   sqlmock.Sqlmock
+	repo   interfaces.ProductRepository
+	db     *sql.DB
+	gormDB *gorm.DB
 }
 
 func (s *PostgresSuite) SetupTest() {
 	var err error
-	var gormDB *gorm.DB
 
 	s.db, s.mock, err = sqlmock.New()
 	s.Require().NoError(err)
@@ -35,10 +42,10 @@ func (s *PostgresSuite) SetupTest() {
 		Conn:       s.db,
 		DriverName: "postgres",
 	})
-	gormDB, err = gorm.Open(dialector, &gorm.Config{})
+	s.gormDB, err = gorm.Open(dialector, &gorm.Config{})
 	s.Require().NoError(err)
 
-	s.repo = NewProductRepository(gormDB)
+	s.repo = NewProductRepository(s.gormDB, "")
 }
 
 func (s *PostgresSuite) TearDownTest() {
@@ -47,29 +54,175 @@ func (s *PostgresSuite) TearDownTest() {
 
 func (s *PostgresSuite) TestCreate() {
 	price := int64(5000000)
-	product := &entity.Product{Name: "LG TV", Price: &price}
+	product := &entity.Product{Name: "LG TV", Price: &price, Currency: "IDR"}
 
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "slug" FROM "products" WHERE slug = $1 OR slug LIKE $2`)).
+		WithArgs("lg-tv", "lg-tv-%").
+		WillReturnRows(sqlmock.NewRows([]string{"slug"}).AddRow("lg-tv").AddRow("lg-tv-2"))
 	s.mock.ExpectBegin()
 	s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "products"`)).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), nil, nil, "lg-tv-3", nil, sqlmock.AnyArg(), "IDR", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 1, "active", nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_prices" ("product_id","price","currency","valid_from","valid_to","applied_at","created_at","created_by") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs(1, price, "IDR", sqlmock.AnyArg(), nil, sqlmock.AnyArg(), sqlmock.AnyArg(), "").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events" ("entity_type","entity_id","action","actor","request_id","before","after","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs("product", 1, "create", "", "", nil, `{"currency":"IDR","description":"","name":"LG TV","price":5000000,"slug":"lg-tv-3","status":"active"}`, sqlmock.AnyArg()).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	s.mock.ExpectCommit()
 
 	err := s.repo.Create(context.Background(), product)
 	s.NoError(err)
+	s.Equal("lg-tv-3", product.Slug)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *PostgresSuite) TestCreateOpensStockLedger() {
+	price := int64(5000000)
+	quantity := 10
+	product := &entity.Product{Name: "LG TV", Slug: "lg-tv", Price: &price, Currency: "IDR", Quantity: &quantity, CreatedBy: "arya"}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "slug" FROM "products"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"slug"}))
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "products"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "locations" WHERE is_default LIMIT $1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	s.mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "product_stocks" ("product_id","location_id","quantity","reserved") VALUES ($1,$2,$3,$4)`)).
+		WithArgs(7, 1, 10, 0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "stock_movements" ("product_id","location_id","type","quantity","balance","reason","created_at","created_by") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs(7, 1, entity.StockMovementAdjustment, 10, 10, "opening balance", sqlmock.AnyArg(), "arya").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_prices" ("product_id","price","currency","valid_from","valid_to","applied_at","created_at","created_by") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs(7, price, "IDR", sqlmock.AnyArg(), nil, sqlmock.AnyArg(), sqlmock.AnyArg(), "arya").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+		WithArgs("product", 7, "create", "arya", "", nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	s.mock.ExpectCommit()
+
+	err := s.repo.Create(context.Background(), product)
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *PostgresSuite) TestCreateConflict() {
+	sku := "LG-TV-42"
+	product := &entity.Product{Name: "LG TV", SKU: &sku}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "slug" FROM "products"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"slug"}))
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "products"`)).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_products_sku_unique"})
+	s.mock.ExpectRollback()
+
+	err := s.repo.Create(context.Background(), product)
+	s.ErrorIs(err, constant.ErrConflict)
+	s.EqualError(err, "conflict: sku is already used by another product")
+}
+
+func (s *PostgresSuite) TestCreateBatch() {
+	price := int64(5000000)
+	products := []*entity.Product{
+		{Name: "LG TV", Price: &price},
+		{Name: "LG TV", Price: &price},
+		{Name: "QLED", Price: &price},
+	}
+
+	s.Run("Success", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "slug" FROM "products" WHERE slug = $1 OR slug LIKE $2 OR slug = $3 OR slug LIKE $4`)).
+			WithArgs("lg-tv", "lg-tv-%", "qled", "qled-%").
+			WillReturnRows(sqlmock.NewRows([]string{"slug"}))
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "products"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "products"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_prices" ("product_id","price","currency","valid_from","valid_to","applied_at","created_at","created_by") VALUES ($1,$2,$3,$4,$5,$6,$7,$8),($9,$10,$11,$12,$13,$14,$15,$16),($17,$18,$19,$20,$21,$22,$23,$24) RETURNING "id"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2).AddRow(3))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events" ("entity_type","entity_id","action","actor","request_id","before","after","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8),($9,$10,$11,$12,$13,$14,$15,$16),($17,$18,$19,$20,$21,$22,$23,$24) RETURNING "id"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2).AddRow(3))
+		s.mock.ExpectCommit()
+
+		err := s.repo.CreateBatch(context.Background(), products, 2)
+		s.NoError(err)
+		s.Equal(int64(1), products[0].ID)
+		s.Equal(int64(3), products[2].ID)
+		s.Equal([]string{"lg-tv", "lg-tv-2", "qled"}, []string{products[0].Slug, products[1].Slug, products[2].Slug})
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Opens Stock Ledger Of Stocked Products", func() {
+		five, zero := 5, 0
+		stocked := []*entity.Product{
+			{Name: "OLED", Price: &price, Quantity: &five, CreatedBy: "arya"},
+			{Name: "NanoCell", Price: &price, Quantity: &zero, CreatedBy: "arya"},
+		}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "slug" FROM "products"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"slug"}))
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "products"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4).AddRow(5))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "locations" WHERE is_default LIMIT $1`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "product_stocks" ("product_id","location_id","quantity","reserved") VALUES ($1,$2,$3,$4)`)).
+			WithArgs(4, 1, 5, 0).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "stock_movements" ("product_id","location_id","type","quantity","balance","reason","created_at","created_by") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+			WithArgs(4, 1, entity.StockMovementAdjustment, 5, 5, "opening balance", sqlmock.AnyArg(), "arya").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_prices"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4).AddRow(5))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(4))
+		s.mock.ExpectCommit()
+
+		err := s.repo.CreateBatch(context.Background(), stocked, 2)
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Rolls Back On Error", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "slug" FROM "products"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"slug"}))
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "products"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "products"`)).
+			WillReturnError(errors.New("db error"))
+		s.mock.ExpectRollback()
+
+		err := s.repo.CreateBatch(context.Background(), products, 2)
+		s.Error(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
 }
 
 func (s *PostgresSuite) TestGetByID() {
 
-	columns := []string{"id", "name", "price", "description", "quantity", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
+	columns := []string{"id", "name", "price", "description", "quantity", "reserved", "created_by", "created_at", "updated_by", "updated_at", "deleted_by", "deleted_at"}
 
 	s.Run("Found", func() {
 		rows := sqlmock.NewRows(columns).
-			AddRow(1, "LG TV", 5000000, "Desc", 10, "arya", time.Now(), nil, nil, nil, nil)
+			AddRow(1, "LG TV", 5000000, "Desc", 10, 4, "arya", time.Now(), nil, nil, nil, nil)
 
-		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE "products"."id" = $1 ORDER BY "products"."id" LIMIT $2`)).
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE "products"."id" = $1 AND "products"."deleted_at" IS NULL ORDER BY "products"."id" LIMIT $2`)).
 			WithArgs(1, 1).
 			WillReturnRows(rows)
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_media" WHERE "product_media"."product_id" = $1 ORDER BY product_media.position, product_media.id`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "url", "position", "is_primary"}).
+				AddRow(5, 1, "http://localhost:8080/api/media/products/1/a.jpg", 1, true))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_variants" WHERE "product_variants"."product_id" = $1 ORDER BY product_variants.id`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "options", "price"}).
+				AddRow(1, 1, `{"color":"Black","storage":"128GB"}`, 5000000).
+				AddRow(2, 1, `{"color":"White","storage":"128GB"}`, 5000000).
+				AddRow(3, 1, `{"color":"Black","storage":"256GB"}`, 6000000))
 
 		res, err := s.repo.GetByID(context.Background(), 1)
 
@@ -80,10 +233,18 @@ func (s *PostgresSuite) TestGetByID() {
 		s.NoError(err)
 		s.NotNil(res)
 		s.Equal("LG TV", res.Name)
+		s.Equal(6, *res.Available)
+		s.Len(res.Variants, 3)
+		s.Len(res.Media, 1)
+		s.True(res.Media[0].IsPrimary)
+		s.Equal([]entity.ProductOption{
+			{Name: "color", Values: []string{"Black", "White"}},
+			{Name: "storage", Values: []string{"128GB", "256GB"}},
+		}, res.Options)
 	})
 
 	s.Run("Not Found", func() {
-		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE "products"."id" = $1 ORDER BY "products"."id" LIMIT $2`)).
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE "products"."id" = $1 AND "products"."deleted_at" IS NULL ORDER BY "products"."id" LIMIT $2`)).
 			WithArgs(999, 1).
 			WillReturnError(gorm.ErrRecordNotFound)
 
@@ -94,7 +255,7 @@ func (s *PostgresSuite) TestGetByID() {
 	})
 
 	s.Run("DB Error", func() {
-		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE "products"."id" = $1 ORDER BY "products"."id" LIMIT $2`)).
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE "products"."id" = $1 AND "products"."deleted_at" IS NULL ORDER BY "products"."id" LIMIT $2`)).
 			WithArgs(1, 1).
 			WillReturnError(sql.ErrConnDone)
 
@@ -104,6 +265,37 @@ func (s *PostgresSuite) TestGetByID() {
 	})
 }
 
+func (s *PostgresSuite) TestGetBySKUAndSlug() {
+
+	s.Run("SKU Found", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE sku = $1 AND "products"."deleted_at" IS NULL ORDER BY "products"."id" LIMIT $2`)).
+			WithArgs("LG-TV-42", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "slug", "name"}).AddRow(1, "LG-TV-42", "lg-tv", "LG TV"))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_media" WHERE "product_media"."product_id" = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_variants" WHERE "product_variants"."product_id" = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		res, err := s.repo.GetBySKU(context.Background(), "LG-TV-42")
+		s.NoError(err)
+		s.Empty(res.Options)
+		s.Equal("LG-TV-42", *res.SKU)
+		s.Equal("lg-tv", res.Slug)
+	})
+
+	s.Run("Slug Not Found", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE slug = $1 AND "products"."deleted_at" IS NULL ORDER BY "products"."id" LIMIT $2`)).
+			WithArgs("missing", 1).
+			WillReturnError(gorm.ErrRecordNotFound)
+
+		res, err := s.repo.GetBySlug(context.Background(), "missing")
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(res)
+	})
+}
+
 func (s *PostgresSuite) TestFetch() {
 	filter := request.ProductFilter{
 		Search: "LG",
@@ -112,13 +304,13 @@ func (s *PostgresSuite) TestFetch() {
 		Limit:  10,
 	}
 
-	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE name ILIKE $1 OR description ILIKE $2`)).
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE (name ILIKE $1 OR description ILIKE $2) AND "products"."deleted_at" IS NULL`)).
 		WithArgs("%LG%", "%LG%").
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(10))
 
 	rows := sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "LG TV")
-	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE name ILIKE $1 OR description ILIKE $2 ORDER BY price ASC LIMIT $3`)).
-		WithArgs("%LG%", "%LG%", 10).
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT *, (similarity(name, $1) + similarity(COALESCE(description, ''), $2) / 2) AS score FROM "products" WHERE (name ILIKE $3 OR description ILIKE $4) AND "products"."deleted_at" IS NULL ORDER BY base_price ASC,id ASC LIMIT $5`)).
+		WithArgs("LG", "LG", "%LG%", "%LG%", 10).
 		WillReturnRows(rows)
 
 	res, total, err := s.repo.Fetch(context.Background(), filter)
@@ -127,6 +319,196 @@ func (s *PostgresSuite) TestFetch() {
 	s.Len(res, 1)
 }
 
+func (s *PostgresSuite) TestFetchStructuredFilter() {
+	minPrice, maxPrice := int64(1000), int64(5000)
+	inStock, outOfStock := true, false
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	s.Run("All Filters", func() {
+		filter := request.ProductFilter{
+			Page:        1,
+			Limit:       10,
+			MinPrice:    &minPrice,
+			MaxPrice:    &maxPrice,
+			InStock:     &inStock,
+			CreatedFrom: &from,
+			CreatedTo:   &to,
+			CreatedBy:   "arya",
+			IDs:         []int64{1, 2},
+		}
+
+		where := `WHERE base_price >= $1 AND base_price <= $2 AND quantity > 0 AND created_at >= $3 AND created_at <= $4 AND created_by = $5 AND id IN ($6,$7) AND "products"."deleted_at" IS NULL`
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" `+where)).
+			WithArgs(minPrice, maxPrice, from, to, "arya", 1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" `+where+` ORDER BY created_at DESC,id DESC LIMIT $8`)).
+			WithArgs(minPrice, maxPrice, from, to, "arya", 1, 2, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "LG TV"))
+
+		res, total, err := s.repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Equal(int64(1), total)
+		s.Len(res, 1)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Category With Descendants", func() {
+		category := int64(4)
+		filter := request.ProductFilter{Page: 1, Limit: 10, Category: &category, SkipTotal: true}
+
+		s.mock.ExpectQuery(`SELECT \* FROM "products" WHERE id IN \(SELECT product_categories.product_id FROM product_categories\s+`+
+			`JOIN categories ON categories.id = product_categories.category_id\s+`+
+			regexp.QuoteMeta(`WHERE categories.path LIKE (SELECT path FROM categories WHERE id = $1) || '%') AND "products"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT $2`)).
+			WithArgs(category, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "LG TV"))
+
+		res, _, err := s.repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Len(res, 1)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Brands", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, BrandIDs: []int64{2, 3}, SkipTotal: true}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE brand_id IN ($1,$2) AND "products"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT $3`)).
+			WithArgs(2, 3, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "brand_id"}).AddRow(1, "Galaxy S24", 2))
+
+		res, _, err := s.repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Equal(int64(2), *res[0].BrandID)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Attributes", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, SkipTotal: true, AttributeConditions: []request.AttributeCondition{
+			{Code: "color", Operator: request.AttributeEqual, Values: []interface{}{"Black", "White"}},
+			{Code: "ram_gb", Operator: request.AttributeGreaterEqual, Values: []interface{}{float64(8)}},
+		}}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE (attributes @> $1::jsonb OR attributes @> $2::jsonb) AND attributes @@ $3::jsonpath AND "products"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT $4`)).
+			WithArgs(`{"color":"Black"}`, `{"color":"White"}`, `$."ram_gb" >= 8`, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "attributes"}).AddRow(1, "Galaxy S24", `{"color":"Black","ram_gb":8}`))
+
+		res, _, err := s.repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Equal(entity.ProductAttributes{"color": "Black", "ram_gb": float64(8)}, res[0].Attributes)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Published", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, Published: true, SkipTotal: true}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE (status = $1 AND (publish_at IS NULL OR publish_at <= now()) AND (unpublish_at IS NULL OR unpublish_at > now())) AND "products"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT $2`)).
+			WithArgs("active", 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "status"}).AddRow(1, "LG TV", "active"))
+
+		res, _, err := s.repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Equal("active", res[0].Status)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Statuses", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, Statuses: []string{"archived", "draft"}, SkipTotal: true}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE status IN ($1,$2) AND "products"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT $3`)).
+			WithArgs("archived", "draft", 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "status"}).AddRow(1, "LG TV", "draft"))
+
+		res, _, err := s.repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Len(res, 1)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Out Of Stock", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, InStock: &outOfStock, SkipTotal: true}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE COALESCE(quantity, 0) <= 0 AND "products"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT $1`)).
+			WithArgs(10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+
+		res, _, err := s.repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Empty(res)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("In Stock At Location", func() {
+		location := int64(2)
+		filter := request.ProductFilter{Page: 1, Limit: 10, LocationID: &location, InStock: &inStock, SkipTotal: true}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE (id IN (SELECT product_id FROM product_stocks WHERE location_id = $1 AND quantity > 0)) AND "products"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT $2`)).
+			WithArgs(location, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "quantity"}).AddRow(1, "LG TV", 10).AddRow(2, "OLED", 3))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_stocks" WHERE location_id = $1 AND product_id IN ($2,$3)`)).
+			WithArgs(location, 1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"product_id", "location_id", "quantity", "reserved"}).AddRow(1, 2, 4, 1))
+
+		res, _, err := s.repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Equal(10, *res[0].Quantity)
+		s.Equal(4, res[0].LocationStock.Quantity)
+		s.Equal(3, res[0].LocationStock.Available)
+		s.Nil(res[1].LocationStock)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+}
+
+func (s *PostgresSuite) TestStream() {
+	minPrice := int64(1000)
+
+	s.Run("Streams Every Row", func() {
+		filter := request.ProductFilter{Sort: "price", Page: 3, Limit: 1, MinPrice: &minPrice}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE base_price >= $1 AND "products"."deleted_at" IS NULL ORDER BY base_price ASC,id ASC`)).
+			WithArgs(minPrice).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "LG TV").AddRow(2, "OLED"))
+
+		var names []string
+		err := s.repo.Stream(context.Background(), filter, func(product entity.Product) error {
+			names = append(names, product.Name)
+			return nil
+		})
+		s.NoError(err)
+		s.Equal([]string{"LG TV", "OLED"}, names)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Callback Error Stops", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE "products"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "LG TV").AddRow(2, "OLED"))
+
+		calls := 0
+		err := s.repo.Stream(context.Background(), request.ProductFilter{}, func(product entity.Product) error {
+			calls++
+			return errors.New("client gone")
+		})
+		s.EqualError(err, "client gone")
+		s.Equal(1, calls)
+	})
+
+	s.Run("Query Error", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products"`)).
+			WillReturnError(errors.New("db error"))
+
+		err := s.repo.Stream(context.Background(), request.ProductFilter{}, func(product entity.Product) error {
+			return nil
+		})
+		s.Error(err)
+	})
+
+	s.Run("Invalid Sort", func() {
+		err := s.repo.Stream(context.Background(), request.ProductFilter{Sort: "stock"}, func(product entity.Product) error {
+			return nil
+		})
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+}
+
 func (s *PostgresSuite) TestFetchSort() {
 
 	columns := []string{"id", "name", "price", "description", "quantity", "created_at"}
@@ -139,37 +521,47 @@ func (s *PostgresSuite) TestFetchSort() {
 		{
 			name:            "Sort Newest",
 			sortParam:       "newest",
-			expectedOrderBy: "created_at DESC",
+			expectedOrderBy: "created_at DESC,id DESC",
 		},
 		{
 			name:            "Sort Cheapest",
 			sortParam:       "cheapest",
-			expectedOrderBy: "price ASC",
+			expectedOrderBy: "base_price ASC,id ASC",
 		},
 		{
 			name:            "Sort Expensive",
 			sortParam:       "expensive",
-			expectedOrderBy: "price DESC",
+			expectedOrderBy: "base_price DESC,id DESC",
 		},
 		{
 			name:            "Sort Name ASC",
 			sortParam:       "name asc",
-			expectedOrderBy: "name ASC",
+			expectedOrderBy: "name ASC,id ASC",
 		},
 		{
 			name:            "Sort Name DESC",
 			sortParam:       "name desc",
-			expectedOrderBy: "name DESC",
+			expectedOrderBy: "name DESC,id DESC",
 		},
 		{
 			name:            "Sort Default (Empty)",
 			sortParam:       "",
-			expectedOrderBy: "created_at DESC",
+			expectedOrderBy: "created_at DESC,id DESC",
+		},
+		{
+			name:            "Sort Fields",
+			sortParam:       "price,-created_at,name",
+			expectedOrderBy: "base_price ASC,created_at DESC,name ASC,id ASC",
 		},
 		{
-			name:            "Sort Default (Invalid)",
-			sortParam:       "random_string",
-			expectedOrderBy: "created_at DESC",
+			name:            "Sort Quantity Descending",
+			sortParam:       "-quantity",
+			expectedOrderBy: "COALESCE(quantity, 0) DESC,id DESC",
+		},
+		{
+			name:            "Sort Explicit ID",
+			sortParam:       "-id,name",
+			expectedOrderBy: "id DESC,name ASC",
 		},
 	}
 
@@ -200,6 +592,18 @@ func (s *PostgresSuite) TestFetchSort() {
 	}
 }
 
+func (s *PostgresSuite) TestFetchSortRejected() {
+	for _, sort := range []string{"random_string", "price,description", "price,-price"} {
+		filter := request.ProductFilter{Sort: sort, Page: 1, Limit: 10}
+
+		products, _, err := s.repo.Fetch(context.Background(), filter)
+
+		s.ErrorIs(err, constant.ErrValidation, sort)
+		s.Nil(products)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
 func (s *PostgresSuite) TestFetchErrors() {
 	filter := request.ProductFilter{
 		Search: "LG",
@@ -208,7 +612,7 @@ func (s *PostgresSuite) TestFetchErrors() {
 	}
 
 	s.Run("Count Error", func() {
-		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE name ILIKE $1 OR description ILIKE $2`)).
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE (name ILIKE $1 OR description ILIKE $2) AND "products"."deleted_at" IS NULL`)).
 			WithArgs("%LG%", "%LG%").
 			WillReturnError(sql.ErrConnDone)
 
@@ -222,8 +626,8 @@ func (s *PostgresSuite) TestFetchErrors() {
 		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products"`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(10))
 
-		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE name ILIKE $1 OR description ILIKE $2 ORDER BY created_at DESC LIMIT $3`)).
-			WithArgs("%LG%", "%LG%", 10).
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT *, (similarity(name, $1) + similarity(COALESCE(description, ''), $2) / 2) AS score FROM "products" WHERE (name ILIKE $3 OR description ILIKE $4) AND "products"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT $5`)).
+			WithArgs("LG", "LG", "%LG%", "%LG%", 10).
 			WillReturnError(sql.ErrConnDone)
 
 		res, total, err := s.repo.Fetch(context.Background(), filter)
@@ -233,6 +637,885 @@ func (s *PostgresSuite) TestFetchErrors() {
 	})
 }
 
+func (s *PostgresSuite) TestFetchCursor() {
+
+	s.Run("Seek Newest Without Count", func() {
+		createdAt := time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC)
+		filter := request.ProductFilter{
+			Limit:     11,
+			SkipTotal: true,
+			Cursor: request.EncodeProductCursor(request.ProductCursor{
+				Sort:   "-created_at,-id",
+				Values: []string{createdAt.Format(time.RFC3339Nano), "42"},
+			}),
+		}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE (created_at, id) < ($1, $2) AND "products"."deleted_at" IS NULL ORDER BY created_at DESC,id DESC LIMIT $3`)).
+			WithArgs(createdAt, int64(42), 11).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(41, "LG TV"))
+
+		res, total, err := s.repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Equal(int64(0), total)
+		s.Len(res, 1)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Seek Cheapest With Count", func() {
+		filter := request.ProductFilter{
+			Sort:  "cheapest",
+			Limit: 11,
+			Cursor: request.EncodeProductCursor(request.ProductCursor{
+				Sort:   "price,id",
+				Values: []string{"5000000", "7"},
+			}),
+		}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(20))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE "products"."deleted_at" IS NULL AND (base_price, id) > ($1, $2) ORDER BY base_price ASC,id ASC LIMIT $3`)).
+			WithArgs(int64(5000000), int64(7), 11).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(8, "LG TV"))
+
+		res, total, err := s.repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Equal(int64(20), total)
+		s.Len(res, 1)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Seek Name", func() {
+		filter := request.ProductFilter{
+			Sort:      "name desc",
+			Limit:     11,
+			SkipTotal: true,
+			Cursor: request.EncodeProductCursor(request.ProductCursor{
+				Sort:   "-name,-id",
+				Values: []string{"LG TV", "3"},
+			}),
+		}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE (name, id) < ($1, $2) AND "products"."deleted_at" IS NULL ORDER BY name DESC,id DESC LIMIT $3`)).
+			WithArgs("LG TV", int64(3), 11).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+
+		res, _, err := s.repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Empty(res)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Seek Mixed Directions", func() {
+		createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		filter := request.ProductFilter{
+			Sort:      "price,-created_at",
+			Limit:     11,
+			SkipTotal: true,
+			Cursor: request.EncodeProductCursor(request.ProductCursor{
+				Sort:   "price,-created_at,-id",
+				Values: []string{"5000000", createdAt.Format(time.RFC3339Nano), "7"},
+			}),
+		}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE ((base_price > $1) OR (base_price = $2 AND created_at < $3) OR (base_price = $4 AND created_at = $5 AND id < $6)) AND "products"."deleted_at" IS NULL ORDER BY base_price ASC,created_at DESC,id DESC LIMIT $7`)).
+			WithArgs(int64(5000000), int64(5000000), createdAt, int64(5000000), createdAt, int64(7), 11).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+
+		res, _, err := s.repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Empty(res)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Cursor Of Another Length", func() {
+		filter := request.ProductFilter{
+			Limit:     11,
+			SkipTotal: true,
+			Cursor:    request.EncodeProductCursor(request.ProductCursor{Values: []string{"1"}}),
+		}
+
+		res, _, err := s.repo.Fetch(context.Background(), filter)
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(res)
+	})
+
+	s.Run("Invalid Cursor Encoding", func() {
+		filter := request.ProductFilter{Limit: 11, SkipTotal: true, Cursor: "%%%"}
+
+		res, _, err := s.repo.Fetch(context.Background(), filter)
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(res)
+	})
+
+	s.Run("Invalid Cursor Value", func() {
+		filter := request.ProductFilter{
+			Sort:      "expensive",
+			Limit:     11,
+			SkipTotal: true,
+			Cursor:    request.EncodeProductCursor(request.ProductCursor{Sort: "-price,id", Values: []string{"abc", "1"}}),
+		}
+
+		res, _, err := s.repo.Fetch(context.Background(), filter)
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(res)
+	})
+}
+
+func (s *PostgresSuite) TestUpdate() {
+	price := int64(5500000)
+	newProduct := func(version int64) *entity.Product {
+		return &entity.Product{ID: 1, Name: "LG TV", Price: &price, Description: "Desc", UpdatedBy: "arya", UpdatedAt: time.Now(), Version: version}
+	}
+
+	s.Run("Success", func() {
+		product := newProduct(2)
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "currency", "description", "version"}).AddRow(1, "LG TV", 5000000, "IDR", "Desc", 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET "barcode"=$1,"brand_id"=$2,"description"=$3,"name"=$4,"price"=$5,"sku"=$6,"updated_at"=$7,"updated_by"=$8,"version"=version + 1 WHERE version = $9 AND "products"."deleted_at" IS NULL AND "id" = $10 RETURNING *`)).
+			WithArgs(nil, nil, "Desc", "LG TV", &price, nil, sqlmock.AnyArg(), "arya", 2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "currency", "created_by", "version"}).AddRow(1, "LG TV", "IDR", "arya", 3))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_prices" WHERE product_id = $1 AND applied_at IS NOT NULL AND valid_to IS NULL LIMIT $2`)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "price", "currency"}).AddRow(4, 1, 5000000, "IDR"))
+		s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_prices" SET "valid_to"=$1 WHERE "id" = $2`)).
+			WithArgs(sqlmock.AnyArg(), 4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_prices" ("product_id","price","currency","valid_from","valid_to","applied_at","created_at","created_by") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+			WithArgs(1, price, "IDR", sqlmock.AnyArg(), nil, sqlmock.AnyArg(), sqlmock.AnyArg(), "arya").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events" ("entity_type","entity_id","action","actor","request_id","before","after","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+			WithArgs("product", 1, "update", "arya", "req-1", `{"price":5000000}`, `{"price":5500000}`, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		ctx := context.WithValue(context.Background(), middlewares.CtxRequestID, "req-1")
+		err := s.repo.Update(ctx, product)
+		s.NoError(err)
+		s.Equal("arya", product.CreatedBy)
+		s.Equal(int64(3), product.Version)
+	})
+
+	s.Run("Success Unconditional", func() {
+		product := newProduct(0)
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET "barcode"=$1,"brand_id"=$2,"description"=$3,"name"=$4,"price"=$5,"sku"=$6,"updated_at"=$7,"updated_by"=$8,"version"=version + 1 WHERE "products"."deleted_at" IS NULL AND "id" = $9 RETURNING *`)).
+			WithArgs(nil, nil, "Desc", "LG TV", &price, nil, sqlmock.AnyArg(), "arya", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 9))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_prices" WHERE product_id = $1 AND applied_at IS NOT NULL AND valid_to IS NULL LIMIT $2`)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "price"}).AddRow(4, 1, price))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Update(context.Background(), product)
+		s.NoError(err)
+		s.Equal(int64(9), product.Version)
+	})
+
+	s.Run("Changes Currency", func() {
+		product := newProduct(2)
+		product.Currency = "USD"
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET "barcode"=$1,"brand_id"=$2,"currency"=$3,"description"=$4,"name"=$5,"price"=$6,"sku"=$7,"updated_at"=$8,"updated_by"=$9,"version"=version + 1 WHERE version = $10 AND "products"."deleted_at" IS NULL AND "id" = $11 RETURNING *`)).
+			WithArgs(nil, nil, "USD", "Desc", "LG TV", &price, nil, sqlmock.AnyArg(), "arya", 2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "currency", "version"}).AddRow(1, "USD", 3))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_prices" WHERE product_id = $1 AND applied_at IS NOT NULL AND valid_to IS NULL LIMIT $2`)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "price", "currency"}).AddRow(4, 1, price, "IDR"))
+		s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_prices" SET "valid_to"=$1 WHERE "id" = $2`)).
+			WithArgs(sqlmock.AnyArg(), 4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_prices"`)).
+			WithArgs(1, price, "USD", sqlmock.AnyArg(), nil, sqlmock.AnyArg(), sqlmock.AnyArg(), "arya").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Update(context.Background(), product)
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE id = $1 AND "products"."deleted_at" IS NULL`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		err := s.repo.Update(context.Background(), newProduct(2))
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+
+	s.Run("Version Mismatch", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE id = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		err := s.repo.Update(context.Background(), newProduct(2))
+		s.ErrorIs(err, constant.ErrVersionMismatch)
+	})
+
+	s.Run("Version Check Error", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE id = $1`)).
+			WillReturnError(sql.ErrConnDone)
+
+		err := s.repo.Update(context.Background(), newProduct(2))
+		s.ErrorIs(err, sql.ErrConnDone)
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET`)).
+			WillReturnError(sql.ErrConnDone)
+		s.mock.ExpectRollback()
+
+		err := s.repo.Update(context.Background(), newProduct(2))
+		s.Error(err)
+	})
+
+	s.Run("Barcode Conflict", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET`)).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_products_barcode_unique"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Update(context.Background(), newProduct(2))
+		s.ErrorIs(err, constant.ErrConflict)
+	})
+
+	s.Run("Unknown Brand", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET`)).
+			WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "fk_products_brand"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Update(context.Background(), newProduct(2))
+		s.ErrorIs(err, constant.ErrValidation)
+		s.EqualError(err, "validation error: brand_id does not refer to an existing brand")
+	})
+
+	s.Run("Unknown Currency", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET`)).
+			WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "fk_products_currency"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Update(context.Background(), newProduct(2))
+		s.ErrorIs(err, constant.ErrValidation)
+		s.EqualError(err, "validation error: currency has no exchange rate")
+	})
+}
+
+func (s *PostgresSuite) TestPatch() {
+	newFields := func() map[string]interface{} {
+		return map[string]interface{}{"name": "LG OLED", "updated_by": "arya"}
+	}
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET "name"=$1,"updated_by"=$2,"version"=version + 1,"updated_at"=$3 WHERE id = $4 AND version = $5 AND "products"."deleted_at" IS NULL RETURNING *`)).
+			WithArgs("LG OLED", "arya", sqlmock.AnyArg(), 1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "updated_by", "version"}).AddRow(1, "LG OLED", 5000000, "arya", 3))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+			WithArgs("product", 1, "update", "arya", "", `{"name":"LG TV"}`, `{"name":"LG OLED"}`, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		res, err := s.repo.Patch(context.Background(), 1, 2, newFields())
+		s.NoError(err)
+		s.Equal("LG OLED", res.Name)
+		s.Equal(int64(5000000), *res.Price)
+		s.Equal(int64(3), res.Version)
+	})
+
+	s.Run("Success With Price", func() {
+		fields := map[string]interface{}{"price": int64(4500000), "updated_by": "arya"}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET "price"=$1,"updated_by"=$2,"version"=version + 1,"updated_at"=$3 WHERE id = $4 AND version = $5 AND "products"."deleted_at" IS NULL RETURNING *`)).
+			WithArgs(4500000, "arya", sqlmock.AnyArg(), 1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "price", "currency", "updated_by", "version"}).AddRow(1, 4500000, "IDR", "arya", 3))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_prices" WHERE product_id = $1 AND applied_at IS NOT NULL AND valid_to IS NULL LIMIT $2`)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_prices"`)).
+			WithArgs(1, 4500000, "IDR", sqlmock.AnyArg(), nil, sqlmock.AnyArg(), sqlmock.AnyArg(), "arya").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		res, err := s.repo.Patch(context.Background(), 1, 2, fields)
+		s.NoError(err)
+		s.Equal(int64(4500000), *res.Price)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Found Unconditional", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(999, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+
+		res, err := s.repo.Patch(context.Background(), 999, 0, newFields())
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(res)
+	})
+
+	s.Run("Version Mismatch", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE id = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		res, err := s.repo.Patch(context.Background(), 1, 2, newFields())
+		s.ErrorIs(err, constant.ErrVersionMismatch)
+		s.Nil(res)
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET`)).
+			WillReturnError(sql.ErrConnDone)
+		s.mock.ExpectRollback()
+
+		res, err := s.repo.Patch(context.Background(), 1, 2, newFields())
+		s.Error(err)
+		s.Nil(res)
+	})
+}
+
+func (s *PostgresSuite) TestDelete() {
+	deletedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET "deleted_at"=$1,"deleted_by"=$2,"version"=version + 1,"updated_at"=$3 WHERE id = $4 AND version = $5 AND "products"."deleted_at" IS NULL RETURNING *`)).
+			WithArgs(sqlmock.AnyArg(), "arya", sqlmock.AnyArg(), 1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "deleted_at", "deleted_by", "version"}).AddRow(1, "LG TV", 5000000, deletedAt, "arya", 3))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+			WithArgs("product", 1, "delete", "arya", "", `{"deleted_at":null,"deleted_by":null}`, `{"deleted_at":"2026-01-02T03:04:05Z","deleted_by":"arya"}`, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Delete(context.Background(), 1, 2, "arya")
+		s.NoError(err)
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(999, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Delete(context.Background(), 999, 0, "arya")
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+
+	s.Run("Version Mismatch", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products" WHERE id = $1`)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		err := s.repo.Delete(context.Background(), 1, 2, "arya")
+		s.ErrorIs(err, constant.ErrVersionMismatch)
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "version"}).AddRow(1, "LG TV", 5000000, 2))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET`)).
+			WillReturnError(sql.ErrConnDone)
+		s.mock.ExpectRollback()
+
+		err := s.repo.Delete(context.Background(), 1, 2, "arya")
+		s.Error(err)
+	})
+}
+
+func (s *PostgresSuite) TestFetchIncludeDeleted() {
+	filter := request.ProductFilter{
+		Page:           1,
+		Limit:          10,
+		IncludeDeleted: true,
+	}
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "products"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	rows := sqlmock.NewRows([]string{"id", "name", "deleted_at", "deleted_by"}).AddRow(1, "LG TV", time.Now(), "arya")
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" ORDER BY created_at DESC,id DESC LIMIT $1`)).
+		WithArgs(10).
+		WillReturnRows(rows)
+
+	res, total, err := s.repo.Fetch(context.Background(), filter)
+	s.NoError(err)
+	s.Equal(int64(1), total)
+	s.Len(res, 1)
+	s.True(res[0].DeletedAt.Valid)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *PostgresSuite) TestRestore() {
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE deleted_at IS NOT NULL AND id = $1 LIMIT $2 FOR UPDATE`)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at", "deleted_by", "version"}).AddRow(1, "LG TV", time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), "arya", 4))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET "deleted_at"=$1,"deleted_by"=$2,"updated_at"=$3,"updated_by"=$4,"version"=version + 1 WHERE id = $5 AND deleted_at IS NOT NULL RETURNING *`)).
+			WithArgs(nil, nil, sqlmock.AnyArg(), "arya", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "LG TV", nil))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+			WithArgs("product", 1, "restore", "arya", "", `{"deleted_at":"2026-01-02T03:04:05Z","deleted_by":"arya"}`, `{"deleted_at":null,"deleted_by":null}`, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		res, err := s.repo.Restore(context.Background(), 1, 4, "arya")
+		s.NoError(err)
+		s.Equal("LG TV", res.Name)
+		s.False(res.DeletedAt.Valid)
+	})
+
+	s.Run("Version Mismatch", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE deleted_at IS NOT NULL AND id = $1 LIMIT $2 FOR UPDATE`)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_at", "version"}).AddRow(1, time.Now(), 5))
+		s.mock.ExpectRollback()
+
+		res, err := s.repo.Restore(context.Background(), 1, 4, "arya")
+		s.ErrorIs(err, constant.ErrVersionMismatch)
+		s.Nil(res)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE deleted_at IS NOT NULL AND id = $1`)).
+			WithArgs(999, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+
+		res, err := s.repo.Restore(context.Background(), 999, 0, "arya")
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(res)
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "products" WHERE deleted_at IS NOT NULL AND id = $1`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_at"}).AddRow(1, time.Now()))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "products" SET`)).
+			WillReturnError(sql.ErrConnDone)
+		s.mock.ExpectRollback()
+
+		res, err := s.repo.Restore(context.Background(), 1, 0, "arya")
+		s.Error(err)
+		s.Nil(res)
+	})
+}
+
+func (s *PostgresSuite) TestPurge() {
+	deletedBefore := time.Now().Add(-720 * time.Hour)
+	lockSQL := `SELECT "id" FROM "products" WHERE deleted_at IS NOT NULL AND deleted_at < $1 FOR UPDATE`
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockSQL)).
+			WithArgs(deletedBefore).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2).AddRow(3))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "storage_key","thumbnail_key" FROM "product_media" WHERE product_id IN ($1,$2,$3)`)).
+			WithArgs(1, 2, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"storage_key", "thumbnail_key"}).AddRow("products/1/a1b2.jpg", "products/1/a1b2_thumb.jpg"))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`DELETE FROM "products" WHERE id IN ($1,$2,$3) RETURNING *`)).
+			WithArgs(1, 2, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_by"}).AddRow(1, "LG TV", "arya").AddRow(2, "QLED", "arya").AddRow(3, "OLED", "arya"))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events" ("entity_type","entity_id","action","actor","request_id","before","after","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8),($9,$10,$11,$12,$13,$14,$15,$16),($17,$18,$19,$20,$21,$22,$23,$24) RETURNING "id"`)).
+			WithArgs(
+				"product", 1, "purge", "system", "", sqlmock.AnyArg(), nil, sqlmock.AnyArg(),
+				"product", 2, "purge", "system", "", sqlmock.AnyArg(), nil, sqlmock.AnyArg(),
+				"product", 3, "purge", "system", "", sqlmock.AnyArg(), nil, sqlmock.AnyArg(),
+			).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2).AddRow(3))
+		s.mock.ExpectCommit()
+
+		purged, keys, err := s.repo.Purge(context.Background(), deletedBefore)
+		s.NoError(err)
+		s.Equal(int64(3), purged)
+		s.Equal([]string{"products/1/a1b2.jpg", "products/1/a1b2_thumb.jpg"}, keys)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Nothing To Purge", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockSQL)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectCommit()
+
+		purged, keys, err := s.repo.Purge(context.Background(), deletedBefore)
+		s.NoError(err)
+		s.Zero(purged)
+		s.Empty(keys)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockSQL)).
+			WillReturnError(sql.ErrConnDone)
+		s.mock.ExpectRollback()
+
+		purged, keys, err := s.repo.Purge(context.Background(), deletedBefore)
+		s.Error(err)
+		s.Equal(int64(0), purged)
+		s.Nil(keys)
+	})
+
+	s.Run("Delete Error", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockSQL)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "storage_key","thumbnail_key" FROM "product_media"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"storage_key", "thumbnail_key"}).AddRow("products/1/a1b2.jpg", "products/1/a1b2_thumb.jpg"))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`DELETE FROM "products"`)).
+			WillReturnError(sql.ErrConnDone)
+		s.mock.ExpectRollback()
+
+		purged, keys, err := s.repo.Purge(context.Background(), deletedBefore)
+		s.Error(err)
+		s.Equal(int64(0), purged)
+		s.Nil(keys)
+	})
+}
+
+func (s *PostgresSuite) TestFetchPublishChanges() {
+	to := time.Now()
+	from := to.Add(-time.Minute)
+	query := regexp.QuoteMeta(`SELECT * FROM "products" WHERE status = $1 AND ((publish_at > $2 AND publish_at <= $3) OR (unpublish_at > $4 AND unpublish_at <= $5)) AND "products"."deleted_at" IS NULL ORDER BY id`)
+
+	s.Run("Success", func() {
+		s.mock.ExpectQuery(query).
+			WithArgs(entity.ProductActive, from, to, from, to).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "status", "publish_at"}).AddRow(1, "LG TV", entity.ProductActive, to.Add(-time.Second)))
+
+		products, err := s.repo.FetchPublishChanges(context.Background(), from, to)
+		s.NoError(err)
+		s.Require().Len(products, 1)
+		s.Equal("LG TV", products[0].Name)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+
+		products, err := s.repo.FetchPublishChanges(context.Background(), from, to)
+		s.Error(err)
+		s.Nil(products)
+	})
+}
+
+func (s *PostgresSuite) TestFetchHistory() {
+
+	s.Run("Success", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "audit_events" WHERE entity_type = $1 AND entity_id = $2`)).
+			WithArgs("product", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "audit_events" WHERE entity_type = $1 AND entity_id = $2 ORDER BY id DESC LIMIT $3 OFFSET $4`)).
+			WithArgs("product", 1, 10, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "entity_type", "entity_id", "action", "actor", "before", "after"}).
+				AddRow(2, "product", 1, "update", "arya", []byte(`{"price":5000000}`), []byte(`{"price":4500000}`)).
+				AddRow(1, "product", 1, "create", "arya", nil, []byte(`{"name":"LG TV","price":5000000}`)))
+
+		events, total, err := s.repo.FetchHistory(context.Background(), 1, 2, 10)
+		s.NoError(err)
+		s.Equal(int64(12), total)
+		s.Len(events, 2)
+		s.Equal(entity.AuditValues{"price": float64(4500000)}, events[0].After)
+		s.Nil(events[1].Before)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "audit_events"`)).
+			WillReturnError(sql.ErrConnDone)
+
+		_, _, err := s.repo.FetchHistory(context.Background(), 1, 1, 10)
+		s.ErrorIs(err, sql.ErrConnDone)
+	})
+}
+
+func (s *PostgresSuite) TestFetchRelevance() {
+	score := `similarity(name, $1) + similarity(COALESCE(description, ''), $2) / 2 + ts_rank(to_tsvector($3::regconfig, name || ' ' || COALESCE(description, '')), plainto_tsquery($4::regconfig, $5))`
+	repo := NewProductRepository(s.gormDB, "indonesian")
+
+	s.Run("Ranked With Score", func() {
+		filter := request.ProductFilter{Search: "tv", Sort: "relevance", Page: 1, Limit: 10, SkipTotal: true}
+
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT *, (`+score+`) AS score FROM "products" WHERE (name ILIKE $6 OR description ILIKE $7) AND "products"."deleted_at" IS NULL ORDER BY score DESC,id DESC LIMIT $8`)).
+			WithArgs("tv", "tv", "indonesian", "indonesian", "tv", "%tv%", "%tv%", 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "score"}).AddRow(1, "LG TV", 0.75))
+
+		res, _, err := repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Require().Len(res, 1)
+		s.Equal(0.75, *res[0].Score)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Seek By Score", func() {
+		filter := request.ProductFilter{
+			Search:    "tv",
+			Sort:      "relevance",
+			Limit:     10,
+			SkipTotal: true,
+			Cursor:    request.EncodeProductCursor(request.ProductCursor{Sort: "-relevance,-id", Values: []string{"0.75", "1"}}),
+		}
+
+		seek := `((` + strings.NewReplacer("$1", "$8", "$2", "$9", "$3", "$10", "$4", "$11", "$5", "$12").Replace(score) + `), id) < ($13, $14)`
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT *, (`+score+`) AS score FROM "products" WHERE (name ILIKE $6 OR description ILIKE $7) AND `+seek+` AND "products"."deleted_at" IS NULL ORDER BY score DESC,id DESC LIMIT $15`)).
+			WithArgs("tv", "tv", "indonesian", "indonesian", "tv", "%tv%", "%tv%", "tv", "tv", "indonesian", "indonesian", "tv", 0.75, int64(1), 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "score"}))
+
+		res, _, err := repo.Fetch(context.Background(), filter)
+		s.NoError(err)
+		s.Empty(res)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Requires Search", func() {
+		filter := request.ProductFilter{Sort: "relevance", Page: 1, Limit: 10}
+
+		res, _, err := repo.Fetch(context.Background(), filter)
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(res)
+	})
+}
+
+func (s *PostgresSuite) TestSuggest() {
+	query := regexp.QuoteMeta(`SELECT name, MAX(similarity(name, $1)) AS score FROM "products" WHERE (status = $2 AND (publish_at IS NULL OR publish_at <= now()) AND (unpublish_at IS NULL OR unpublish_at > now())) AND name % $3 AND "products"."deleted_at" IS NULL GROUP BY "name" ORDER BY score DESC, name LIMIT $4`)
+
+	s.Run("Success", func() {
+		s.mock.ExpectQuery(query).
+			WithArgs("televsion", entity.ProductActive, "televsion", 5).
+			WillReturnRows(sqlmock.NewRows([]string{"name", "score"}).AddRow("LG Television", 0.6).AddRow("Sony Television", 0.55))
+
+		suggestions, err := s.repo.Suggest(context.Background(), "televsion", 5)
+		s.NoError(err)
+		s.Equal([]entity.ProductSuggestion{{Name: "LG Television", Score: 0.6}, {Name: "Sony Television", Score: 0.55}}, suggestions)
+	})
+
+	s.Run("Draft Not Suggested", func() {
+		// Only active products inside their publish window are read, so the
+		// name of a draft never reaches the result.
+		s.mock.ExpectQuery(query).
+			WithArgs("galaxy s30", entity.ProductActive, "galaxy s30", 5).
+			WillReturnRows(sqlmock.NewRows([]string{"name", "score"}))
+
+		suggestions, err := s.repo.Suggest(context.Background(), "galaxy s30", 5)
+		s.NoError(err)
+		s.Empty(suggestions)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+
+		suggestions, err := s.repo.Suggest(context.Background(), "televsion", 5)
+		s.Error(err)
+		s.Nil(suggestions)
+	})
+}
+
+func (s *PostgresSuite) TestBrandFacets() {
+	minPrice := int64(1000)
+	filter := request.ProductFilter{Page: 2, Limit: 10, MinPrice: &minPrice, BrandIDs: []int64{2}, Facets: []string{request.FacetBrand}}
+	query := regexp.QuoteMeta(`SELECT brands.id, brands.name, count(*) AS count FROM (SELECT "brand_id" FROM "products" WHERE base_price >= $1 AND "products"."deleted_at" IS NULL) AS p JOIN brands ON brands.id = p.brand_id GROUP BY brands.id, brands.name ORDER BY count DESC, brands.name`)
+
+	s.Run("Success Ignores Brand Filter", func() {
+		s.mock.ExpectQuery(query).
+			WithArgs(minPrice).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "count"}).AddRow(2, "Samsung", 12).AddRow(1, "Apple", 7))
+
+		counts, err := s.repo.BrandFacets(context.Background(), filter)
+		s.NoError(err)
+		s.Equal([]entity.FacetCount{{ID: 2, Name: "Samsung", Count: 12}, {ID: 1, Name: "Apple", Count: 7}}, counts)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+
+		counts, err := s.repo.BrandFacets(context.Background(), filter)
+		s.Error(err)
+		s.Nil(counts)
+	})
+}
+
+func (s *PostgresSuite) TestCategoryFacets() {
+	category := int64(1)
+
+	s.Run("Subcategories Of Selected Category", func() {
+		filter := request.ProductFilter{Category: &category, Facets: []string{request.FacetCategory}}
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT categories.id, categories.name, count(DISTINCT p.id) AS count FROM (SELECT "id" FROM "products" WHERE id IN (SELECT product_categories.product_id FROM product_categories
+			JOIN categories ON categories.id = product_categories.category_id
+			WHERE categories.path LIKE (SELECT path FROM categories WHERE id = $1) || '%') AND "products"."deleted_at" IS NULL) AS p JOIN product_categories ON product_categories.product_id = p.id JOIN categories AS linked ON linked.id = product_categories.category_id JOIN categories ON linked.path LIKE categories.path || '%' WHERE categories.parent_id = $2 GROUP BY categories.id, categories.name ORDER BY count DESC, categories.name`)).
+			WithArgs(category, category).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "count"}).AddRow(4, "TV", 9).AddRow(5, "Audio", 2))
+
+		counts, err := s.repo.CategoryFacets(context.Background(), filter)
+		s.NoError(err)
+		s.Equal([]entity.FacetCount{{ID: 4, Name: "TV", Count: 9}, {ID: 5, Name: "Audio", Count: 2}}, counts)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Root Categories", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(`FROM (SELECT "id" FROM "products" WHERE "products"."deleted_at" IS NULL) AS p JOIN product_categories ON product_categories.product_id = p.id JOIN categories AS linked ON linked.id = product_categories.category_id JOIN categories ON linked.path LIKE categories.path || '%' WHERE categories.parent_id IS NULL GROUP BY`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "count"}).AddRow(1, "Electronics", 11))
+
+		counts, err := s.repo.CategoryFacets(context.Background(), request.ProductFilter{})
+		s.NoError(err)
+		s.Equal([]entity.FacetCount{{ID: 1, Name: "Electronics", Count: 11}}, counts)
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT categories.id`)).WillReturnError(sql.ErrConnDone)
+
+		counts, err := s.repo.CategoryFacets(context.Background(), request.ProductFilter{})
+		s.Error(err)
+		s.Nil(counts)
+	})
+}
+
+func (s *PostgresSuite) TestPriceFacets() {
+	minPrice, maxPrice := int64(1000), int64(5000)
+	inStock := true
+	filter := request.ProductFilter{MinPrice: &minPrice, MaxPrice: &maxPrice, InStock: &inStock, Facets: []string{request.FacetPrice}}
+	query := regexp.QuoteMeta(`SELECT width_bucket(p.base_price, $1::bigint[]) AS bucket, count(*) AS count FROM (SELECT "base_price" FROM "products" WHERE quantity > 0 AND "products"."deleted_at" IS NULL) AS p WHERE p.base_price IS NOT NULL GROUP BY "bucket"`)
+
+	s.Run("Success Ignores Price Filter", func() {
+		s.mock.ExpectQuery(query).
+			WithArgs("{100,500}").
+			WillReturnRows(sqlmock.NewRows([]string{"bucket", "count"}).AddRow(0, 3).AddRow(2, 8))
+
+		buckets, err := s.repo.PriceFacets(context.Background(), filter, []int64{100, 500})
+		s.NoError(err)
+
+		hundred, fiveHundred := int64(100), int64(500)
+		s.Equal([]entity.PriceBucket{
+			{Min: 0, Max: &hundred, Count: 3},
+			{Min: 100, Max: &fiveHundred, Count: 0},
+			{Min: 500, Count: 8},
+		}, buckets)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+
+		buckets, err := s.repo.PriceFacets(context.Background(), filter, []int64{100, 500})
+		s.Error(err)
+		s.Nil(buckets)
+	})
+}
+
+func (s *PostgresSuite) TestStockFacets() {
+	inStock := false
+	filter := request.ProductFilter{InStock: &inStock, BrandIDs: []int64{2}, Facets: []string{request.FacetInStock}}
+	query := regexp.QuoteMeta(`SELECT count(*) FILTER (WHERE p.quantity > 0) AS in_stock, count(*) FILTER (WHERE COALESCE(p.quantity, 0) <= 0) AS out_of_stock FROM (SELECT "quantity" FROM "products" WHERE brand_id IN ($1) AND "products"."deleted_at" IS NULL) AS p`)
+
+	s.Run("Success Ignores Stock Filter", func() {
+		s.mock.ExpectQuery(query).
+			WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"in_stock", "out_of_stock"}).AddRow(6, 2))
+
+		counts, err := s.repo.StockFacets(context.Background(), filter)
+		s.NoError(err)
+		s.Equal(&entity.StockCount{InStock: 6, OutOfStock: 2}, counts)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+
+		counts, err := s.repo.StockFacets(context.Background(), filter)
+		s.Error(err)
+		s.Nil(counts)
+	})
+}
+
 func TestPostgresSuite(t *testing.T) {
 	suite.Run(t, new(PostgresSuite))
 }
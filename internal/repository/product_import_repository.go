@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+
+	"gorm.io/gorm"
+)
+
+type productImportRepository struct {
+	db *gorm.DB
+}
+
+func NewProductImportRepository(db *gorm.DB) interfaces.ProductImportRepository {
+	return &productImportRepository{
+		db: db,
+	}
+}
+
+func (r *productImportRepository) Create(ctx context.Context, job *entity.ProductImport) error {
+	return r.db.WithContext(ctx).Create(job).Error
+}
+
+// GetByID loads a job without its uploaded file.
+func (r *productImportRepository) GetByID(ctx context.Context, id int64) (*entity.ProductImport, error) {
+	var job entity.ProductImport
+	err := r.db.WithContext(ctx).Omit("payload").First(&job, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+// ClaimPending moves the oldest pending job to processing and returns it with
+// its file. SKIP LOCKED lets several workers claim jobs without waiting on
+// each other. Returns ErrNotFound when no job is pending.
+func (r *productImportRepository) ClaimPending(ctx context.Context) (*entity.ProductImport, error) {
+	var job entity.ProductImport
+	res := r.db.WithContext(ctx).Raw(`UPDATE product_imports SET status = ?, started_at = ?, updated_at = ?
+		WHERE id = (SELECT id FROM product_imports WHERE status = ? ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED)
+		RETURNING *`, entity.ImportStatusProcessing, time.Now(), time.Now(), entity.ImportStatusPending).
+		Scan(&job)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, constant.ErrNotFound
+	}
+	return &job, nil
+}
+
+// FailStale fails the jobs still processing whose progress was last saved
+// before staleBefore, left behind by a worker that stopped in the middle of
+// them. The rows created so far are kept and the file is dropped, as for any
+// finished job.
+func (r *productImportRepository) FailStale(ctx context.Context, staleBefore time.Time, message string) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).Model(&entity.ProductImport{}).
+		Where("status = ? AND updated_at < ?", entity.ImportStatusProcessing, staleBefore).
+		Updates(map[string]interface{}{
+			"status":      entity.ImportStatusFailed,
+			"message":     message,
+			"payload":     nil,
+			"updated_at":  now,
+			"finished_at": now,
+		}).Error
+}
+
+// SaveProgress stores the counters, row errors and status of a job. The file
+// is dropped once the job has finished. Only a job still processing is
+// written, so a job FailStale closed in the meantime stays failed; that
+// returns ErrConflict.
+func (r *productImportRepository) SaveProgress(ctx context.Context, job *entity.ProductImport) error {
+	job.UpdatedAt = time.Now()
+
+	columns := []string{"status", "total_rows", "processed_rows", "created_rows", "failed_rows", "message", "errors", "updated_at", "finished_at"}
+	if job.FinishedAt != nil {
+		job.Payload = nil
+		columns = append(columns, "payload")
+	}
+
+	res := r.db.WithContext(ctx).Model(job).
+		Where("status = ?", entity.ImportStatusProcessing).
+		Select(columns).
+		Updates(job)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: import %d is no longer processing", constant.ErrConflict, job.ID)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type ProductImportSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo interfaces.ProductImportRepository
+	db   *sql.DB
+}
+
+func (s *ProductImportSuite) SetupTest() {
+	var err error
+
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       s.db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = NewProductImportRepository(gormDB)
+}
+
+func (s *ProductImportSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *ProductImportSuite) TestCreate() {
+	job := &entity.ProductImport{FileName: "catalog.csv", Format: "csv", Status: entity.ImportStatusPending, Payload: []byte("name")}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_imports"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	s.mock.ExpectCommit()
+
+	err := s.repo.Create(context.Background(), job)
+	s.NoError(err)
+	s.Equal(int64(1), job.ID)
+}
+
+func (s *ProductImportSuite) TestGetByID() {
+	query := `SELECT "product_imports"."id","product_imports"."file_name","product_imports"."format","product_imports"."status","product_imports"."total_rows","product_imports"."processed_rows","product_imports"."created_rows","product_imports"."failed_rows","product_imports"."message","product_imports"."errors","product_imports"."created_by","product_imports"."created_at","product_imports"."updated_at","product_imports"."started_at","product_imports"."finished_at" FROM "product_imports" WHERE "product_imports"."id" = $1 ORDER BY "product_imports"."id" LIMIT $2`
+
+	s.Run("Found", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "status", "failed_rows", "errors"}).
+				AddRow(1, entity.ImportStatusCompleted, 1, `[{"row":3,"errors":["price is required"]}]`))
+
+		res, err := s.repo.GetByID(context.Background(), 1)
+		s.NoError(err)
+		s.Equal(entity.ImportStatusCompleted, res.Status)
+		s.Equal(entity.ImportRowErrors{{Row: 3, Errors: []string{"price is required"}}}, res.Errors)
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(9, 1).
+			WillReturnError(gorm.ErrRecordNotFound)
+
+		res, err := s.repo.GetByID(context.Background(), 9)
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(res)
+	})
+}
+
+func (s *ProductImportSuite) TestClaimPending() {
+	query := `UPDATE product_imports SET status = $1, started_at = $2, updated_at = $3
+		WHERE id = (SELECT id FROM product_imports WHERE status = $4 ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED)
+		RETURNING *`
+
+	s.Run("Claimed", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(entity.ImportStatusProcessing, sqlmock.AnyArg(), sqlmock.AnyArg(), entity.ImportStatusPending).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "format", "status", "payload"}).
+				AddRow(2, "csv", entity.ImportStatusProcessing, []byte("name,price")))
+
+		job, err := s.repo.ClaimPending(context.Background())
+		s.NoError(err)
+		s.Equal(int64(2), job.ID)
+		s.Equal([]byte("name,price"), job.Payload)
+	})
+
+	s.Run("Nothing Pending", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		job, err := s.repo.ClaimPending(context.Background())
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(job)
+	})
+
+	s.Run("Error", func() {
+		s.mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WillReturnError(errors.New("db error"))
+
+		job, err := s.repo.ClaimPending(context.Background())
+		s.Error(err)
+		s.Nil(job)
+	})
+}
+
+func (s *ProductImportSuite) TestFailStale() {
+	staleBefore := time.Now().Add(-15 * time.Minute)
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_imports" SET "finished_at"=$1,"message"=$2,"payload"=$3,"status"=$4,"updated_at"=$5 WHERE status = $6 AND updated_at < $7`)).
+		WithArgs(sqlmock.AnyArg(), "import was interrupted", nil, entity.ImportStatusFailed, sqlmock.AnyArg(), entity.ImportStatusProcessing, staleBefore).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	err := s.repo.FailStale(context.Background(), staleBefore, "import was interrupted")
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *ProductImportSuite) TestSaveProgress() {
+	s.Run("In Progress Keeps File", func() {
+		job := &entity.ProductImport{ID: 1, Status: entity.ImportStatusProcessing, TotalRows: 10, ProcessedRows: 5, Payload: []byte("name")}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_imports" SET "status"=$1,"total_rows"=$2,"processed_rows"=$3,"created_rows"=$4,"failed_rows"=$5,"message"=$6,"errors"=$7,"updated_at"=$8,"finished_at"=$9 WHERE status = $10 AND "id" = $11`)).
+			WithArgs(entity.ImportStatusProcessing, 10, 5, 0, 0, "", "[]", sqlmock.AnyArg(), nil, entity.ImportStatusProcessing, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.SaveProgress(context.Background(), job)
+		s.NoError(err)
+		s.NotNil(job.Payload)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Finished Drops File", func() {
+		finished := time.Now()
+		job := &entity.ProductImport{
+			ID: 1, Status: entity.ImportStatusCompleted, TotalRows: 10, ProcessedRows: 10, CreatedRows: 9, FailedRows: 1,
+			Errors:     entity.ImportRowErrors{{Row: 4, Errors: []string{"name is required"}}},
+			Payload:    []byte("name"),
+			FinishedAt: &finished,
+		}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_imports" SET "status"=$1,"total_rows"=$2,"processed_rows"=$3,"created_rows"=$4,"failed_rows"=$5,"message"=$6,"errors"=$7,"payload"=$8,"updated_at"=$9,"finished_at"=$10 WHERE status = $11 AND "id" = $12`)).
+			WithArgs(entity.ImportStatusCompleted, 10, 10, 9, 1, "", `[{"row":4,"errors":["name is required"]}]`, []byte(nil), sqlmock.AnyArg(), finished, entity.ImportStatusProcessing, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.SaveProgress(context.Background(), job)
+		s.NoError(err)
+		s.Nil(job.Payload)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Failed Meanwhile", func() {
+		job := &entity.ProductImport{ID: 1, Status: entity.ImportStatusProcessing, TotalRows: 10, ProcessedRows: 5}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_imports" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		s.mock.ExpectCommit()
+
+		err := s.repo.SaveProgress(context.Background(), job)
+		s.ErrorIs(err, constant.ErrConflict)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+}
+
+func TestProductImportSuite(t *testing.T) {
+	suite.Run(t, new(ProductImportSuite))
+}
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type productMediaRepository struct {
+	db *gorm.DB
+}
+
+func NewProductMediaRepository(db *gorm.DB) interfaces.ProductMediaRepository {
+	return &productMediaRepository{
+		db: db,
+	}
+}
+
+// Create adds an image after the other images of its product. The first image
+// of a product is its primary image, and so is an image created as primary.
+// Like variant writes, every media write bumps the version of the product,
+// whose detail embeds the images, and locks the product row, so concurrent
+// writes agree on the positions.
+func (r *productMediaRepository) Create(ctx context.Context, media *entity.ProductMedia) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpProductVersion(tx, media.ProductID); err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&entity.ProductMedia{}).Where("product_id = ?", media.ProductID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count >= entity.MaxProductMedia {
+			return fmt.Errorf("%w: a product has at most %d images", constant.ErrValidation, entity.MaxProductMedia)
+		}
+
+		if media.IsPrimary && count > 0 {
+			if err := clearPrimaryMedia(tx, media.ProductID); err != nil {
+				return err
+			}
+		}
+		media.Position = int(count) + 1
+		media.IsPrimary = media.IsPrimary || count == 0
+		return tx.Create(media).Error
+	})
+}
+
+// FetchByProduct returns the images of a product in their order.
+func (r *productMediaRepository) FetchByProduct(ctx context.Context, productID int64) ([]entity.ProductMedia, error) {
+	var media []entity.ProductMedia
+	err := r.db.WithContext(ctx).Where("product_id = ?", productID).Scopes(orderMedia).Find(&media).Error
+	return media, err
+}
+
+// Reorder gives the images of a product the positions of ids, which has to
+// list every image of the product once.
+func (r *productMediaRepository) Reorder(ctx context.Context, productID int64, ids []int64, updatedBy string, at time.Time) ([]entity.ProductMedia, error) {
+	var media []entity.ProductMedia
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpProductVersion(tx, productID); err != nil {
+			return err
+		}
+
+		var current []int64
+		if err := tx.Model(&entity.ProductMedia{}).Where("product_id = ?", productID).Pluck("id", &current).Error; err != nil {
+			return err
+		}
+		if !sameMedia(current, ids) {
+			return fmt.Errorf("%w: media_ids must list each of the %d images of the product once", constant.ErrValidation, len(current))
+		}
+
+		for i, id := range ids {
+			err := tx.Model(&entity.ProductMedia{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+				"position":   i + 1,
+				"updated_at": at,
+				"updated_by": updatedBy,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Where("product_id = ?", productID).Scopes(orderMedia).Find(&media).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+// SetPrimary makes an image the primary image of its product in place of the
+// current one.
+func (r *productMediaRepository) SetPrimary(ctx context.Context, productID int64, id int64, updatedBy string, at time.Time) (*entity.ProductMedia, error) {
+	var media entity.ProductMedia
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpProductVersion(tx, productID); err != nil {
+			return err
+		}
+		if err := clearPrimaryMedia(tx, productID); err != nil {
+			return err
+		}
+
+		result := tx.Model(&media).Clauses(clause.Returning{}).
+			Where("id = ? AND product_id = ?", id, productID).
+			UpdateColumns(map[string]interface{}{
+				"is_primary": true,
+				"updated_at": at,
+				"updated_by": updatedBy,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return constant.ErrNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &media, nil
+}
+
+// Delete removes an image and closes the gap it leaves in the positions. When
+// it was the primary image, the first image left takes over. Returns the
+// deleted image, whose files are still to be removed from the storage.
+func (r *productMediaRepository) Delete(ctx context.Context, productID int64, id int64) (*entity.ProductMedia, error) {
+	var media entity.ProductMedia
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpProductVersion(tx, productID); err != nil {
+			return err
+		}
+
+		result := tx.Clauses(clause.Returning{}).Where("id = ? AND product_id = ?", id, productID).Delete(&media)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return constant.ErrNotFound
+		}
+
+		err := tx.Model(&entity.ProductMedia{}).
+			Where("product_id = ? AND position > ?", productID, media.Position).
+			UpdateColumn("position", gorm.Expr("position - 1")).Error
+		if err != nil {
+			return err
+		}
+		if !media.IsPrimary {
+			return nil
+		}
+
+		return tx.Model(&entity.ProductMedia{}).
+			Where("product_id = ? AND position = 1", productID).
+			UpdateColumn("is_primary", true).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &media, nil
+}
+
+func orderMedia(db *gorm.DB) *gorm.DB {
+	return db.Order("product_media.position, product_media.id")
+}
+
+func clearPrimaryMedia(tx *gorm.DB, productID int64) error {
+	return tx.Model(&entity.ProductMedia{}).
+		Where("product_id = ? AND is_primary", productID).
+		UpdateColumn("is_primary", false).Error
+}
+
+// sameMedia tells whether ids lists each of the current ids exactly once.
+func sameMedia(current []int64, ids []int64) bool {
+	if len(current) != len(ids) {
+		return false
+	}
+
+	listed := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		listed[id] = true
+	}
+	for _, id := range current {
+		if !listed[id] {
+			return false
+		}
+	}
+	return len(listed) == len(current)
+}
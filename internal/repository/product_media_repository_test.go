@@ -0,0 +1,296 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type ProductMediaSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo interfaces.ProductMediaRepository
+	db   *sql.DB
+}
+
+func (s *ProductMediaSuite) SetupTest() {
+	var err error
+
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       s.db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = NewProductMediaRepository(gormDB)
+}
+
+func (s *ProductMediaSuite) TearDownTest() {
+	s.db.Close()
+}
+
+const (
+	countMediaQuery   = `SELECT count(*) FROM "product_media" WHERE product_id = $1`
+	clearPrimaryQuery = `UPDATE "product_media" SET "is_primary"=$1 WHERE product_id = $2 AND is_primary`
+	orderedMediaQuery = `SELECT * FROM "product_media" WHERE product_id = $1 ORDER BY product_media.position, product_media.id`
+)
+
+func (s *ProductMediaSuite) TestCreate() {
+	insertQuery := regexp.QuoteMeta(`INSERT INTO "product_media" ("product_id","storage_key","thumbnail_key","url","thumbnail_url","content_type","size","width","height","position","is_primary","created_at","created_by","updated_at","updated_by") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15) RETURNING "id"`)
+	newMedia := func(primary bool) *entity.ProductMedia {
+		return &entity.ProductMedia{
+			ProductID: 1, StorageKey: "products/1/a.jpg", ThumbnailKey: "products/1/a_thumb.jpg",
+			URL: "http://cdn/products/1/a.jpg", ThumbnailURL: "http://cdn/products/1/a_thumb.jpg",
+			ContentType: entity.MediaJPEG, Size: 2048, Width: 800, Height: 600, IsPrimary: primary, CreatedBy: "arya",
+		}
+	}
+
+	s.Run("First Image", func() {
+		media := newMedia(false)
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(countMediaQuery)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		s.mock.ExpectQuery(insertQuery).
+			WithArgs(1, "products/1/a.jpg", "products/1/a_thumb.jpg", "http://cdn/products/1/a.jpg", "http://cdn/products/1/a_thumb.jpg",
+				entity.MediaJPEG, 2048, 800, 600, 1, true, sqlmock.AnyArg(), "arya", sqlmock.AnyArg(), "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Create(context.Background(), media)
+		s.NoError(err)
+		s.Equal(int64(5), media.ID)
+		s.Equal(1, media.Position)
+		s.True(media.IsPrimary)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("New Primary", func() {
+		media := newMedia(true)
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(countMediaQuery)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		s.mock.ExpectExec(regexp.QuoteMeta(clearPrimaryQuery)).
+			WithArgs(false, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(insertQuery).
+			WithArgs(1, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 3, true, sqlmock.AnyArg(), "arya", sqlmock.AnyArg(), "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(6))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Create(context.Background(), media)
+		s.NoError(err)
+		s.Equal(3, media.Position)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Too Many Images", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(countMediaQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(entity.MaxProductMedia))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Create(context.Background(), newMedia(false))
+		s.ErrorIs(err, constant.ErrValidation)
+		s.EqualError(err, "validation error: a product has at most 50 images")
+	})
+
+	s.Run("Product Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Create(context.Background(), newMedia(false))
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *ProductMediaSuite) TestFetchByProduct() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(orderedMediaQuery)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "url", "position", "is_primary"}).
+			AddRow(5, 1, "http://cdn/products/1/a.jpg", 1, true).
+			AddRow(6, 1, "http://cdn/products/1/b.jpg", 2, false))
+
+	media, err := s.repo.FetchByProduct(context.Background(), 1)
+	s.NoError(err)
+	s.Len(media, 2)
+	s.Equal("http://cdn/products/1/a.jpg", media[0].URL)
+	s.True(media[0].IsPrimary)
+}
+
+func (s *ProductMediaSuite) TestReorder() {
+	idsQuery := regexp.QuoteMeta(`SELECT "id" FROM "product_media" WHERE product_id = $1`)
+	updateQuery := regexp.QuoteMeta(`UPDATE "product_media" SET "position"=$1,"updated_at"=$2,"updated_by"=$3 WHERE id = $4`)
+	at := time.Now()
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(idsQuery).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5).AddRow(6))
+		s.mock.ExpectExec(updateQuery).
+			WithArgs(1, at, "arya", 6).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(updateQuery).
+			WithArgs(2, at, "arya", 5).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(orderedMediaQuery)).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "position"}).AddRow(6, 1).AddRow(5, 2))
+		s.mock.ExpectCommit()
+
+		media, err := s.repo.Reorder(context.Background(), 1, []int64{6, 5}, "arya", at)
+		s.NoError(err)
+		s.Len(media, 2)
+		s.Equal(int64(6), media[0].ID)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Incomplete Order", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(idsQuery).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5).AddRow(6))
+		s.mock.ExpectRollback()
+
+		_, err := s.repo.Reorder(context.Background(), 1, []int64{6, 6}, "arya", at)
+		s.ErrorIs(err, constant.ErrValidation)
+		s.EqualError(err, "validation error: media_ids must list each of the 2 images of the product once")
+	})
+}
+
+func (s *ProductMediaSuite) TestSetPrimary() {
+	query := regexp.QuoteMeta(`UPDATE "product_media" SET "is_primary"=$1,"updated_at"=$2,"updated_by"=$3 WHERE id = $4 AND product_id = $5 RETURNING *`)
+	at := time.Now()
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(regexp.QuoteMeta(clearPrimaryQuery)).
+			WithArgs(false, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(query).
+			WithArgs(true, at, "arya", 6, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "position", "is_primary"}).AddRow(6, 1, 2, true))
+		s.mock.ExpectCommit()
+
+		media, err := s.repo.SetPrimary(context.Background(), 1, 6, "arya", at)
+		s.NoError(err)
+		s.Equal(int64(6), media.ID)
+		s.True(media.IsPrimary)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(regexp.QuoteMeta(clearPrimaryQuery)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(query).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+
+		_, err := s.repo.SetPrimary(context.Background(), 1, 9, "arya", at)
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *ProductMediaSuite) TestDelete() {
+	query := regexp.QuoteMeta(`DELETE FROM "product_media" WHERE id = $1 AND product_id = $2 RETURNING *`)
+	shiftQuery := regexp.QuoteMeta(`UPDATE "product_media" SET "position"=position - 1 WHERE product_id = $1 AND position > $2`)
+	promoteQuery := regexp.QuoteMeta(`UPDATE "product_media" SET "is_primary"=$1 WHERE product_id = $2 AND position = 1`)
+	columns := []string{"id", "product_id", "storage_key", "thumbnail_key", "position", "is_primary"}
+
+	s.Run("Primary Image", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(query).
+			WithArgs(5, 1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(5, 1, "products/1/a.jpg", "products/1/a_thumb.jpg", 1, true))
+		s.mock.ExpectExec(shiftQuery).
+			WithArgs(1, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(promoteQuery).
+			WithArgs(true, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		media, err := s.repo.Delete(context.Background(), 1, 5)
+		s.NoError(err)
+		s.Equal("products/1/a.jpg", media.StorageKey)
+		s.Equal("products/1/a_thumb.jpg", media.ThumbnailKey)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Other Image", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(query).
+			WithArgs(6, 1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(6, 1, "products/1/b.jpg", "products/1/b_thumb.jpg", 2, false))
+		s.mock.ExpectExec(shiftQuery).
+			WithArgs(1, 2).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		s.mock.ExpectCommit()
+
+		_, err := s.repo.Delete(context.Background(), 1, 6)
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(query).
+			WithArgs(9, 1).
+			WillReturnRows(sqlmock.NewRows(columns))
+		s.mock.ExpectRollback()
+
+		_, err := s.repo.Delete(context.Background(), 1, 9)
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func TestProductMediaSuite(t *testing.T) {
+	suite.Run(t, new(ProductMediaSuite))
+}
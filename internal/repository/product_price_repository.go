@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type productPriceRepository struct {
+	db *gorm.DB
+}
+
+func NewProductPriceRepository(db *gorm.DB) interfaces.ProductPriceRepository {
+	return &productPriceRepository{
+		db: db,
+	}
+}
+
+// Schedule stores a price change of an existing product for later, in the
+// currency of the product unless the price names one.
+func (r *productPriceRepository) Schedule(ctx context.Context, price *entity.ProductPrice) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var product entity.Product
+		err := tx.Select("id", "currency").First(&product, price.ProductID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constant.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if price.Currency == "" {
+			price.Currency = product.Currency
+		}
+		return constraintViolation(tx.Create(price).Error)
+	})
+}
+
+// FetchByProduct returns a page of the price history of a product, latest
+// first so the scheduled prices lead, with the number of prices in total.
+func (r *productPriceRepository) FetchByProduct(ctx context.Context, productID int64, page int, limit int) ([]entity.ProductPrice, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entity.ProductPrice{}).Where("product_id = ?", productID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var prices []entity.ProductPrice
+	err := query.Order("valid_from DESC, id DESC").Offset((page - 1) * limit).Limit(limit).Find(&prices).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range prices {
+		prices[i].Status = prices[i].PriceStatus()
+	}
+	return prices, total, nil
+}
+
+// ApplyNext claims the scheduled price that came due first and makes it the
+// price of its product. Prices claimed by another scheduler are skipped, and
+// so are the prices of deleted products, which stay due until the product is
+// restored. A price that cannot be applied is marked failed with the reason
+// and returned with that status, so the prices due after it still apply.
+// Returns ErrNotFound when no scheduled price is due.
+func (r *productPriceRepository) ApplyNext(ctx context.Context, now time.Time) (*entity.ProductPrice, error) {
+	var price entity.ProductPrice
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("applied_at IS NULL AND failed_at IS NULL AND valid_from <= ?", now).
+			Where("product_id IN (?)", tx.Model(&entity.Product{}).Select("id")).
+			Order("valid_from, id").
+			Limit(1).
+			Find(&price)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return constant.ErrNotFound
+		}
+
+		if err := tx.SavePoint("apply_price").Error; err != nil {
+			return err
+		}
+		applyErr := applyPrice(ctx, tx, &price, now)
+		if applyErr == nil {
+			return nil
+		}
+		if err := tx.RollbackTo("apply_price").Error; err != nil {
+			return err
+		}
+
+		price.FailedAt = &now
+		price.Failure = applyErr.Error()
+		price.Status = entity.PriceFailed
+		return tx.Model(&price).UpdateColumns(map[string]interface{}{
+			"failed_at": now,
+			"failure":   price.Failure,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &price, nil
+}
+
+// applyPrice makes a due price the price of its product and closes the price
+// it replaces. The product change is audited with the scheduler of the price
+// as the actor.
+func applyPrice(ctx context.Context, tx *gorm.DB, price *entity.ProductPrice, now time.Time) error {
+	before, err := lockProduct(tx, price.ProductID)
+	if errors.Is(err, errNothingUpdated) {
+		return fmt.Errorf("%w: product %d was deleted", constant.ErrNotFound, price.ProductID)
+	}
+	if err != nil {
+		return err
+	}
+
+	var product entity.Product
+	err = tx.Model(&product).
+		Clauses(clause.Returning{}).
+		Where("id = ?", price.ProductID).
+		UpdateColumns(map[string]interface{}{
+			"price":      price.Price,
+			"currency":   price.Currency,
+			"updated_at": now,
+			"updated_by": price.CreatedBy,
+			"version":    gorm.Expr("version + 1"),
+		}).Error
+	if err != nil {
+		return constraintViolation(err)
+	}
+
+	err = tx.Model(&entity.ProductPrice{}).
+		Where("product_id = ? AND applied_at IS NOT NULL AND valid_to IS NULL", price.ProductID).
+		UpdateColumn("valid_to", price.ValidFrom).Error
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Model(price).UpdateColumn("applied_at", now).Error; err != nil {
+		return err
+	}
+	err = recordAudit(tx, []entity.AuditEvent{productAuditEvent(ctx, entity.AuditUpdate, price.CreatedBy, before, &product)})
+	if err != nil {
+		return err
+	}
+
+	price.AppliedAt = &now
+	price.Status = entity.PriceCurrent
+	return nil
+}
+
+// recordPrice closes the current price of an updated product and opens its
+// new one, unless neither the price nor the currency changed. It runs in the
+// transaction that writes products.price, under the lock of the product row.
+func recordPrice(tx *gorm.DB, product *entity.Product) error {
+	at := product.UpdatedAt
+
+	var current entity.ProductPrice
+	result := tx.Where("product_id = ? AND applied_at IS NOT NULL AND valid_to IS NULL", product.ID).Limit(1).Find(&current)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		if product.Price != nil && current.Price == *product.Price && current.Currency == product.Currency {
+			return nil
+		}
+		if err := tx.Model(&current).UpdateColumn("valid_to", at).Error; err != nil {
+			return err
+		}
+	}
+	if product.Price == nil {
+		return nil
+	}
+
+	return tx.Create(&entity.ProductPrice{
+		ProductID: product.ID,
+		Price:     *product.Price,
+		Currency:  product.Currency,
+		ValidFrom: at,
+		AppliedAt: &at,
+		CreatedAt: at,
+		CreatedBy: product.UpdatedBy,
+	}).Error
+}
+
+// openingPrices opens the price history of new products with their price.
+func openingPrices(tx *gorm.DB, products []*entity.Product) error {
+	var prices []entity.ProductPrice
+	for _, product := range products {
+		if product.Price == nil {
+			continue
+		}
+		createdAt := product.CreatedAt
+		prices = append(prices, entity.ProductPrice{
+			ProductID: product.ID,
+			Price:     *product.Price,
+			Currency:  product.Currency,
+			ValidFrom: createdAt,
+			AppliedAt: &createdAt,
+			CreatedAt: createdAt,
+			CreatedBy: product.CreatedBy,
+		})
+	}
+	if len(prices) == 0 {
+		return nil
+	}
+	return tx.Create(&prices).Error
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type ProductPriceSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo interfaces.ProductPriceRepository
+	db   *sql.DB
+}
+
+func (s *ProductPriceSuite) SetupTest() {
+	var err error
+
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       s.db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = NewProductPriceRepository(gormDB)
+}
+
+func (s *ProductPriceSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *ProductPriceSuite) TestSchedule() {
+	validFrom := time.Now().Add(24 * time.Hour)
+	productQuery := regexp.QuoteMeta(`SELECT "id","currency" FROM "products" WHERE "products"."id" = $1 AND "products"."deleted_at" IS NULL ORDER BY "products"."id" LIMIT $2`)
+
+	s.Run("Success", func() {
+		price := &entity.ProductPrice{ProductID: 1, Price: 4500000, ValidFrom: validFrom, CreatedBy: "arya"}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(productQuery).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "currency"}).AddRow(1, "IDR"))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_prices" ("product_id","price","currency","valid_from","valid_to","applied_at","created_at","created_by") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+			WithArgs(1, 4500000, "IDR", validFrom, nil, nil, sqlmock.AnyArg(), "arya").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(9))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Schedule(context.Background(), price)
+		s.NoError(err)
+		s.Equal(int64(9), price.ID)
+		s.Equal("IDR", price.Currency)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Product Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(productQuery).
+			WithArgs(999, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Schedule(context.Background(), &entity.ProductPrice{ProductID: 999, Price: 1000, ValidFrom: validFrom})
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+
+	s.Run("Unknown Currency", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(productQuery).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "currency"}).AddRow(1, "IDR"))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_prices"`)).
+			WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "fk_product_prices_currency"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Schedule(context.Background(), &entity.ProductPrice{ProductID: 1, Price: 300, Currency: "XAU", ValidFrom: validFrom})
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+}
+
+func (s *ProductPriceSuite) TestFetchByProduct() {
+	appliedAt := time.Now().Add(-time.Hour)
+	validTo := time.Now()
+
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "product_prices" WHERE product_id = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_prices" WHERE product_id = $1 ORDER BY valid_from DESC, id DESC LIMIT $2 OFFSET $3`)).
+		WithArgs(1, 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "price", "valid_to", "applied_at"}).
+			AddRow(3, 1, 4000000, nil, nil).
+			AddRow(2, 1, 4500000, nil, appliedAt).
+			AddRow(1, 1, 5000000, validTo, appliedAt))
+
+	res, total, err := s.repo.FetchByProduct(context.Background(), 1, 2, 10)
+	s.NoError(err)
+	s.Equal(int64(3), total)
+	s.Equal([]string{entity.PriceScheduled, entity.PriceCurrent, entity.PricePast}, []string{res[0].Status, res[1].Status, res[2].Status})
+}
+
+func (s *ProductPriceSuite) TestApplyNext() {
+	now := time.Now()
+	validFrom := now.Add(-time.Minute)
+	query := regexp.QuoteMeta(`SELECT * FROM "product_prices" WHERE (applied_at IS NULL AND failed_at IS NULL AND valid_from <= $1) AND product_id IN (SELECT "id" FROM "products" WHERE "products"."deleted_at" IS NULL) ORDER BY valid_from, id LIMIT $2 FOR UPDATE SKIP LOCKED`)
+	updateProduct := regexp.QuoteMeta(`UPDATE "products" SET "currency"=$1,"price"=$2,"updated_at"=$3,"updated_by"=$4,"version"=version + 1 WHERE id = $5 AND "products"."deleted_at" IS NULL RETURNING *`)
+	markFailed := regexp.QuoteMeta(`UPDATE "product_prices" SET "failed_at"=$1,"failure"=$2 WHERE "id" = $3`)
+	dueRow := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "product_id", "price", "currency", "valid_from", "created_by"}).AddRow(9, 1, 4500000, "USD", validFrom, "arya")
+	}
+
+	s.Run("Due", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(query).
+			WithArgs(now, 1).
+			WillReturnRows(dueRow())
+		s.mock.ExpectExec("SAVEPOINT apply_price").WillReturnResult(sqlmock.NewResult(0, 0))
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "price", "currency", "version"}).AddRow(1, 70000000, "IDR", 3))
+		s.mock.ExpectQuery(updateProduct).
+			WithArgs("USD", 4500000, now, "arya", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "price", "currency", "updated_by", "version"}).AddRow(1, 4500000, "USD", "arya", 4))
+		s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_prices" SET "valid_to"=$1 WHERE product_id = $2 AND applied_at IS NOT NULL AND valid_to IS NULL`)).
+			WithArgs(validFrom, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_prices" SET "applied_at"=$1 WHERE "id" = $2`)).
+			WithArgs(now, 9).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+			WithArgs("product", 1, "update", "arya", "", `{"currency":"IDR","price":70000000}`, `{"currency":"USD","price":4500000}`, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		res, err := s.repo.ApplyNext(context.Background(), now)
+		s.NoError(err)
+		s.Equal(int64(1), res.ProductID)
+		s.Equal(entity.PriceCurrent, res.Status)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Product Deleted Meanwhile", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(query).
+			WithArgs(now, 1).
+			WillReturnRows(dueRow())
+		s.mock.ExpectExec("SAVEPOINT apply_price").WillReturnResult(sqlmock.NewResult(0, 0))
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectExec("ROLLBACK TO SAVEPOINT apply_price").WillReturnResult(sqlmock.NewResult(0, 0))
+		s.mock.ExpectExec(markFailed).
+			WithArgs(now, "record not found: product 1 was deleted", 9).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		res, err := s.repo.ApplyNext(context.Background(), now)
+		s.NoError(err)
+		s.Equal(entity.PriceFailed, res.Status)
+		s.Nil(res.AppliedAt)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Failed Price Does Not Block Later Ones", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(query).
+			WithArgs(now, 1).
+			WillReturnRows(dueRow())
+		s.mock.ExpectExec("SAVEPOINT apply_price").WillReturnResult(sqlmock.NewResult(0, 0))
+		s.mock.ExpectQuery(regexp.QuoteMeta(lockProductSQL)).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "price", "currency", "version"}).AddRow(1, 70000000, "IDR", 3))
+		s.mock.ExpectQuery(updateProduct).
+			WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "fk_products_currency"})
+		s.mock.ExpectExec("ROLLBACK TO SAVEPOINT apply_price").WillReturnResult(sqlmock.NewResult(0, 0))
+		s.mock.ExpectExec(markFailed).
+			WithArgs(now, sqlmock.AnyArg(), 9).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		res, err := s.repo.ApplyNext(context.Background(), now)
+		s.NoError(err)
+		s.Equal(entity.PriceFailed, res.Status)
+		s.NotEmpty(res.Failure)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Nothing Due", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(query).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+
+		res, err := s.repo.ApplyNext(context.Background(), now)
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(res)
+	})
+}
+
+func TestProductPriceSuite(t *testing.T) {
+	suite.Run(t, new(ProductPriceSuite))
+}
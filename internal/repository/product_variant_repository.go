@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type productVariantRepository struct {
+	db *gorm.DB
+}
+
+func NewProductVariantRepository(db *gorm.DB) interfaces.ProductVariantRepository {
+	return &productVariantRepository{
+		db: db,
+	}
+}
+
+// Create adds a variant to a product. Every variant write bumps the version
+// of the product, which changes the ETag of the product detail the variants
+// are part of and locks the product row so that concurrent writes agree on
+// the option axes.
+func (r *productVariantRepository) Create(ctx context.Context, variant *entity.ProductVariant) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpProductVersion(tx, variant.ProductID); err != nil {
+			return err
+		}
+		if err := checkVariantAxes(tx, variant); err != nil {
+			return err
+		}
+		return constraintViolation(tx.Create(variant).Error)
+	})
+}
+
+// FetchByProduct returns the variants of a product in creation order.
+func (r *productVariantRepository) FetchByProduct(ctx context.Context, productID int64) ([]entity.ProductVariant, error) {
+	var variants []entity.ProductVariant
+	err := r.db.WithContext(ctx).Where("product_id = ?", productID).Order("id").Find(&variants).Error
+	return variants, err
+}
+
+// Update replaces the editable fields of a variant and reads back the stored
+// row.
+func (r *productVariantRepository) Update(ctx context.Context, variant *entity.ProductVariant) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpProductVersion(tx, variant.ProductID); err != nil {
+			return err
+		}
+		if err := checkVariantAxes(tx, variant); err != nil {
+			return err
+		}
+
+		result := tx.Model(variant).Clauses(clause.Returning{}).Where("product_id = ?", variant.ProductID).Updates(map[string]interface{}{
+			"sku":        variant.SKU,
+			"options":    variant.Options,
+			"price":      variant.Price,
+			"quantity":   variant.Quantity,
+			"updated_at": variant.UpdatedAt,
+			"updated_by": variant.UpdatedBy,
+		})
+		if result.Error != nil {
+			return constraintViolation(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return constant.ErrNotFound
+		}
+		return nil
+	})
+}
+
+func (r *productVariantRepository) Delete(ctx context.Context, productID int64, id int64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpProductVersion(tx, productID); err != nil {
+			return err
+		}
+
+		result := tx.Where("product_id = ?", productID).Delete(&entity.ProductVariant{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return constant.ErrNotFound
+		}
+		return nil
+	})
+}
+
+// bumpProductVersion increments the version of a product that is not deleted.
+func bumpProductVersion(tx *gorm.DB, productID int64) error {
+	result := tx.Model(&entity.Product{}).Where("id = ?", productID).UpdateColumn("version", gorm.Expr("version + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return constant.ErrNotFound
+	}
+	return nil
+}
+
+// checkVariantAxes rejects options that do not name the same axes as the
+// other variants of the product.
+func checkVariantAxes(tx *gorm.DB, variant *entity.ProductVariant) error {
+	var other entity.ProductVariant
+	err := tx.Where("product_id = ? AND id <> ?", variant.ProductID, variant.ID).Take(&other).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	axes := other.Options.Axes()
+	if !slices.Equal(axes, variant.Options.Axes()) {
+		return fmt.Errorf("%w: options must name the axes %s like the other variants", constant.ErrValidation, strings.Join(axes, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type ProductVariantSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo interfaces.ProductVariantRepository
+	db   *sql.DB
+}
+
+func (s *ProductVariantSuite) SetupTest() {
+	var err error
+
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       s.db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = NewProductVariantRepository(gormDB)
+}
+
+func (s *ProductVariantSuite) TearDownTest() {
+	s.db.Close()
+}
+
+const (
+	bumpVersionQuery  = `UPDATE "products" SET "version"=version + 1 WHERE id = $1 AND "products"."deleted_at" IS NULL`
+	otherVariantQuery = `SELECT * FROM "product_variants" WHERE product_id = $1 AND id <> $2 LIMIT $3`
+)
+
+func (s *ProductVariantSuite) TestCreate() {
+	price := int64(20000000)
+	qty := 5
+	newVariant := func(options entity.VariantOptions) *entity.ProductVariant {
+		return &entity.ProductVariant{ProductID: 1, Options: options, Price: &price, Quantity: &qty, CreatedBy: "arya"}
+	}
+
+	s.Run("First Variant", func() {
+		variant := newVariant(entity.VariantOptions{"color": "Black", "storage": "256GB"})
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(otherVariantQuery)).
+			WithArgs(1, 0, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_variants" ("product_id","sku","options","price","quantity","created_at","created_by","updated_at","updated_by") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)).
+			WithArgs(1, nil, `{"color":"Black","storage":"256GB"}`, &price, &qty, sqlmock.AnyArg(), "arya", sqlmock.AnyArg(), "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Create(context.Background(), variant)
+		s.NoError(err)
+		s.Equal(int64(7), variant.ID)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Same Axes", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(otherVariantQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "options"}).AddRow(7, `{"color":"Black","storage":"256GB"}`))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_variants"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(8))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Create(context.Background(), newVariant(entity.VariantOptions{"storage": "512GB", "color": "White"}))
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Different Axes", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(otherVariantQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "options"}).AddRow(7, `{"color":"Black","storage":"256GB"}`))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Create(context.Background(), newVariant(entity.VariantOptions{"color": "White"}))
+		s.ErrorIs(err, constant.ErrValidation)
+		s.EqualError(err, "validation error: options must name the axes color, storage like the other variants")
+	})
+
+	s.Run("Product Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Create(context.Background(), newVariant(entity.VariantOptions{"color": "Black"}))
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+
+	s.Run("Duplicate Options", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(otherVariantQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "options"}).AddRow(7, `{"color":"Black"}`))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "product_variants"`)).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_product_variants_options_unique"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Create(context.Background(), newVariant(entity.VariantOptions{"color": "Black"}))
+		s.ErrorIs(err, constant.ErrConflict)
+		s.EqualError(err, "conflict: the product already has a variant with these options")
+	})
+}
+
+func (s *ProductVariantSuite) TestFetchByProduct() {
+	s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_variants" WHERE product_id = $1 ORDER BY id`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "sku", "options"}).
+			AddRow(7, 1, "S24-BLK-256", `{"color":"Black","storage":"256GB"}`))
+
+	variants, err := s.repo.FetchByProduct(context.Background(), 1)
+	s.NoError(err)
+	s.Len(variants, 1)
+	s.Equal("S24-BLK-256", *variants[0].SKU)
+	s.Equal(entity.VariantOptions{"color": "Black", "storage": "256GB"}, variants[0].Options)
+}
+
+func (s *ProductVariantSuite) TestUpdate() {
+	price := int64(21000000)
+	qty := 3
+	sku := "S24-BLK-256"
+	newVariant := func() *entity.ProductVariant {
+		return &entity.ProductVariant{ID: 7, ProductID: 1, SKU: &sku, Options: entity.VariantOptions{"color": "Black"}, Price: &price, Quantity: &qty, UpdatedBy: "arya", UpdatedAt: time.Now()}
+	}
+	query := regexp.QuoteMeta(`UPDATE "product_variants" SET "options"=$1,"price"=$2,"quantity"=$3,"sku"=$4,"updated_at"=$5,"updated_by"=$6 WHERE product_id = $7 AND "id" = $8 RETURNING *`)
+
+	s.Run("Success", func() {
+		variant := newVariant()
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(otherVariantQuery)).
+			WithArgs(1, 7, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectQuery(query).
+			WithArgs(`{"color":"Black"}`, &price, &qty, &sku, sqlmock.AnyArg(), "arya", 1, 7).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "created_by"}).AddRow(7, 1, "budi"))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Update(context.Background(), variant)
+		s.NoError(err)
+		s.Equal("budi", variant.CreatedBy)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(otherVariantQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectQuery(query).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Update(context.Background(), newVariant())
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+
+	s.Run("Duplicate SKU", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(otherVariantQuery)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectQuery(query).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_product_variants_sku_unique"})
+		s.mock.ExpectRollback()
+
+		err := s.repo.Update(context.Background(), newVariant())
+		s.ErrorIs(err, constant.ErrConflict)
+	})
+}
+
+func (s *ProductVariantSuite) TestDelete() {
+	query := regexp.QuoteMeta(`DELETE FROM "product_variants" WHERE product_id = $1 AND "product_variants"."id" = $2`)
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(query).
+			WithArgs(1, 7).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Delete(context.Background(), 1, 7)
+		s.NoError(err)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectExec(regexp.QuoteMeta(bumpVersionQuery)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(query).
+			WithArgs(1, 9).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Delete(context.Background(), 1, 9)
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func TestProductVariantSuite(t *testing.T) {
+	suite.Run(t, new(ProductVariantSuite))
+}
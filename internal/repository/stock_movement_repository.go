@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type stockMovementRepository struct {
+	db *gorm.DB
+}
+
+func NewStockMovementRepository(db *gorm.DB) interfaces.StockMovementRepository {
+	return &stockMovementRepository{
+		db: db,
+	}
+}
+
+// Post appends a movement and moves the stock of its product, in total and at
+// the location of the movement, by the movement quantity in one transaction.
+// The product row stays locked from reading the stock until the commit, so
+// concurrent movements apply one after the other and none can take away
+// stock that is missing or held by a reservation.
+func (r *stockMovementRepository) Post(ctx context.Context, movement *entity.StockMovement) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		product, err := lockStock(tx, movement.ProductID)
+		if err != nil {
+			return err
+		}
+		stock, err := locationStock(tx, movement.ProductID, movement.LocationID)
+		if err != nil {
+			return err
+		}
+
+		if available := stock.Quantity - stock.Reserved; movement.Quantity < -available {
+			return fmt.Errorf("%w: insufficient stock, %d available at the location", constant.ErrConflict, available)
+		}
+		return appendMovement(ctx, tx, product, stock, movement)
+	})
+}
+
+// lockStock reads the stock of a product and locks its row until the
+// transaction ends. Every write to the stock of a product, at any location,
+// takes this lock first.
+func lockStock(tx *gorm.DB, productID int64) (*entity.Product, error) {
+	var product entity.Product
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "quantity", "reserved").First(&product, productID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, constant.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// locationStock reads the stock of a product at a location, or at the default
+// location when locationID is zero. A location that has no stock of the
+// product yet starts at zero.
+func locationStock(tx *gorm.DB, productID int64, locationID int64) (*entity.ProductStock, error) {
+	location, err := findLocation(tx, locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	stock := &entity.ProductStock{ProductID: productID, LocationID: location.ID}
+	if err := tx.Find(stock).Error; err != nil {
+		return nil, err
+	}
+	return stock, nil
+}
+
+// findLocation looks a location up by id, or the default location for a zero
+// id.
+func findLocation(tx *gorm.DB, locationID int64) (*entity.Location, error) {
+	query := tx.Select("id")
+	if locationID == 0 {
+		query = query.Where("is_default")
+	} else {
+		query = query.Where("id = ?", locationID)
+	}
+
+	var location entity.Location
+	err := query.Take(&location).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: location_id does not refer to an existing location", constant.ErrValidation)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
+
+// appendMovement writes the stock of a product locked by lockStock and of its
+// location, both moved by the movement quantity and with the reserved stock
+// as set by the caller, and appends the movement with the resulting balance.
+// The change of the quantity on hand is audited with the author of the
+// movement as the actor.
+func appendMovement(ctx context.Context, tx *gorm.DB, product *entity.Product, stock *entity.ProductStock, movement *entity.StockMovement) error {
+	onHand := product.Stock()
+	balance := onHand + movement.Quantity
+
+	// The stock version changes the ETag of the product detail, which shows
+	// the quantity, and leaves the version catalog edits are checked against.
+	err := tx.Model(product).UpdateColumns(map[string]interface{}{
+		"quantity":      balance,
+		"reserved":      product.Reserved,
+		"updated_at":    movement.CreatedAt,
+		"updated_by":    movement.CreatedBy,
+		"stock_version": gorm.Expr("stock_version + 1"),
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	stock.Quantity += movement.Quantity
+	if err := saveStock(tx, stock); err != nil {
+		return err
+	}
+
+	movement.LocationID = stock.LocationID
+	movement.Balance = balance
+	if err := tx.Create(movement).Error; err != nil {
+		return err
+	}
+
+	// lockStock reads the stock columns only, so both sides are limited to the
+	// quantity and the event records nothing else.
+	before := &entity.Product{ID: product.ID, Quantity: &onHand}
+	after := &entity.Product{ID: product.ID, Quantity: &balance}
+	return recordAudit(tx, []entity.AuditEvent{productAuditEvent(ctx, entity.AuditUpdate, movement.CreatedBy, before, after)})
+}
+
+// saveStock writes the stock of a product at a location, adding the row the
+// first time the location keeps the product.
+func saveStock(tx *gorm.DB, stock *entity.ProductStock) error {
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "product_id"}, {Name: "location_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"quantity", "reserved"}),
+	}).Create(stock).Error
+}
+
+// FetchByProduct returns a page of the movements of a product, newest first,
+// with the number of movements in total.
+func (r *stockMovementRepository) FetchByProduct(ctx context.Context, productID int64, page int, limit int) ([]entity.StockMovement, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entity.StockMovement{}).Where("product_id = ?", productID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var movements []entity.StockMovement
+	err := query.Order("id DESC").Offset((page - 1) * limit).Limit(limit).Find(&movements).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return movements, total, nil
+}
+
+// openingMovements starts the stock ledger of new products with the quantity
+// they were created with, kept at the default location.
+func openingMovements(tx *gorm.DB, products []*entity.Product) error {
+	var stocks []entity.ProductStock
+	var movements []entity.StockMovement
+	for _, product := range products {
+		if product.Stock() == 0 {
+			continue
+		}
+		stocks = append(stocks, entity.ProductStock{
+			ProductID: product.ID,
+			Quantity:  product.Stock(),
+		})
+		movements = append(movements, entity.StockMovement{
+			ProductID: product.ID,
+			Type:      entity.StockMovementAdjustment,
+			Quantity:  product.Stock(),
+			Balance:   product.Stock(),
+			Reason:    "opening balance",
+			CreatedAt: product.CreatedAt,
+			CreatedBy: product.CreatedBy,
+		})
+	}
+	if len(movements) == 0 {
+		return nil
+	}
+
+	location, err := findLocation(tx, 0)
+	if err != nil {
+		return err
+	}
+	for i := range movements {
+		stocks[i].LocationID = location.ID
+		movements[i].LocationID = location.ID
+	}
+
+	if err := tx.Create(&stocks).Error; err != nil {
+		return err
+	}
+	return tx.Create(&movements).Error
+}
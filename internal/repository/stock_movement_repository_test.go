@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type StockMovementSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo interfaces.StockMovementRepository
+	db   *sql.DB
+}
+
+func (s *StockMovementSuite) SetupTest() {
+	var err error
+
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       s.db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = NewStockMovementRepository(gormDB)
+}
+
+func (s *StockMovementSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func (s *StockMovementSuite) TestPost() {
+	lock := regexp.QuoteMeta(`SELECT "id","quantity","reserved" FROM "products" WHERE "products"."id" = $1 AND "products"."deleted_at" IS NULL ORDER BY "products"."id" LIMIT $2 FOR UPDATE`)
+	defaultLocation := regexp.QuoteMeta(`SELECT "id" FROM "locations" WHERE is_default LIMIT $1`)
+	location := regexp.QuoteMeta(`SELECT "id" FROM "locations" WHERE id = $1 LIMIT $2`)
+	stock := regexp.QuoteMeta(`SELECT * FROM "product_stocks" WHERE "product_stocks"."product_id" = $1 AND "product_stocks"."location_id" = $2`)
+	update := regexp.QuoteMeta(`UPDATE "products" SET "quantity"=$1,"reserved"=$2,"stock_version"=stock_version + 1,"updated_at"=$3,"updated_by"=$4 WHERE "products"."deleted_at" IS NULL AND "id" = $5`)
+	saveStock := regexp.QuoteMeta(`INSERT INTO "product_stocks" ("product_id","location_id","quantity","reserved") VALUES ($1,$2,$3,$4) ON CONFLICT ("product_id","location_id") DO UPDATE SET "quantity"="excluded"."quantity","reserved"="excluded"."reserved"`)
+	insert := regexp.QuoteMeta(`INSERT INTO "stock_movements" ("product_id","location_id","type","quantity","balance","reason","created_at","created_by") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+	audit := regexp.QuoteMeta(`INSERT INTO "audit_events" ("entity_type","entity_id","action","actor","request_id","before","after","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+	stockColumns := []string{"product_id", "location_id", "quantity", "reserved"}
+	now := time.Now()
+
+	s.Run("Sale At Default Location", func() {
+		movement := &entity.StockMovement{ProductID: 1, Type: entity.StockMovementSale, Quantity: -3, CreatedAt: now, CreatedBy: "arya"}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lock).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "quantity", "reserved"}).AddRow(1, 10, 0))
+		s.mock.ExpectQuery(defaultLocation).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectQuery(stock).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows(stockColumns).AddRow(1, 1, 6, 0))
+		s.mock.ExpectExec(update).
+			WithArgs(7, 0, now, "arya", 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(saveStock).
+			WithArgs(1, 1, 3, 0).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(insert).
+			WithArgs(1, 1, entity.StockMovementSale, -3, 7, "", now, "arya").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+		s.mock.ExpectQuery(audit).
+			WithArgs("product", 1, "update", "arya", "", `{"quantity":10}`, `{"quantity":7}`, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Post(context.Background(), movement)
+		s.NoError(err)
+		s.Equal(int64(5), movement.ID)
+		s.Equal(int64(1), movement.LocationID)
+		s.Equal(7, movement.Balance)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("First Receipt At Location", func() {
+		movement := &entity.StockMovement{ProductID: 2, LocationID: 3, Type: entity.StockMovementReceipt, Quantity: 4, CreatedAt: now, CreatedBy: "arya"}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lock).
+			WithArgs(2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "quantity", "reserved"}).AddRow(2, nil, 0))
+		s.mock.ExpectQuery(location).
+			WithArgs(3, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		s.mock.ExpectQuery(stock).
+			WithArgs(2, 3).
+			WillReturnRows(sqlmock.NewRows(stockColumns))
+		s.mock.ExpectExec(update).
+			WithArgs(4, 0, now, "arya", 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(saveStock).
+			WithArgs(2, 3, 4, 0).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(insert).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(6))
+		s.mock.ExpectQuery(audit).
+			WithArgs("product", 2, "update", "arya", "", `{"quantity":0}`, `{"quantity":4}`, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Post(context.Background(), movement)
+		s.NoError(err)
+		s.Equal(4, movement.Balance)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Insufficient Stock At Location", func() {
+		movement := &entity.StockMovement{ProductID: 1, LocationID: 3, Type: entity.StockMovementSale, Quantity: -5, CreatedAt: now, CreatedBy: "arya"}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lock).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "quantity", "reserved"}).AddRow(1, 10, 0))
+		s.mock.ExpectQuery(location).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		s.mock.ExpectQuery(stock).
+			WillReturnRows(sqlmock.NewRows(stockColumns).AddRow(1, 3, 4, 0))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Post(context.Background(), movement)
+		s.ErrorIs(err, constant.ErrConflict)
+		s.EqualError(err, "conflict: insufficient stock, 4 available at the location")
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Reserved Stock Not Available", func() {
+		movement := &entity.StockMovement{ProductID: 1, Type: entity.StockMovementSale, Quantity: -8, CreatedAt: now, CreatedBy: "arya"}
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lock).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "quantity", "reserved"}).AddRow(1, 10, 3))
+		s.mock.ExpectQuery(defaultLocation).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectQuery(stock).
+			WillReturnRows(sqlmock.NewRows(stockColumns).AddRow(1, 1, 10, 3))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Post(context.Background(), movement)
+		s.EqualError(err, "conflict: insufficient stock, 7 available at the location")
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Unknown Location", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lock).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "quantity", "reserved"}).AddRow(1, 10, 0))
+		s.mock.ExpectQuery(location).
+			WithArgs(9, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Post(context.Background(), &entity.StockMovement{ProductID: 1, LocationID: 9, Quantity: 1})
+		s.ErrorIs(err, constant.ErrValidation)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Product Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lock).
+			WillReturnError(gorm.ErrRecordNotFound)
+		s.mock.ExpectRollback()
+
+		err := s.repo.Post(context.Background(), &entity.StockMovement{ProductID: 9, Quantity: 1})
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+
+	s.Run("DB Error", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lock).
+			WillReturnError(sql.ErrConnDone)
+		s.mock.ExpectRollback()
+
+		err := s.repo.Post(context.Background(), &entity.StockMovement{ProductID: 1, Quantity: 1})
+		s.ErrorIs(err, sql.ErrConnDone)
+	})
+}
+
+func (s *StockMovementSuite) TestFetchByProduct() {
+	count := regexp.QuoteMeta(`SELECT count(*) FROM "stock_movements" WHERE product_id = $1`)
+
+	s.Run("Success", func() {
+		s.mock.ExpectQuery(count).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "stock_movements" WHERE product_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3`)).
+			WithArgs(1, 10, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "type", "quantity", "balance"}).
+				AddRow(2, 1, entity.StockMovementSale, -3, 7).
+				AddRow(1, 1, entity.StockMovementAdjustment, 10, 10))
+
+		movements, total, err := s.repo.FetchByProduct(context.Background(), 1, 2, 10)
+		s.NoError(err)
+		s.Equal(int64(12), total)
+		s.Len(movements, 2)
+		s.Equal(7, movements[0].Balance)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Count Error", func() {
+		s.mock.ExpectQuery(count).WillReturnError(sql.ErrConnDone)
+
+		movements, _, err := s.repo.FetchByProduct(context.Background(), 1, 1, 10)
+		s.Error(err)
+		s.Nil(movements)
+	})
+
+	s.Run("Find Error", func() {
+		s.mock.ExpectQuery(count).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "stock_movements"`)).
+			WillReturnError(sql.ErrConnDone)
+
+		movements, _, err := s.repo.FetchByProduct(context.Background(), 1, 1, 10)
+		s.Error(err)
+		s.Nil(movements)
+	})
+}
+
+func TestStockMovementSuite(t *testing.T) {
+	suite.Run(t, new(StockMovementSuite))
+}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// reaperActor is recorded as the updater of the reservations that expire.
+const reaperActor = "system"
+
+type stockReservationRepository struct {
+	db *gorm.DB
+}
+
+func NewStockReservationRepository(db *gorm.DB) interfaces.StockReservationRepository {
+	return &stockReservationRepository{
+		db: db,
+	}
+}
+
+// Reserve holds the reservation quantity at its location when that much stock
+// is available there, and stores the reservation. The product row is locked
+// like for a stock movement, so reservations and movements never share out
+// the same units twice.
+func (r *stockReservationRepository) Reserve(ctx context.Context, reservation *entity.StockReservation) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		product, err := lockStock(tx, reservation.ProductID)
+		if err != nil {
+			return err
+		}
+		stock, err := locationStock(tx, reservation.ProductID, reservation.LocationID)
+		if err != nil {
+			return err
+		}
+
+		if available := stock.Quantity - stock.Reserved; reservation.Quantity > available {
+			return fmt.Errorf("%w: insufficient stock, %d available at the location", constant.ErrConflict, available)
+		}
+
+		err = tx.Model(product).UpdateColumns(map[string]interface{}{
+			"reserved":      product.Reserved + reservation.Quantity,
+			"stock_version": gorm.Expr("stock_version + 1"),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		stock.Reserved += reservation.Quantity
+		if err := saveStock(tx, stock); err != nil {
+			return err
+		}
+
+		reservation.LocationID = stock.LocationID
+		return tx.Create(reservation).Error
+	})
+}
+
+func (r *stockReservationRepository) GetByID(ctx context.Context, id int64) (*entity.StockReservation, error) {
+	var reservation entity.StockReservation
+	err := r.db.WithContext(ctx).First(&reservation, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, constant.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+// Commit turns an active reservation into a sale: the held units leave the
+// reserved and the on hand stock together, through a movement in the ledger.
+func (r *stockReservationRepository) Commit(ctx context.Context, id int64, updatedBy string, at time.Time) (*entity.StockReservation, error) {
+	var reservation *entity.StockReservation
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		reservation, err = lockActiveReservation(tx, id)
+		if err != nil {
+			return err
+		}
+		if !reservation.ExpiresAt.After(at) {
+			return fmt.Errorf("%w: reservation has expired", constant.ErrConflict)
+		}
+
+		product, err := lockStock(tx, reservation.ProductID)
+		if err != nil {
+			return err
+		}
+		stock, err := locationStock(tx, reservation.ProductID, reservation.LocationID)
+		if err != nil {
+			return err
+		}
+
+		product.Reserved -= reservation.Quantity
+		stock.Reserved -= reservation.Quantity
+		err = appendMovement(ctx, tx, product, stock, &entity.StockMovement{
+			ProductID: reservation.ProductID,
+			Type:      entity.StockMovementSale,
+			Quantity:  -reservation.Quantity,
+			Reason:    fmt.Sprintf("reservation %d", reservation.ID),
+			CreatedAt: at,
+			CreatedBy: updatedBy,
+		})
+		if err != nil {
+			return err
+		}
+
+		return resolveReservation(tx, reservation, entity.ReservationCommitted, updatedBy, at)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// Release gives the units of an active reservation back to the available
+// stock.
+func (r *stockReservationRepository) Release(ctx context.Context, id int64, updatedBy string, at time.Time) (*entity.StockReservation, error) {
+	var reservation *entity.StockReservation
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		reservation, err = lockActiveReservation(tx, id)
+		if err != nil {
+			return err
+		}
+		return releaseReservation(tx, reservation, entity.ReservationReleased, updatedBy, at)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// ExpireNext claims one active reservation that expired before now and
+// releases it. Reservations locked by a checkout committing or releasing them
+// are skipped, so the reaper never waits on one. Returns ErrNotFound when
+// nothing is left to expire.
+func (r *stockReservationRepository) ExpireNext(ctx context.Context, now time.Time) (*entity.StockReservation, error) {
+	var reservation entity.StockReservation
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND expires_at <= ?", entity.ReservationActive, now).
+			Order("expires_at").
+			Limit(1).
+			Find(&reservation)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return constant.ErrNotFound
+		}
+		return releaseReservation(tx, &reservation, entity.ReservationExpired, reaperActor, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+// lockActiveReservation reads a reservation and locks its row until the
+// transaction ends. A reservation that was already resolved is a conflict.
+func lockActiveReservation(tx *gorm.DB, id int64) (*entity.StockReservation, error) {
+	var reservation entity.StockReservation
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&reservation, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, constant.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if reservation.Status != entity.ReservationActive {
+		return nil, fmt.Errorf("%w: reservation is %s", constant.ErrConflict, reservation.Status)
+	}
+	return &reservation, nil
+}
+
+// releaseReservation hands the units of a locked reservation back to its
+// product and location and closes the reservation with status.
+func releaseReservation(tx *gorm.DB, reservation *entity.StockReservation, status string, updatedBy string, at time.Time) error {
+	// Unscoped, a deleted product gives back its reserved stock all the same.
+	err := tx.Unscoped().Model(&entity.Product{}).
+		Where("id = ?", reservation.ProductID).
+		UpdateColumns(map[string]interface{}{
+			"reserved":      gorm.Expr("reserved - ?", reservation.Quantity),
+			"stock_version": gorm.Expr("stock_version + 1"),
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Model(&entity.ProductStock{}).
+		Where("product_id = ? AND location_id = ?", reservation.ProductID, reservation.LocationID).
+		UpdateColumn("reserved", gorm.Expr("reserved - ?", reservation.Quantity)).Error
+	if err != nil {
+		return err
+	}
+	return resolveReservation(tx, reservation, status, updatedBy, at)
+}
+
+func resolveReservation(tx *gorm.DB, reservation *entity.StockReservation, status string, updatedBy string, at time.Time) error {
+	reservation.Status = status
+	reservation.UpdatedAt = at
+	reservation.UpdatedBy = updatedBy
+	return tx.Model(reservation).UpdateColumns(map[string]interface{}{
+		"status":     status,
+		"updated_at": at,
+		"updated_by": updatedBy,
+	}).Error
+}
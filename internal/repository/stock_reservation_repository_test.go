@@ -0,0 +1,296 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/shared/constant"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type StockReservationSuite struct {
+	suite.Suite
+	mock sqlmock.Sqlmock
+	repo interfaces.StockReservationRepository
+	db   *sql.DB
+}
+
+func (s *StockReservationSuite) SetupTest() {
+	var err error
+
+	s.db, s.mock, err = sqlmock.New()
+	s.Require().NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       s.db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	s.Require().NoError(err)
+
+	s.repo = NewStockReservationRepository(gormDB)
+}
+
+func (s *StockReservationSuite) TearDownTest() {
+	s.db.Close()
+}
+
+var (
+	lockProductQuery     = regexp.QuoteMeta(`SELECT "id","quantity","reserved" FROM "products" WHERE "products"."id" = $1 AND "products"."deleted_at" IS NULL ORDER BY "products"."id" LIMIT $2 FOR UPDATE`)
+	lockReservationQuery = regexp.QuoteMeta(`SELECT * FROM "stock_reservations" WHERE "stock_reservations"."id" = $1 ORDER BY "stock_reservations"."id" LIMIT $2 FOR UPDATE`)
+	resolveQuery         = regexp.QuoteMeta(`UPDATE "stock_reservations" SET "status"=$1,"updated_at"=$2,"updated_by"=$3 WHERE "id" = $4`)
+	releaseStockQuery    = regexp.QuoteMeta(`UPDATE "products" SET "reserved"=reserved - $1,"stock_version"=stock_version + 1 WHERE id = $2`)
+	releaseLocationQuery = regexp.QuoteMeta(`UPDATE "product_stocks" SET "reserved"=reserved - $1 WHERE product_id = $2 AND location_id = $3`)
+	defaultLocationQuery = regexp.QuoteMeta(`SELECT "id" FROM "locations" WHERE is_default LIMIT $1`)
+	locationStockQuery   = regexp.QuoteMeta(`SELECT * FROM "product_stocks" WHERE "product_stocks"."product_id" = $1 AND "product_stocks"."location_id" = $2`)
+	saveStockQuery       = regexp.QuoteMeta(`INSERT INTO "product_stocks" ("product_id","location_id","quantity","reserved") VALUES ($1,$2,$3,$4) ON CONFLICT ("product_id","location_id") DO UPDATE SET "quantity"="excluded"."quantity","reserved"="excluded"."reserved"`)
+	stockColumns         = []string{"product_id", "location_id", "quantity", "reserved"}
+)
+
+func (s *StockReservationSuite) TestReserve() {
+	now := time.Now()
+	newReservation := func() *entity.StockReservation {
+		return &entity.StockReservation{ProductID: 1, Quantity: 3, Status: entity.ReservationActive, ExpiresAt: now.Add(time.Minute), CreatedAt: now, CreatedBy: "checkout", UpdatedAt: now, UpdatedBy: "checkout"}
+	}
+
+	s.Run("Success", func() {
+		reservation := newReservation()
+
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lockProductQuery).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "quantity", "reserved"}).AddRow(1, 10, 5))
+		s.mock.ExpectQuery(defaultLocationQuery).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectQuery(locationStockQuery).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows(stockColumns).AddRow(1, 1, 8, 4))
+		s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "products" SET "reserved"=$1,"stock_version"=stock_version + 1 WHERE "products"."deleted_at" IS NULL AND "id" = $2`)).
+			WithArgs(8, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(saveStockQuery).
+			WithArgs(1, 1, 8, 7).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "stock_reservations" ("product_id","location_id","quantity","status","reference","expires_at","created_at","created_by","updated_at","updated_by") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		s.mock.ExpectCommit()
+
+		err := s.repo.Reserve(context.Background(), reservation)
+		s.NoError(err)
+		s.Equal(int64(4), reservation.ID)
+		s.Equal(int64(1), reservation.LocationID)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Insufficient Stock At Location", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lockProductQuery).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "quantity", "reserved"}).AddRow(1, 10, 5))
+		s.mock.ExpectQuery(defaultLocationQuery).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectQuery(locationStockQuery).
+			WillReturnRows(sqlmock.NewRows(stockColumns).AddRow(1, 1, 6, 4))
+		s.mock.ExpectRollback()
+
+		err := s.repo.Reserve(context.Background(), newReservation())
+		s.EqualError(err, "conflict: insufficient stock, 2 available at the location")
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Product Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lockProductQuery).
+			WillReturnError(gorm.ErrRecordNotFound)
+		s.mock.ExpectRollback()
+
+		err := s.repo.Reserve(context.Background(), newReservation())
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *StockReservationSuite) TestGetByID() {
+	query := regexp.QuoteMeta(`SELECT * FROM "stock_reservations" WHERE "stock_reservations"."id" = $1 ORDER BY "stock_reservations"."id" LIMIT $2`)
+
+	s.Run("Found", func() {
+		s.mock.ExpectQuery(query).
+			WithArgs(4, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "quantity", "status"}).AddRow(4, 1, 3, entity.ReservationActive))
+
+		res, err := s.repo.GetByID(context.Background(), 4)
+		s.NoError(err)
+		s.Equal(3, res.Quantity)
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectQuery(query).
+			WithArgs(9, 1).
+			WillReturnError(gorm.ErrRecordNotFound)
+
+		res, err := s.repo.GetByID(context.Background(), 9)
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(res)
+	})
+}
+
+func (s *StockReservationSuite) TestCommit() {
+	now := time.Now()
+	columns := []string{"id", "product_id", "location_id", "quantity", "status", "expires_at"}
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lockReservationQuery).
+			WithArgs(4, 1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(4, 1, 2, 3, entity.ReservationActive, now.Add(time.Minute)))
+		s.mock.ExpectQuery(lockProductQuery).
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "quantity", "reserved"}).AddRow(1, 10, 5))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "locations" WHERE id = $1 LIMIT $2`)).
+			WithArgs(2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		s.mock.ExpectQuery(locationStockQuery).
+			WithArgs(1, 2).
+			WillReturnRows(sqlmock.NewRows(stockColumns).AddRow(1, 2, 4, 3))
+		s.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "products" SET "quantity"=$1,"reserved"=$2,"stock_version"=stock_version + 1,"updated_at"=$3,"updated_by"=$4 WHERE "products"."deleted_at" IS NULL AND "id" = $5`)).
+			WithArgs(7, 2, now, "checkout", 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(saveStockQuery).
+			WithArgs(1, 2, 1, 0).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "stock_movements"`)).
+			WithArgs(1, 2, entity.StockMovementSale, -3, 7, "reservation 4", now, "checkout").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+		s.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_events"`)).
+			WithArgs("product", 1, "update", "checkout", "", `{"quantity":10}`, `{"quantity":7}`, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.mock.ExpectExec(resolveQuery).
+			WithArgs(entity.ReservationCommitted, now, "checkout", 4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		res, err := s.repo.Commit(context.Background(), 4, "checkout", now)
+		s.NoError(err)
+		s.Equal(entity.ReservationCommitted, res.Status)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Expired", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lockReservationQuery).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(4, 1, 1, 3, entity.ReservationActive, now.Add(-time.Second)))
+		s.mock.ExpectRollback()
+
+		res, err := s.repo.Commit(context.Background(), 4, "checkout", now)
+		s.EqualError(err, "conflict: reservation has expired")
+		s.Nil(res)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Already Released", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lockReservationQuery).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(4, 1, 1, 3, entity.ReservationReleased, now.Add(time.Minute)))
+		s.mock.ExpectRollback()
+
+		_, err := s.repo.Commit(context.Background(), 4, "checkout", now)
+		s.EqualError(err, "conflict: reservation is released")
+	})
+
+	s.Run("Not Found", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lockReservationQuery).
+			WillReturnError(gorm.ErrRecordNotFound)
+		s.mock.ExpectRollback()
+
+		_, err := s.repo.Commit(context.Background(), 9, "checkout", now)
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *StockReservationSuite) TestRelease() {
+	now := time.Now()
+
+	s.Run("Success", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lockReservationQuery).
+			WithArgs(4, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "location_id", "quantity", "status"}).AddRow(4, 1, 2, 3, entity.ReservationActive))
+		s.mock.ExpectExec(releaseStockQuery).
+			WithArgs(3, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(releaseLocationQuery).
+			WithArgs(3, 1, 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(resolveQuery).
+			WithArgs(entity.ReservationReleased, now, "checkout", 4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		res, err := s.repo.Release(context.Background(), 4, "checkout", now)
+		s.NoError(err)
+		s.Equal(entity.ReservationReleased, res.Status)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Already Committed", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(lockReservationQuery).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "quantity", "status"}).AddRow(4, 1, 3, entity.ReservationCommitted))
+		s.mock.ExpectRollback()
+
+		res, err := s.repo.Release(context.Background(), 4, "checkout", now)
+		s.ErrorIs(err, constant.ErrConflict)
+		s.Nil(res)
+	})
+}
+
+func (s *StockReservationSuite) TestExpireNext() {
+	now := time.Now()
+	query := regexp.QuoteMeta(`SELECT * FROM "stock_reservations" WHERE status = $1 AND expires_at <= $2 ORDER BY expires_at LIMIT $3 FOR UPDATE SKIP LOCKED`)
+
+	s.Run("Expired", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(query).
+			WithArgs(entity.ReservationActive, now, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "location_id", "quantity", "status"}).AddRow(4, 1, 2, 3, entity.ReservationActive))
+		s.mock.ExpectExec(releaseStockQuery).
+			WithArgs(3, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(releaseLocationQuery).
+			WithArgs(3, 1, 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectExec(resolveQuery).
+			WithArgs(entity.ReservationExpired, now, "system", 4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.mock.ExpectCommit()
+
+		res, err := s.repo.ExpireNext(context.Background(), now)
+		s.NoError(err)
+		s.Equal(int64(4), res.ID)
+		s.Equal(entity.ReservationExpired, res.Status)
+		s.NoError(s.mock.ExpectationsWereMet())
+	})
+
+	s.Run("Nothing Expired", func() {
+		s.mock.ExpectBegin()
+		s.mock.ExpectQuery(query).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		s.mock.ExpectRollback()
+
+		res, err := s.repo.ExpireNext(context.Background(), now)
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(res)
+	})
+}
+
+func TestStockReservationSuite(t *testing.T) {
+	suite.Run(t, new(StockReservationSuite))
+}
@@ -0,0 +1,297 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/internal/repository"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/utils"
+)
+
+// maxAttributeText is the longest value a string attribute holds.
+const maxAttributeText = 255
+
+type attributeUsecase struct {
+	repo      interfaces.AttributeRepository
+	redisRepo repository.RedisRepository
+	validator *utils.CustomValidator
+}
+
+func NewAttributeUsecase(repo interfaces.AttributeRepository, redisRepo repository.RedisRepository) interfaces.AttributeUsecase {
+	return &attributeUsecase{
+		repo:      repo,
+		redisRepo: redisRepo,
+		validator: utils.NewValidator(),
+	}
+}
+
+func (u *attributeUsecase) CreateAttribute(ctx context.Context, req *request.Attribute) (*entity.Attribute, error) {
+
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	code := strings.TrimSpace(req.Code)
+	if !request.AttributeCodePattern.MatchString(code) {
+		return nil, fmt.Errorf("%w: code must be lower case letters, digits and underscores, starting with a letter", constant.ErrValidation)
+	}
+
+	unit, options, err := attributeShape(req.Type, req.Unit, req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	attribute := &entity.Attribute{
+		Code:      code,
+		Name:      strings.TrimSpace(req.Name),
+		Type:      req.Type,
+		Unit:      unit,
+		Options:   options,
+		CreatedAt: now,
+		CreatedBy: req.CreatedBy,
+		UpdatedAt: now,
+		UpdatedBy: req.CreatedBy,
+	}
+
+	if err := u.repo.Create(ctx, attribute); err != nil {
+		return nil, err
+	}
+
+	return attribute, nil
+}
+
+func (u *attributeUsecase) GetAttribute(ctx context.Context, id int64) (*entity.Attribute, error) {
+	return u.repo.GetByID(ctx, id)
+}
+
+func (u *attributeUsecase) ListAttributes(ctx context.Context) ([]entity.Attribute, error) {
+	attributes, err := u.repo.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if attributes == nil {
+		attributes = []entity.Attribute{}
+	}
+	return attributes, nil
+}
+
+// UpdateAttribute renames an attribute and replaces its unit and options.
+// Products only hold the values, so no cached product changes.
+func (u *attributeUsecase) UpdateAttribute(ctx context.Context, id int64, req *request.AttributeUpdate) (*entity.Attribute, error) {
+
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	current, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	unit, options, err := attributeShape(current.Type, req.Unit, req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	attribute := &entity.Attribute{
+		ID:        id,
+		Name:      strings.TrimSpace(req.Name),
+		Unit:      unit,
+		Options:   options,
+		UpdatedAt: time.Now(),
+		UpdatedBy: req.UpdatedBy,
+	}
+
+	if err := u.repo.Update(ctx, attribute); err != nil {
+		return nil, err
+	}
+
+	return attribute, nil
+}
+
+func (u *attributeUsecase) DeleteAttribute(ctx context.Context, id int64) error {
+	return u.repo.Delete(ctx, id)
+}
+
+func (u *attributeUsecase) GetCategoryAttributes(ctx context.Context, categoryID int64) ([]entity.Attribute, error) {
+	attributes, err := u.repo.FetchByCategory(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	if attributes == nil {
+		attributes = []entity.Attribute{}
+	}
+	return attributes, nil
+}
+
+// SetCategoryAttributes replaces the attributes a category defines and returns
+// those that now apply to it, inherited ones included. Values products already
+// hold are kept.
+func (u *attributeUsecase) SetCategoryAttributes(ctx context.Context, categoryID int64, req *request.CategoryAttributes) ([]entity.Attribute, error) {
+
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.SetCategoryAttributes(ctx, categoryID, req.AttributeIDs); err != nil {
+		return nil, err
+	}
+
+	return u.GetCategoryAttributes(ctx, categoryID)
+}
+
+// SetProductAttributes replaces the attribute values of a product, written
+// only if the product is still at version when it is above 0. Every value
+// must belong to an attribute of the categories of the product, or of their
+// ancestors, and be of its type.
+func (u *attributeUsecase) SetProductAttributes(ctx context.Context, productID int64, version int64, req *request.ProductAttributes) (*entity.Product, error) {
+
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	attributes, err := u.repo.FetchByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicable := make(map[string]entity.Attribute, len(attributes))
+	for _, attribute := range attributes {
+		applicable[attribute.Code] = attribute
+	}
+
+	values := make(entity.ProductAttributes, len(req.Attributes))
+	for _, code := range slices.Sorted(maps.Keys(req.Attributes)) {
+		attribute, ok := applicable[code]
+		if !ok {
+			return nil, fmt.Errorf("%w: attribute %s does not apply to the categories of the product", constant.ErrValidation, code)
+		}
+
+		value, err := attributeValue(attribute, req.Attributes[code])
+		if err != nil {
+			return nil, err
+		}
+		values[code] = value
+	}
+
+	product, err := u.repo.SetProductValues(ctx, productID, version, values, req.UpdatedBy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateProductCaches(ctx, u.redisRepo, productID)
+
+	return product, nil
+}
+
+// attributeShape checks the unit and options against the type of an
+// attribute: only unit attributes have a unit, which they need, and only enum
+// attributes have options, at least one and each listed once.
+func attributeShape(attributeType string, unit string, options []string) (string, entity.AttributeOptions, error) {
+	unit = strings.TrimSpace(unit)
+	if attributeType == entity.AttributeUnit && unit == "" {
+		return "", nil, fmt.Errorf("%w: unit attributes need a unit", constant.ErrValidation)
+	}
+	if attributeType != entity.AttributeUnit && unit != "" {
+		return "", nil, fmt.Errorf("%w: only unit attributes have a unit", constant.ErrValidation)
+	}
+
+	if attributeType != entity.AttributeEnum {
+		if len(options) > 0 {
+			return "", nil, fmt.Errorf("%w: only enum attributes have options", constant.ErrValidation)
+		}
+		return unit, nil, nil
+	}
+	if len(options) == 0 {
+		return "", nil, fmt.Errorf("%w: enum attributes need options", constant.ErrValidation)
+	}
+
+	normalized := make(entity.AttributeOptions, 0, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if _, found := matchOption(normalized, option); found {
+			return "", nil, fmt.Errorf("%w: option %q is listed twice", constant.ErrValidation, option)
+		}
+		normalized = append(normalized, option)
+	}
+	return unit, normalized, nil
+}
+
+// attributeValue checks a value decoded from JSON against the type of its
+// attribute and returns it as stored: trimmed text, the spelling of the enum
+// option, or the number or boolean as is.
+func attributeValue(attribute entity.Attribute, value interface{}) (interface{}, error) {
+	switch attribute.Type {
+	case entity.AttributeNumber, entity.AttributeUnit:
+		if number, ok := value.(float64); ok {
+			return number, nil
+		}
+		return nil, fmt.Errorf("%w: attributes.%s must be a number", constant.ErrValidation, attribute.Code)
+	case entity.AttributeBoolean:
+		if flag, ok := value.(bool); ok {
+			return flag, nil
+		}
+		return nil, fmt.Errorf("%w: attributes.%s must be true or false", constant.ErrValidation, attribute.Code)
+	case entity.AttributeEnum:
+		text, _ := value.(string)
+		if option, found := matchOption(attribute.Options, strings.TrimSpace(text)); found {
+			return option, nil
+		}
+		return nil, fmt.Errorf("%w: attributes.%s must be one of %s", constant.ErrValidation, attribute.Code, strings.Join(attribute.Options, ", "))
+	default:
+		text, _ := value.(string)
+		text = strings.TrimSpace(text)
+		if text == "" || len(text) > maxAttributeText {
+			return nil, fmt.Errorf("%w: attributes.%s must be a text of 1 to %d characters", constant.ErrValidation, attribute.Code, maxAttributeText)
+		}
+		return text, nil
+	}
+}
+
+// parseAttributeValue reads a value of a listing filter as the type of its
+// attribute.
+func parseAttributeValue(attribute entity.Attribute, text string) (interface{}, error) {
+	switch attribute.Type {
+	case entity.AttributeNumber, entity.AttributeUnit:
+		number, err := strconv.ParseFloat(text, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return nil, fmt.Errorf("%w: attr.%s must be a number", constant.ErrValidation, attribute.Code)
+		}
+		return number, nil
+	case entity.AttributeBoolean:
+		flag, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, fmt.Errorf("%w: attr.%s must be true or false", constant.ErrValidation, attribute.Code)
+		}
+		return flag, nil
+	case entity.AttributeEnum:
+		if option, found := matchOption(attribute.Options, text); found {
+			return option, nil
+		}
+		return nil, fmt.Errorf("%w: attr.%s must be one of %s", constant.ErrValidation, attribute.Code, strings.Join(attribute.Options, ", "))
+	default:
+		return text, nil
+	}
+}
+
+// matchOption finds value among the options regardless of case and returns the
+// spelling of the option.
+func matchOption(options []string, value string) (string, bool) {
+	for _, option := range options {
+		if strings.EqualFold(option, value) {
+			return option, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AttributeUsecaseTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.AttributeRepository
+	mockRedisRepo *mocks.RedisRepository
+	uc            interfaces.AttributeUsecase
+}
+
+func (s *AttributeUsecaseTestSuite) SetupTest() {
+	s.mockRepo = new(mocks.AttributeRepository)
+	s.mockRedisRepo = new(mocks.RedisRepository)
+	s.uc = NewAttributeUsecase(s.mockRepo, s.mockRedisRepo)
+}
+
+func (s *AttributeUsecaseTestSuite) TestCreateAttribute() {
+
+	s.Run("Success", func() {
+		req := &request.Attribute{Code: "color", Name: " Color ", Type: entity.AttributeEnum, Options: []string{" Black", "White "}, CreatedBy: "arya"}
+
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(a *entity.Attribute) bool {
+			return a.Code == "color" && a.Name == "Color" && a.UpdatedBy == "arya"
+		})).Return(nil).Once()
+
+		attribute, err := s.uc.CreateAttribute(context.Background(), req)
+
+		s.NoError(err)
+		s.Equal(entity.AttributeOptions{"Black", "White"}, attribute.Options)
+	})
+
+	s.Run("Invalid Code", func() {
+		req := &request.Attribute{Code: "RAM GB", Name: "RAM", Type: entity.AttributeNumber, CreatedBy: "arya"}
+
+		_, err := s.uc.CreateAttribute(context.Background(), req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+
+	s.Run("Unit Without Unit", func() {
+		req := &request.Attribute{Code: "ram_gb", Name: "RAM", Type: entity.AttributeUnit, CreatedBy: "arya"}
+
+		_, err := s.uc.CreateAttribute(context.Background(), req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.ErrorContains(err, "unit attributes need a unit")
+	})
+
+	s.Run("Options On Number", func() {
+		req := &request.Attribute{Code: "ram_gb", Name: "RAM", Type: entity.AttributeNumber, Options: []string{"8"}, CreatedBy: "arya"}
+
+		_, err := s.uc.CreateAttribute(context.Background(), req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+
+	s.Run("Duplicate Option", func() {
+		req := &request.Attribute{Code: "color", Name: "Color", Type: entity.AttributeEnum, Options: []string{"Black", "black"}, CreatedBy: "arya"}
+
+		_, err := s.uc.CreateAttribute(context.Background(), req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.ErrorContains(err, `option "black" is listed twice`)
+	})
+
+	s.Run("Validation Error", func() {
+		_, err := s.uc.CreateAttribute(context.Background(), &request.Attribute{Code: "color", Type: "list"})
+
+		s.Error(err)
+	})
+}
+
+func (s *AttributeUsecaseTestSuite) TestUpdateAttribute() {
+
+	s.Run("Success", func() {
+		req := &request.AttributeUpdate{Name: "Screen", Unit: "inch", UpdatedBy: "arya"}
+
+		s.mockRepo.On("GetByID", mock.Anything, int64(2)).Return(&entity.Attribute{ID: 2, Code: "screen", Type: entity.AttributeUnit, Unit: "in"}, nil).Once()
+		s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(a *entity.Attribute) bool {
+			return a.ID == 2 && a.Unit == "inch" && a.UpdatedBy == "arya"
+		})).Return(nil).Once()
+
+		attribute, err := s.uc.UpdateAttribute(context.Background(), 2, req)
+
+		s.NoError(err)
+		s.Equal("Screen", attribute.Name)
+	})
+
+	s.Run("Not Found", func() {
+		s.mockRepo.On("GetByID", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		_, err := s.uc.UpdateAttribute(context.Background(), 9, &request.AttributeUpdate{Name: "Screen", UpdatedBy: "arya"})
+
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+
+	s.Run("Enum Without Options", func() {
+		s.mockRepo.On("GetByID", mock.Anything, int64(1)).Return(&entity.Attribute{ID: 1, Code: "color", Type: entity.AttributeEnum}, nil).Once()
+
+		_, err := s.uc.UpdateAttribute(context.Background(), 1, &request.AttributeUpdate{Name: "Color", UpdatedBy: "arya"})
+
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+}
+
+func (s *AttributeUsecaseTestSuite) TestSetCategoryAttributes() {
+	req := &request.CategoryAttributes{AttributeIDs: []int64{1, 2}}
+	attributes := []entity.Attribute{{ID: 1, Code: "color"}, {ID: 2, Code: "ram_gb"}, {ID: 3, Code: "weight"}}
+
+	s.mockRepo.On("SetCategoryAttributes", mock.Anything, int64(4), []int64{1, 2}).Return(nil).Once()
+	s.mockRepo.On("FetchByCategory", mock.Anything, int64(4)).Return(attributes, nil).Once()
+
+	result, err := s.uc.SetCategoryAttributes(context.Background(), 4, req)
+
+	s.NoError(err)
+	s.Len(result, 3, "Inherited attributes are returned too")
+}
+
+func (s *AttributeUsecaseTestSuite) TestSetProductAttributes() {
+	applicable := []entity.Attribute{
+		{Code: "color", Type: entity.AttributeEnum, Options: entity.AttributeOptions{"Black", "White"}},
+		{Code: "model", Type: entity.AttributeString},
+		{Code: "nfc", Type: entity.AttributeBoolean},
+		{Code: "ram_gb", Type: entity.AttributeUnit, Unit: "GB"},
+	}
+
+	s.Run("Success", func() {
+		req := &request.ProductAttributes{
+			Attributes: map[string]interface{}{"color": "white", "model": " SM-S921 ", "nfc": true, "ram_gb": float64(8)},
+			UpdatedBy:  "arya",
+		}
+		values := entity.ProductAttributes{"color": "White", "model": "SM-S921", "nfc": true, "ram_gb": float64(8)}
+
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(1)).Return(applicable, nil).Once()
+		s.mockRepo.On("SetProductValues", mock.Anything, int64(1), int64(2), values, "arya", mock.Anything).
+			Return(&entity.Product{ID: 1, Version: 3, Attributes: values}, nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, 1)).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, constant.RedisKeyProductList+"*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, constant.RedisKeyProductFacets+"*").Return(nil).Once()
+
+		product, err := s.uc.SetProductAttributes(context.Background(), 1, 2, req)
+
+		s.NoError(err)
+		s.Equal(values, product.Attributes)
+		s.Equal(int64(3), product.Version)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Attribute Of Another Category", func() {
+		req := &request.ProductAttributes{Attributes: map[string]interface{}{"weight": float64(180)}, UpdatedBy: "arya"}
+
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(1)).Return(applicable, nil).Once()
+
+		_, err := s.uc.SetProductAttributes(context.Background(), 1, 0, req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.ErrorContains(err, "attribute weight does not apply")
+	})
+
+	s.Run("Wrong Types", func() {
+		for code, value := range map[string]interface{}{
+			"color":  "Blue",
+			"model":  float64(1),
+			"nfc":    "yes",
+			"ram_gb": "8",
+		} {
+			req := &request.ProductAttributes{Attributes: map[string]interface{}{code: value}, UpdatedBy: "arya"}
+			s.mockRepo.On("FetchByProduct", mock.Anything, int64(1)).Return(applicable, nil).Once()
+
+			_, err := s.uc.SetProductAttributes(context.Background(), 1, 0, req)
+
+			s.ErrorIs(err, constant.ErrValidation, code)
+			s.ErrorContains(err, "attributes."+code)
+		}
+	})
+
+	s.Run("Product Not Found", func() {
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		_, err := s.uc.SetProductAttributes(context.Background(), 9, 0, &request.ProductAttributes{UpdatedBy: "arya"})
+
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+
+	s.Run("Repository Error", func() {
+		req := &request.ProductAttributes{Attributes: map[string]interface{}{"nfc": false}, UpdatedBy: "arya"}
+
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(1)).Return(applicable, nil).Once()
+		s.mockRepo.On("SetProductValues", mock.Anything, int64(1), int64(0), mock.Anything, "arya", mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		_, err := s.uc.SetProductAttributes(context.Background(), 1, 0, req)
+
+		s.EqualError(err, "db error")
+	})
+}
+
+func TestAttributeUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(AttributeUsecaseTestSuite))
+}
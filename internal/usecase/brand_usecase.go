@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/internal/repository"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/utils"
+)
+
+type brandUsecase struct {
+	repo      interfaces.BrandRepository
+	redisRepo repository.RedisRepository
+	validator *utils.CustomValidator
+}
+
+func NewBrandUsecase(repo interfaces.BrandRepository, redisRepo repository.RedisRepository) interfaces.BrandUsecase {
+	return &brandUsecase{
+		repo:      repo,
+		redisRepo: redisRepo,
+		validator: utils.NewValidator(),
+	}
+}
+
+func (u *brandUsecase) CreateBrand(ctx context.Context, req *request.Brand) (*entity.Brand, error) {
+
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	brand := &entity.Brand{
+		Name:      req.Name,
+		CreatedAt: now,
+		CreatedBy: req.CreatedBy,
+		UpdatedAt: now,
+		UpdatedBy: req.CreatedBy,
+	}
+
+	if err := u.repo.Create(ctx, brand); err != nil {
+		return nil, err
+	}
+
+	return brand, nil
+}
+
+func (u *brandUsecase) GetBrand(ctx context.Context, id int64) (*entity.Brand, error) {
+	return u.repo.GetByID(ctx, id)
+}
+
+func (u *brandUsecase) ListBrands(ctx context.Context) ([]entity.Brand, error) {
+	brands, err := u.repo.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if brands == nil {
+		brands = []entity.Brand{}
+	}
+	return brands, nil
+}
+
+func (u *brandUsecase) UpdateBrand(ctx context.Context, id int64, req *request.BrandUpdate) (*entity.Brand, error) {
+
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	brand := &entity.Brand{
+		ID:        id,
+		Name:      req.Name,
+		UpdatedAt: time.Now(),
+		UpdatedBy: req.UpdatedBy,
+	}
+
+	if err := u.repo.Update(ctx, brand); err != nil {
+		return nil, err
+	}
+
+	// Cached brand facets carry the old name. Facet keys carry the encoded
+	// filter, where a brand facet request is "Facets=brand".
+	_ = u.redisRepo.Delete(ctx, fmt.Sprintf("%s:*Facets=%s*", constant.RedisKeyProductFacets, request.FacetBrand))
+
+	return brand, nil
+}
+
+// DeleteBrand removes a brand. A brand still referred to by products cannot
+// be deleted, so no cached listing can contain it.
+func (u *brandUsecase) DeleteBrand(ctx context.Context, id int64) error {
+	return u.repo.Delete(ctx, id)
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"testing"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BrandUsecaseTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.BrandRepository
+	mockRedisRepo *mocks.RedisRepository
+	uc            interfaces.BrandUsecase
+}
+
+func (s *BrandUsecaseTestSuite) SetupTest() {
+	s.mockRepo = new(mocks.BrandRepository)
+	s.mockRedisRepo = new(mocks.RedisRepository)
+	s.uc = NewBrandUsecase(s.mockRepo, s.mockRedisRepo)
+}
+
+var brandFacetPattern = fmt.Sprintf("%s:*Facets=brand*", constant.RedisKeyProductFacets)
+
+func (s *BrandUsecaseTestSuite) TestFacetPatternMatchesFacetKey() {
+	values, _ := query.Values(request.ProductFilter{Facets: []string{request.FacetBrand, request.FacetPrice}})
+	key := fmt.Sprintf("%s:%s", constant.RedisKeyProductFacets, values.Encode())
+
+	matched, err := path.Match(brandFacetPattern, key)
+	s.NoError(err)
+	s.True(matched, key)
+
+	values, _ = query.Values(request.ProductFilter{Facets: []string{request.FacetPrice}})
+	matched, _ = path.Match(brandFacetPattern, fmt.Sprintf("%s:%s", constant.RedisKeyProductFacets, values.Encode()))
+	s.False(matched)
+}
+
+func (s *BrandUsecaseTestSuite) TestCreateBrand() {
+
+	s.Run("Success", func() {
+		req := &request.Brand{Name: "Samsung", CreatedBy: "arya"}
+
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(b *entity.Brand) bool {
+			return b.Name == "Samsung" && b.CreatedBy == "arya" && b.UpdatedBy == "arya"
+		})).Return(nil).Once()
+
+		brand, err := s.uc.CreateBrand(context.Background(), req)
+
+		s.NoError(err)
+		s.Equal("Samsung", brand.Name)
+	})
+
+	s.Run("Validation Error", func() {
+		brand, err := s.uc.CreateBrand(context.Background(), &request.Brand{CreatedBy: "arya"})
+
+		s.Error(err)
+		s.Nil(brand)
+	})
+
+	s.Run("Duplicate Name", func() {
+		s.mockRepo.On("Create", mock.Anything, mock.Anything).Return(constant.ErrConflict).Once()
+
+		brand, err := s.uc.CreateBrand(context.Background(), &request.Brand{Name: "samsung", CreatedBy: "arya"})
+
+		s.ErrorIs(err, constant.ErrConflict)
+		s.Nil(brand)
+	})
+}
+
+func (s *BrandUsecaseTestSuite) TestListBrands() {
+
+	s.Run("Success", func() {
+		s.mockRepo.On("Fetch", mock.Anything).Return([]entity.Brand{{ID: 2, Name: "Apple"}, {ID: 1, Name: "Samsung"}}, nil).Once()
+
+		brands, err := s.uc.ListBrands(context.Background())
+
+		s.NoError(err)
+		s.Len(brands, 2)
+	})
+
+	s.Run("Empty", func() {
+		s.mockRepo.On("Fetch", mock.Anything).Return(nil, nil).Once()
+
+		brands, err := s.uc.ListBrands(context.Background())
+
+		s.NoError(err)
+		s.NotNil(brands)
+		s.Empty(brands)
+	})
+
+	s.Run("Error", func() {
+		s.mockRepo.On("Fetch", mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		brands, err := s.uc.ListBrands(context.Background())
+
+		s.Error(err)
+		s.Nil(brands)
+	})
+}
+
+func (s *BrandUsecaseTestSuite) TestUpdateBrand() {
+
+	s.Run("Success Invalidates Facets", func() {
+		req := &request.BrandUpdate{Name: "Samsung Electronics", UpdatedBy: "arya"}
+
+		s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(b *entity.Brand) bool {
+			return b.ID == 1 && b.Name == "Samsung Electronics" && b.UpdatedBy == "arya"
+		})).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, brandFacetPattern).Return(nil).Once()
+
+		brand, err := s.uc.UpdateBrand(context.Background(), 1, req)
+
+		s.NoError(err)
+		s.Equal("Samsung Electronics", brand.Name)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Not Found", func() {
+		s.mockRepo.On("Update", mock.Anything, mock.Anything).Return(constant.ErrNotFound).Once()
+
+		brand, err := s.uc.UpdateBrand(context.Background(), 9, &request.BrandUpdate{Name: "Sony", UpdatedBy: "arya"})
+
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(brand)
+	})
+
+	s.Run("Validation Error", func() {
+		brand, err := s.uc.UpdateBrand(context.Background(), 1, &request.BrandUpdate{Name: "Sony"})
+
+		s.Error(err)
+		s.Nil(brand)
+	})
+}
+
+func (s *BrandUsecaseTestSuite) TestDeleteBrand() {
+
+	s.Run("Success", func() {
+		s.mockRepo.On("Delete", mock.Anything, int64(1)).Return(nil).Once()
+
+		err := s.uc.DeleteBrand(context.Background(), 1)
+
+		s.NoError(err)
+	})
+
+	s.Run("Still Used", func() {
+		s.mockRepo.On("Delete", mock.Anything, int64(2)).Return(constant.ErrConflict).Once()
+
+		err := s.uc.DeleteBrand(context.Background(), 2)
+
+		s.ErrorIs(err, constant.ErrConflict)
+	})
+}
+
+func TestBrandUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(BrandUsecaseTestSuite))
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/internal/repository"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/utils"
+)
+
+type categoryUsecase struct {
+	repo      interfaces.CategoryRepository
+	redisRepo repository.RedisRepository
+	validator *utils.CustomValidator
+}
+
+func NewCategoryUsecase(repo interfaces.CategoryRepository, redisRepo repository.RedisRepository) interfaces.CategoryUsecase {
+	return &categoryUsecase{
+		repo:      repo,
+		redisRepo: redisRepo,
+		validator: utils.NewValidator(),
+	}
+}
+
+func (u *categoryUsecase) CreateCategory(ctx context.Context, req *request.Category) (*entity.Category, error) {
+
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	category := &entity.Category{
+		ParentID:  req.ParentID,
+		Name:      req.Name,
+		CreatedAt: now,
+		CreatedBy: req.CreatedBy,
+		UpdatedAt: now,
+		UpdatedBy: req.CreatedBy,
+	}
+
+	if err := u.repo.Create(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (u *categoryUsecase) GetCategory(ctx context.Context, id int64) (*entity.Category, error) {
+	return u.repo.GetByID(ctx, id)
+}
+
+// ListCategories returns the root categories with their subcategories nested
+// in Children.
+func (u *categoryUsecase) ListCategories(ctx context.Context) ([]entity.Category, error) {
+	categories, err := u.repo.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make(map[int64][]entity.Category)
+	roots := []entity.Category{}
+	for _, category := range categories {
+		if category.ParentID == nil {
+			roots = append(roots, category)
+		} else {
+			children[*category.ParentID] = append(children[*category.ParentID], category)
+		}
+	}
+
+	var nest func(nodes []entity.Category) []entity.Category
+	nest = func(nodes []entity.Category) []entity.Category {
+		for i := range nodes {
+			nodes[i].Children = nest(children[nodes[i].ID])
+		}
+		return nodes
+	}
+
+	return nest(roots), nil
+}
+
+func (u *categoryUsecase) UpdateCategory(ctx context.Context, id int64, req *request.CategoryUpdate) (*entity.Category, error) {
+
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	current, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	category := &entity.Category{
+		ID:        id,
+		ParentID:  req.ParentID,
+		Name:      req.Name,
+		UpdatedAt: time.Now(),
+		UpdatedBy: req.UpdatedBy,
+	}
+
+	if err := u.repo.Update(ctx, category); err != nil {
+		return nil, err
+	}
+
+	// A move changes which products the old and the new ancestors contain.
+	if category.Path != current.Path {
+		u.invalidateCategoryLists(ctx, *current, *category)
+	} else if category.Name != current.Name {
+		u.invalidateCategoryFacets(ctx)
+	}
+
+	return category, nil
+}
+
+func (u *categoryUsecase) DeleteCategory(ctx context.Context, id int64) error {
+
+	current, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	u.invalidateCategoryLists(ctx, *current)
+
+	return nil
+}
+
+func (u *categoryUsecase) GetProductCategories(ctx context.Context, productID int64) ([]entity.Category, error) {
+	return u.repo.GetByProduct(ctx, productID)
+}
+
+func (u *categoryUsecase) SetProductCategories(ctx context.Context, productID int64, req *request.ProductCategories) ([]entity.Category, error) {
+
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	previous, err := u.repo.GetByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.SetProductCategories(ctx, productID, req.CategoryIDs, req.UpdatedBy); err != nil {
+		return nil, err
+	}
+
+	categories, err := u.repo.GetByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	u.invalidateCategoryLists(ctx, append(previous, categories...)...)
+
+	return categories, nil
+}
+
+// invalidateCategoryLists drops the cached listings and facet counts filtered
+// by any of the categories or their ancestors, whose contents include the
+// categories. Both keys carry the encoded filter, where the category is
+// "Category=<id>".
+func (u *categoryUsecase) invalidateCategoryLists(ctx context.Context, categories ...entity.Category) {
+	seen := make(map[int64]bool)
+	for _, category := range categories {
+		for _, id := range category.PathIDs() {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			_ = u.redisRepo.Delete(ctx, fmt.Sprintf("%s:*Category=%d&*", constant.RedisKeyProductList, id))
+			_ = u.redisRepo.Delete(ctx, fmt.Sprintf("%s:*Category=%d&*", constant.RedisKeyProductFacets, id))
+		}
+	}
+	if len(seen) > 0 {
+		u.invalidateCategoryFacets(ctx)
+	}
+}
+
+// invalidateCategoryFacets drops the cached facet counts that include the
+// category facet, whose names and counts follow the whole taxonomy. Facet keys
+// carry the encoded filter, where a category facet request is
+// "Facets=category".
+func (u *categoryUsecase) invalidateCategoryFacets(ctx context.Context) {
+	_ = u.redisRepo.Delete(ctx, fmt.Sprintf("%s:*Facets=%s*", constant.RedisKeyProductFacets, request.FacetCategory))
+}
@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"testing"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CategoryUsecaseTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.CategoryRepository
+	mockRedisRepo *mocks.RedisRepository
+	uc            interfaces.CategoryUsecase
+}
+
+func (s *CategoryUsecaseTestSuite) SetupTest() {
+	s.mockRepo = new(mocks.CategoryRepository)
+	s.mockRedisRepo = new(mocks.RedisRepository)
+	s.uc = NewCategoryUsecase(s.mockRepo, s.mockRedisRepo)
+}
+
+func listPattern(id int64) string {
+	return fmt.Sprintf("%s:*Category=%d&*", constant.RedisKeyProductList, id)
+}
+
+func facetPattern(id int64) string {
+	return fmt.Sprintf("%s:*Category=%d&*", constant.RedisKeyProductFacets, id)
+}
+
+var categoryFacetPattern = fmt.Sprintf("%s:*Facets=category*", constant.RedisKeyProductFacets)
+
+func (s *CategoryUsecaseTestSuite) TestListPatternMatchesListingKey() {
+	category := int64(4)
+	values, _ := query.Values(request.ProductFilter{Page: 1, Limit: 10, Category: &category})
+	key := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, values.Encode())
+
+	matched, err := path.Match(listPattern(4), key)
+	s.NoError(err)
+	s.True(matched, key)
+
+	matched, _ = path.Match(listPattern(40), key)
+	s.False(matched)
+}
+
+func (s *CategoryUsecaseTestSuite) TestFacetPatternMatchesFilteredFacetKey() {
+	category := int64(4)
+	values, _ := query.Values(request.ProductFilter{Category: &category, Facets: []string{request.FacetBrand, request.FacetPrice}})
+	key := fmt.Sprintf("%s:%s", constant.RedisKeyProductFacets, values.Encode())
+
+	matched, err := path.Match(facetPattern(4), key)
+	s.NoError(err)
+	s.True(matched, key)
+
+	matched, _ = path.Match(facetPattern(40), key)
+	s.False(matched)
+
+	matched, _ = path.Match(categoryFacetPattern, key)
+	s.False(matched, "Only the per category pattern reaches facets that do not count categories")
+}
+
+func (s *CategoryUsecaseTestSuite) TestFacetPatternMatchesFacetKey() {
+	values, _ := query.Values(request.ProductFilter{Facets: []string{request.FacetBrand, request.FacetCategory}})
+	key := fmt.Sprintf("%s:%s", constant.RedisKeyProductFacets, values.Encode())
+
+	matched, err := path.Match(categoryFacetPattern, key)
+	s.NoError(err)
+	s.True(matched, key)
+}
+
+func (s *CategoryUsecaseTestSuite) TestCreateCategory() {
+
+	s.Run("Success", func() {
+		parentID := int64(1)
+		req := &request.Category{Name: "TV", ParentID: &parentID, CreatedBy: "arya"}
+
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(c *entity.Category) bool {
+			return c.Name == "TV" && *c.ParentID == 1 && c.UpdatedBy == "arya"
+		})).Return(nil).Once()
+
+		category, err := s.uc.CreateCategory(context.Background(), req)
+
+		s.NoError(err)
+		s.Equal("TV", category.Name)
+	})
+
+	s.Run("Validation Error", func() {
+		category, err := s.uc.CreateCategory(context.Background(), &request.Category{Name: "TV"})
+
+		s.Error(err)
+		s.Nil(category)
+	})
+}
+
+func (s *CategoryUsecaseTestSuite) TestListCategories() {
+	one, four := int64(1), int64(4)
+	s.mockRepo.On("Fetch", mock.Anything).Return([]entity.Category{
+		{ID: 1, Name: "Electronics", Path: "/1/"},
+		{ID: 2, Name: "Fashion", Path: "/2/"},
+		{ID: 4, ParentID: &one, Name: "TV", Path: "/1/4/", Depth: 1},
+		{ID: 7, ParentID: &four, Name: "OLED", Path: "/1/4/7/", Depth: 2},
+	}, nil).Once()
+
+	tree, err := s.uc.ListCategories(context.Background())
+
+	s.NoError(err)
+	s.Len(tree, 2)
+	s.Equal("TV", tree[0].Children[0].Name)
+	s.Equal("OLED", tree[0].Children[0].Children[0].Name)
+	s.Empty(tree[1].Children)
+}
+
+func (s *CategoryUsecaseTestSuite) TestUpdateCategory() {
+	one, two := int64(1), int64(2)
+	current := &entity.Category{ID: 4, ParentID: &one, Name: "TV", Path: "/1/4/", Depth: 1}
+
+	s.Run("Move Invalidates Old And New Ancestors", func() {
+		s.mockRepo.On("GetByID", mock.Anything, int64(4)).Return(current, nil).Once()
+		s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(c *entity.Category) bool {
+			return c.ID == 4 && *c.ParentID == 2
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.Category).Path = "/2/4/"
+		}).Return(nil).Once()
+
+		for _, id := range []int64{1, 4, 2} {
+			s.mockRedisRepo.On("Delete", mock.Anything, listPattern(id)).Return(nil).Once()
+			s.mockRedisRepo.On("Delete", mock.Anything, facetPattern(id)).Return(nil).Once()
+		}
+		s.mockRedisRepo.On("Delete", mock.Anything, categoryFacetPattern).Return(nil).Once()
+
+		category, err := s.uc.UpdateCategory(context.Background(), 4, &request.CategoryUpdate{Name: "TV", ParentID: &two, UpdatedBy: "arya"})
+
+		s.NoError(err)
+		s.Equal("/2/4/", category.Path)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Rename Invalidates Only Facets", func() {
+		s.mockRepo.On("GetByID", mock.Anything, int64(4)).Return(current, nil).Once()
+		s.mockRepo.On("Update", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.Category).Path = "/1/4/"
+		}).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, categoryFacetPattern).Return(nil).Once()
+
+		category, err := s.uc.UpdateCategory(context.Background(), 4, &request.CategoryUpdate{Name: "Television", ParentID: &one, UpdatedBy: "arya"})
+
+		s.NoError(err)
+		s.Equal("Television", category.Name)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Unchanged Keeps Cache", func() {
+		s.mockRepo.On("GetByID", mock.Anything, int64(4)).Return(current, nil).Once()
+		s.mockRepo.On("Update", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.Category).Path = "/1/4/"
+		}).Return(nil).Once()
+
+		_, err := s.uc.UpdateCategory(context.Background(), 4, &request.CategoryUpdate{Name: "TV", ParentID: &one, UpdatedBy: "arya"})
+
+		s.NoError(err)
+	})
+
+	s.Run("Not Found", func() {
+		s.mockRepo.On("GetByID", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		_, err := s.uc.UpdateCategory(context.Background(), 9, &request.CategoryUpdate{Name: "TV", UpdatedBy: "arya"})
+
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *CategoryUsecaseTestSuite) TestDeleteCategory() {
+	one := int64(1)
+
+	s.Run("Success", func() {
+		s.mockRepo.On("GetByID", mock.Anything, int64(4)).Return(&entity.Category{ID: 4, ParentID: &one, Path: "/1/4/"}, nil).Once()
+		s.mockRepo.On("Delete", mock.Anything, int64(4)).Return(nil).Once()
+		for _, id := range []int64{1, 4} {
+			s.mockRedisRepo.On("Delete", mock.Anything, listPattern(id)).Return(nil).Once()
+			s.mockRedisRepo.On("Delete", mock.Anything, facetPattern(id)).Return(nil).Once()
+		}
+		s.mockRedisRepo.On("Delete", mock.Anything, categoryFacetPattern).Return(nil).Once()
+
+		err := s.uc.DeleteCategory(context.Background(), 4)
+
+		s.NoError(err)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Has Subcategories", func() {
+		s.mockRepo.On("GetByID", mock.Anything, int64(1)).Return(&entity.Category{ID: 1, Path: "/1/"}, nil).Once()
+		s.mockRepo.On("Delete", mock.Anything, int64(1)).Return(fmt.Errorf("%w: the category still has subcategories", constant.ErrConflict)).Once()
+
+		err := s.uc.DeleteCategory(context.Background(), 1)
+
+		s.ErrorIs(err, constant.ErrConflict)
+	})
+}
+
+func (s *CategoryUsecaseTestSuite) TestSetProductCategories() {
+
+	s.Run("Success", func() {
+		previous := []entity.Category{{ID: 4, Path: "/1/4/"}}
+		updated := []entity.Category{{ID: 5, Path: "/1/5/"}, {ID: 8, Path: "/2/8/"}}
+
+		s.mockRepo.On("GetByProduct", mock.Anything, int64(10)).Return(previous, nil).Once()
+		s.mockRepo.On("SetProductCategories", mock.Anything, int64(10), []int64{5, 8}, "arya").Return(nil).Once()
+		s.mockRepo.On("GetByProduct", mock.Anything, int64(10)).Return(updated, nil).Once()
+		for _, id := range []int64{1, 4, 5, 2, 8} {
+			s.mockRedisRepo.On("Delete", mock.Anything, listPattern(id)).Return(nil).Once()
+			s.mockRedisRepo.On("Delete", mock.Anything, facetPattern(id)).Return(nil).Once()
+		}
+		s.mockRedisRepo.On("Delete", mock.Anything, categoryFacetPattern).Return(nil).Once()
+
+		categories, err := s.uc.SetProductCategories(context.Background(), 10, &request.ProductCategories{CategoryIDs: []int64{5, 8}, UpdatedBy: "arya"})
+
+		s.NoError(err)
+		s.Equal(updated, categories)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Repository Error", func() {
+		s.mockRepo.On("GetByProduct", mock.Anything, int64(10)).Return([]entity.Category{}, nil).Once()
+		s.mockRepo.On("SetProductCategories", mock.Anything, int64(10), []int64{9}, "arya").Return(errors.New("db error")).Once()
+
+		categories, err := s.uc.SetProductCategories(context.Background(), 10, &request.ProductCategories{CategoryIDs: []int64{9}, UpdatedBy: "arya"})
+
+		s.Error(err)
+		s.Nil(categories)
+	})
+
+	s.Run("Validation Error", func() {
+		categories, err := s.uc.SetProductCategories(context.Background(), 10, &request.ProductCategories{CategoryIDs: []int64{0}, UpdatedBy: "arya"})
+
+		s.Error(err)
+		s.Nil(categories)
+	})
+}
+
+func TestCategoryUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(CategoryUsecaseTestSuite))
+}
@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/internal/repository"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/utils"
+)
+
+type exchangeRateUsecase struct {
+	repo      interfaces.ExchangeRateRepository
+	redisRepo repository.RedisRepository
+	validator *utils.CustomValidator
+	vat       entity.VATRule
+}
+
+func NewExchangeRateUsecase(repo interfaces.ExchangeRateRepository, redisRepo repository.RedisRepository, vat entity.VATRule) interfaces.ExchangeRateUsecase {
+	return &exchangeRateUsecase{
+		repo:      repo,
+		redisRepo: redisRepo,
+		validator: utils.NewValidator(),
+		vat:       vat,
+	}
+}
+
+func (u *exchangeRateUsecase) ListRates(ctx context.Context) ([]entity.ExchangeRate, error) {
+	rates, err := u.repo.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rates == nil {
+		rates = []entity.ExchangeRate{}
+	}
+	return rates, nil
+}
+
+// LoadRates stores the given rates. The base currency keeps its rate of 1 and
+// no minor unit, every other rate is quoted against it. The minor units of a
+// known currency cannot change, since the prices stored in it are counted in
+// them.
+func (u *exchangeRateUsecase) LoadRates(ctx context.Context, req *request.ExchangeRateLoad) ([]entity.ExchangeRate, error) {
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	current, err := u.repo.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	minorUnits := make(map[string]int, len(current))
+	for _, rate := range current {
+		minorUnits[rate.Currency] = rate.MinorUnits
+	}
+
+	now := time.Now()
+	seen := make(map[string]bool, len(req.Rates))
+	rates := make([]entity.ExchangeRate, 0, len(req.Rates))
+	for _, item := range req.Rates {
+		if item.Currency == constant.BaseCurrency && item.Rate != 1 {
+			return nil, fmt.Errorf("%w: %s is the base currency, its rate is 1", constant.ErrValidation, constant.BaseCurrency)
+		}
+		if item.Currency == constant.BaseCurrency && *item.MinorUnits != 0 {
+			return nil, fmt.Errorf("%w: %s has no minor unit, its minor_units is 0", constant.ErrValidation, constant.BaseCurrency)
+		}
+		if units, ok := minorUnits[item.Currency]; ok && units != *item.MinorUnits {
+			return nil, fmt.Errorf("%w: currency %s has %d minor units, which cannot change", constant.ErrValidation, item.Currency, units)
+		}
+		if seen[item.Currency] {
+			return nil, fmt.Errorf("%w: currency %s is listed twice", constant.ErrValidation, item.Currency)
+		}
+		seen[item.Currency] = true
+
+		rates = append(rates, entity.ExchangeRate{
+			Currency:   item.Currency,
+			Rate:       item.Rate,
+			MinorUnits: *item.MinorUnits,
+			UpdatedAt:  now,
+			UpdatedBy:  req.UpdatedBy,
+		})
+	}
+
+	if err := u.repo.Upsert(ctx, rates); err != nil {
+		return nil, err
+	}
+
+	// Prices are converted on every read, but a new rate moves the base
+	// prices listings filter, sort and count by, so cached listings and facet
+	// counts go with the cached rates. Cached details hold no converted amount.
+	_ = u.redisRepo.Delete(ctx, constant.RedisKeyExchangeRates)
+	_ = u.redisRepo.Delete(ctx, constant.RedisKeyProductList+"*")
+	_ = u.redisRepo.Delete(ctx, constant.RedisKeyProductFacets+"*")
+
+	return rates, nil
+}
+
+// PriceProducts sets the pricing of the products and their variants: the
+// price in currency, or in the currency it is stored in when currency is
+// empty, split into net, tax and gross by the VAT rule.
+func (u *exchangeRateUsecase) PriceProducts(ctx context.Context, currency string, products []*entity.Product) error {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+
+	var rates map[string]entity.ExchangeRate
+	if currency != "" {
+		var err error
+		rates, err = u.rates(ctx)
+		if err != nil {
+			return err
+		}
+		if _, ok := rates[currency]; !ok {
+			return fmt.Errorf("%w: currency %s has no exchange rate", constant.ErrValidation, currency)
+		}
+	}
+
+	for _, product := range products {
+		pricing, err := u.breakdown(product.Price, product.Currency, currency, rates)
+		if err != nil {
+			return err
+		}
+		product.Pricing = pricing
+
+		for i := range product.Variants {
+			pricing, err := u.breakdown(product.Variants[i].Price, product.Currency, currency, rates)
+			if err != nil {
+				return err
+			}
+			product.Variants[i].Pricing = pricing
+		}
+	}
+	return nil
+}
+
+// breakdown converts a price stored in from into to and splits it by the VAT
+// rule. A product without a price has no pricing.
+func (u *exchangeRateUsecase) breakdown(price *int64, from string, to string, rates map[string]entity.ExchangeRate) (*entity.PriceBreakdown, error) {
+	if price == nil {
+		return nil, nil
+	}
+	if from == "" {
+		from = constant.BaseCurrency
+	}
+
+	pricing := &entity.PriceBreakdown{Currency: from, VATRate: u.vat.Rate}
+	amount := *price
+	if to != "" && to != from {
+		source, ok := rates[from]
+		if !ok {
+			return nil, fmt.Errorf("currency %s has no exchange rate", from)
+		}
+		target := rates[to]
+
+		rate := source.Rate / target.Rate
+		amount = convertAmount(amount, source, target)
+		pricing.Currency = to
+		pricing.ExchangeRate = &rate
+	}
+
+	if u.vat.Inclusive {
+		pricing.Gross = amount
+		pricing.Net = int64(math.Round(float64(amount) * 100 / (100 + u.vat.Rate)))
+		pricing.Tax = pricing.Gross - pricing.Net
+	} else {
+		pricing.Net = amount
+		pricing.Tax = int64(math.Round(float64(amount) * u.vat.Rate / 100))
+		pricing.Gross = pricing.Net + pricing.Tax
+	}
+	return pricing, nil
+}
+
+// convertAmount converts an amount in the minor unit of source into the minor
+// unit of target, rounded half away from zero.
+func convertAmount(amount int64, source entity.ExchangeRate, target entity.ExchangeRate) int64 {
+	units := float64(amount) / math.Pow10(source.MinorUnits) * source.Rate / target.Rate
+	return int64(math.Round(units * math.Pow10(target.MinorUnits)))
+}
+
+// rates returns the exchange rates by currency, cached like the product
+// reads.
+func (u *exchangeRateUsecase) rates(ctx context.Context) (map[string]entity.ExchangeRate, error) {
+	var list []entity.ExchangeRate
+
+	val, err := u.redisRepo.Get(ctx, constant.RedisKeyExchangeRates)
+	if err != nil || json.Unmarshal([]byte(val), &list) != nil {
+		list, err = u.repo.Fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		data, _ := json.Marshal(list)
+		_ = u.redisRepo.Set(ctx, constant.RedisKeyExchangeRates, data, 5*time.Minute)
+	}
+
+	rates := make(map[string]entity.ExchangeRate, len(list))
+	for _, rate := range list {
+		rates[rate.Currency] = rate
+	}
+	return rates, nil
+}
@@ -0,0 +1,269 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExchangeRateUsecaseTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.ExchangeRateRepository
+	mockRedisRepo *mocks.RedisRepository
+	uc            interfaces.ExchangeRateUsecase
+}
+
+var testRates = []entity.ExchangeRate{
+	{Currency: "IDR", Rate: 1, MinorUnits: 0},
+	{Currency: "SGD", Rate: 12000, MinorUnits: 2},
+	{Currency: "USD", Rate: 16000, MinorUnits: 2},
+}
+
+func (s *ExchangeRateUsecaseTestSuite) SetupTest() {
+	s.mockRepo = new(mocks.ExchangeRateRepository)
+	s.mockRedisRepo = new(mocks.RedisRepository)
+	s.uc = NewExchangeRateUsecase(s.mockRepo, s.mockRedisRepo, entity.VATRule{Rate: 11, Inclusive: true})
+}
+
+func (s *ExchangeRateUsecaseTestSuite) TestListRates() {
+
+	s.Run("Success", func() {
+		s.mockRepo.On("Fetch", mock.Anything).Return(testRates, nil).Once()
+
+		rates, err := s.uc.ListRates(context.Background())
+
+		s.NoError(err)
+		s.Len(rates, 3)
+	})
+
+	s.Run("Empty", func() {
+		s.mockRepo.On("Fetch", mock.Anything).Return(nil, nil).Once()
+
+		rates, err := s.uc.ListRates(context.Background())
+
+		s.NoError(err)
+		s.NotNil(rates)
+		s.Empty(rates)
+	})
+}
+
+func (s *ExchangeRateUsecaseTestSuite) TestLoadRates() {
+	two := 2
+	zero := 0
+	current := []entity.ExchangeRate{{Currency: "IDR", Rate: 1, MinorUnits: 0}, {Currency: "USD", Rate: 16000, MinorUnits: 2}}
+
+	s.Run("Success", func() {
+		req := &request.ExchangeRateLoad{
+			Rates:     []request.ExchangeRate{{Currency: "USD", Rate: 16250, MinorUnits: &two}},
+			UpdatedBy: "arya",
+		}
+
+		s.mockRepo.On("Fetch", mock.Anything).Return(current, nil).Once()
+		s.mockRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(rates []entity.ExchangeRate) bool {
+			return len(rates) == 1 && rates[0].Currency == "USD" && rates[0].Rate == 16250 && rates[0].MinorUnits == 2 && rates[0].UpdatedBy == "arya"
+		})).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, constant.RedisKeyExchangeRates).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+
+		rates, err := s.uc.LoadRates(context.Background(), req)
+
+		s.NoError(err)
+		s.Len(rates, 1)
+	})
+
+	s.Run("Base Rate Changed", func() {
+		req := &request.ExchangeRateLoad{
+			Rates:     []request.ExchangeRate{{Currency: "IDR", Rate: 2, MinorUnits: &zero}},
+			UpdatedBy: "arya",
+		}
+
+		s.mockRepo.On("Fetch", mock.Anything).Return(current, nil).Once()
+
+		_, err := s.uc.LoadRates(context.Background(), req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+
+	s.Run("Base Minor Units Changed", func() {
+		req := &request.ExchangeRateLoad{
+			Rates:     []request.ExchangeRate{{Currency: "IDR", Rate: 1, MinorUnits: &two}},
+			UpdatedBy: "arya",
+		}
+
+		s.mockRepo.On("Fetch", mock.Anything).Return([]entity.ExchangeRate{}, nil).Once()
+
+		_, err := s.uc.LoadRates(context.Background(), req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.ErrorContains(err, "IDR has no minor unit")
+	})
+
+	s.Run("Known Minor Units Changed", func() {
+		req := &request.ExchangeRateLoad{
+			Rates:     []request.ExchangeRate{{Currency: "USD", Rate: 16250, MinorUnits: &zero}},
+			UpdatedBy: "arya",
+		}
+
+		s.mockRepo.On("Fetch", mock.Anything).Return(current, nil).Once()
+
+		_, err := s.uc.LoadRates(context.Background(), req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.ErrorContains(err, "currency USD has 2 minor units, which cannot change")
+	})
+
+	s.Run("New Currency Sets Minor Units", func() {
+		req := &request.ExchangeRateLoad{
+			Rates:     []request.ExchangeRate{{Currency: "JPY", Rate: 105, MinorUnits: &zero}},
+			UpdatedBy: "arya",
+		}
+
+		s.mockRepo.On("Fetch", mock.Anything).Return(current, nil).Once()
+		s.mockRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(rates []entity.ExchangeRate) bool {
+			return len(rates) == 1 && rates[0].Currency == "JPY" && rates[0].MinorUnits == 0
+		})).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, constant.RedisKeyExchangeRates).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+
+		_, err := s.uc.LoadRates(context.Background(), req)
+
+		s.NoError(err)
+	})
+
+	s.Run("Duplicate Currency", func() {
+		req := &request.ExchangeRateLoad{
+			Rates: []request.ExchangeRate{
+				{Currency: "USD", Rate: 16250, MinorUnits: &two},
+				{Currency: "USD", Rate: 16300, MinorUnits: &two},
+			},
+			UpdatedBy: "arya",
+		}
+
+		s.mockRepo.On("Fetch", mock.Anything).Return(current, nil).Once()
+
+		_, err := s.uc.LoadRates(context.Background(), req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+
+	s.Run("Validation Error", func() {
+		req := &request.ExchangeRateLoad{
+			Rates:     []request.ExchangeRate{{Currency: "usd", Rate: 0, MinorUnits: &two}},
+			UpdatedBy: "arya",
+		}
+
+		_, err := s.uc.LoadRates(context.Background(), req)
+
+		s.Error(err)
+	})
+
+	s.Run("Repository Error", func() {
+		req := &request.ExchangeRateLoad{
+			Rates:     []request.ExchangeRate{{Currency: "USD", Rate: 16250, MinorUnits: &two}},
+			UpdatedBy: "arya",
+		}
+
+		s.mockRepo.On("Fetch", mock.Anything).Return(current, nil).Once()
+		s.mockRepo.On("Upsert", mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+		_, err := s.uc.LoadRates(context.Background(), req)
+
+		s.EqualError(err, "db error")
+	})
+}
+
+func (s *ExchangeRateUsecaseTestSuite) TestPriceProducts() {
+	cached, _ := json.Marshal(testRates)
+
+	s.Run("Own Currency", func() {
+		price := int64(4800000)
+		variantPrice := int64(5000000)
+		product := &entity.Product{ID: 1, Price: &price, Currency: "IDR", Variants: []entity.ProductVariant{{ID: 7, Price: &variantPrice}}}
+
+		err := s.uc.PriceProducts(context.Background(), "", []*entity.Product{product})
+
+		s.NoError(err)
+		s.Equal(&entity.PriceBreakdown{Currency: "IDR", Net: 4324324, Tax: 475676, Gross: 4800000, VATRate: 11}, product.Pricing)
+		s.Equal(int64(5000000), product.Variants[0].Pricing.Gross)
+	})
+
+	s.Run("Converted From Cache", func() {
+		price := int64(4800000)
+		product := &entity.Product{ID: 1, Price: &price, Currency: "IDR"}
+
+		s.mockRedisRepo.On("Get", mock.Anything, constant.RedisKeyExchangeRates).Return(string(cached), nil).Once()
+
+		err := s.uc.PriceProducts(context.Background(), "usd", []*entity.Product{product})
+
+		s.NoError(err)
+		s.Equal("USD", product.Pricing.Currency)
+		s.Equal(int64(30000), product.Pricing.Gross, "4.800.000 IDR is 300.00 USD")
+		s.Equal(int64(27027), product.Pricing.Net)
+		s.Equal(int64(2973), product.Pricing.Tax)
+		s.InDelta(1.0/16000, *product.Pricing.ExchangeRate, 1e-12)
+		s.Equal(int64(4800000), *product.Price, "The stored price is left as is")
+	})
+
+	s.Run("Converted Between Foreign Currencies", func() {
+		price := int64(30000)
+		product := &entity.Product{ID: 1, Price: &price, Currency: "USD"}
+
+		s.mockRedisRepo.On("Get", mock.Anything, constant.RedisKeyExchangeRates).Return("", errors.New("redis: nil")).Once()
+		s.mockRepo.On("Fetch", mock.Anything).Return(testRates, nil).Once()
+		s.mockRedisRepo.On("Set", mock.Anything, constant.RedisKeyExchangeRates, mock.Anything, 5*time.Minute).Return(nil).Once()
+
+		err := s.uc.PriceProducts(context.Background(), "SGD", []*entity.Product{product})
+
+		s.NoError(err)
+		s.Equal(int64(40000), product.Pricing.Gross, "300.00 USD is 400.00 SGD")
+	})
+
+	s.Run("Unknown Currency", func() {
+		price := int64(4800000)
+		product := &entity.Product{ID: 1, Price: &price, Currency: "IDR"}
+
+		s.mockRedisRepo.On("Get", mock.Anything, constant.RedisKeyExchangeRates).Return(string(cached), nil).Once()
+
+		err := s.uc.PriceProducts(context.Background(), "XYZ", []*entity.Product{product})
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(product.Pricing)
+	})
+
+	s.Run("VAT Exclusive", func() {
+		uc := NewExchangeRateUsecase(s.mockRepo, s.mockRedisRepo, entity.VATRule{Rate: 11})
+		price := int64(1000000)
+		product := &entity.Product{ID: 1, Price: &price}
+
+		err := uc.PriceProducts(context.Background(), "", []*entity.Product{product})
+
+		s.NoError(err)
+		s.Equal(&entity.PriceBreakdown{Currency: "IDR", Net: 1000000, Tax: 110000, Gross: 1110000, VATRate: 11}, product.Pricing)
+	})
+
+	s.Run("No Price", func() {
+		product := &entity.Product{ID: 1}
+
+		err := s.uc.PriceProducts(context.Background(), "", []*entity.Product{product})
+
+		s.NoError(err)
+		s.Nil(product.Pricing)
+	})
+}
+
+func TestExchangeRateUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(ExchangeRateUsecaseTestSuite))
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/utils"
+)
+
+type locationUsecase struct {
+	repo      interfaces.LocationRepository
+	validator *utils.CustomValidator
+}
+
+func NewLocationUsecase(repo interfaces.LocationRepository) interfaces.LocationUsecase {
+	return &locationUsecase{
+		repo:      repo,
+		validator: utils.NewValidator(),
+	}
+}
+
+func (u *locationUsecase) CreateLocation(ctx context.Context, req *request.Location) (*entity.Location, error) {
+
+	req.Code = strings.TrimSpace(req.Code)
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	location := &entity.Location{
+		Code:      req.Code,
+		Name:      req.Name,
+		Type:      req.Type,
+		Address:   req.Address,
+		CreatedAt: now,
+		CreatedBy: req.CreatedBy,
+		UpdatedAt: now,
+		UpdatedBy: req.CreatedBy,
+	}
+
+	if err := u.repo.Create(ctx, location); err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+func (u *locationUsecase) GetLocation(ctx context.Context, id int64) (*entity.Location, error) {
+	return u.repo.GetByID(ctx, id)
+}
+
+func (u *locationUsecase) ListLocations(ctx context.Context) ([]entity.Location, error) {
+	locations, err := u.repo.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if locations == nil {
+		locations = []entity.Location{}
+	}
+	return locations, nil
+}
+
+// UpdateLocation replaces the details of a location. Cached listings only
+// carry location IDs, so none of them goes stale.
+func (u *locationUsecase) UpdateLocation(ctx context.Context, id int64, req *request.LocationUpdate) (*entity.Location, error) {
+
+	req.Code = strings.TrimSpace(req.Code)
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	location := &entity.Location{
+		ID:        id,
+		Code:      req.Code,
+		Name:      req.Name,
+		Type:      req.Type,
+		Address:   req.Address,
+		UpdatedAt: time.Now(),
+		UpdatedBy: req.UpdatedBy,
+	}
+
+	if err := u.repo.Update(ctx, location); err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+// DeleteLocation removes a location that never kept stock.
+func (u *locationUsecase) DeleteLocation(ctx context.Context, id int64) error {
+	return u.repo.Delete(ctx, id)
+}
+
+// ListProductStocks returns the stock of a product per location. Their sum is
+// the quantity of the product.
+func (u *locationUsecase) ListProductStocks(ctx context.Context, productID int64) ([]entity.ProductStock, error) {
+	stocks, err := u.repo.FetchStocks(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if stocks == nil {
+		stocks = []entity.ProductStock{}
+	}
+	return stocks, nil
+}
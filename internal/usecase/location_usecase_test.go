@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocationUsecaseTestSuite struct {
+	suite.Suite
+	mockRepo *mocks.LocationRepository
+	uc       interfaces.LocationUsecase
+}
+
+func (s *LocationUsecaseTestSuite) SetupTest() {
+	s.mockRepo = new(mocks.LocationRepository)
+	s.uc = NewLocationUsecase(s.mockRepo)
+}
+
+func (s *LocationUsecaseTestSuite) TestCreateLocation() {
+
+	s.Run("Success", func() {
+		req := &request.Location{Code: " JKT-01 ", Name: "Jakarta store", Type: entity.LocationStore, CreatedBy: "arya"}
+
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *entity.Location) bool {
+			return l.Code == "JKT-01" && l.Type == entity.LocationStore && l.CreatedBy == "arya" && l.UpdatedBy == "arya"
+		})).Return(nil).Once()
+
+		location, err := s.uc.CreateLocation(context.Background(), req)
+
+		s.NoError(err)
+		s.Equal("Jakarta store", location.Name)
+	})
+
+	s.Run("Validation Error", func() {
+		location, err := s.uc.CreateLocation(context.Background(), &request.Location{Code: "JKT-01", Name: "Jakarta", Type: "kiosk", CreatedBy: "arya"})
+
+		s.Error(err)
+		s.Nil(location)
+	})
+
+	s.Run("Duplicate Code", func() {
+		s.mockRepo.On("Create", mock.Anything, mock.Anything).Return(constant.ErrConflict).Once()
+
+		location, err := s.uc.CreateLocation(context.Background(), &request.Location{Code: "jkt-01", Name: "Jakarta", Type: entity.LocationStore, CreatedBy: "arya"})
+
+		s.ErrorIs(err, constant.ErrConflict)
+		s.Nil(location)
+	})
+}
+
+func (s *LocationUsecaseTestSuite) TestListLocations() {
+
+	s.Run("Success", func() {
+		s.mockRepo.On("Fetch", mock.Anything).Return([]entity.Location{{ID: 2, Code: "JKT-01"}, {ID: 1, Code: "MAIN"}}, nil).Once()
+
+		locations, err := s.uc.ListLocations(context.Background())
+
+		s.NoError(err)
+		s.Len(locations, 2)
+	})
+
+	s.Run("Error", func() {
+		s.mockRepo.On("Fetch", mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		locations, err := s.uc.ListLocations(context.Background())
+
+		s.Error(err)
+		s.Nil(locations)
+	})
+}
+
+func (s *LocationUsecaseTestSuite) TestUpdateLocation() {
+
+	s.Run("Success", func() {
+		req := &request.LocationUpdate{Code: "JKT-01", Name: "Jakarta flagship", Type: entity.LocationStore, UpdatedBy: "arya"}
+
+		s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(l *entity.Location) bool {
+			return l.ID == 2 && l.Name == "Jakarta flagship" && l.UpdatedBy == "arya"
+		})).Return(nil).Once()
+
+		location, err := s.uc.UpdateLocation(context.Background(), 2, req)
+
+		s.NoError(err)
+		s.Equal("Jakarta flagship", location.Name)
+	})
+
+	s.Run("Validation Error", func() {
+		location, err := s.uc.UpdateLocation(context.Background(), 2, &request.LocationUpdate{Code: " ", Name: "Jakarta", Type: entity.LocationStore, UpdatedBy: "arya"})
+
+		s.Error(err)
+		s.Nil(location)
+	})
+}
+
+func (s *LocationUsecaseTestSuite) TestDeleteLocation() {
+	s.mockRepo.On("Delete", mock.Anything, int64(1)).Return(constant.ErrConflict).Once()
+
+	err := s.uc.DeleteLocation(context.Background(), 1)
+
+	s.ErrorIs(err, constant.ErrConflict)
+}
+
+func (s *LocationUsecaseTestSuite) TestListProductStocks() {
+
+	s.Run("Success", func() {
+		s.mockRepo.On("FetchStocks", mock.Anything, int64(1)).Return([]entity.ProductStock{{ProductID: 1, LocationID: 1, Quantity: 6}}, nil).Once()
+
+		stocks, err := s.uc.ListProductStocks(context.Background(), 1)
+
+		s.NoError(err)
+		s.Len(stocks, 1)
+	})
+
+	s.Run("Never Stocked", func() {
+		s.mockRepo.On("FetchStocks", mock.Anything, int64(2)).Return(nil, nil).Once()
+
+		stocks, err := s.uc.ListProductStocks(context.Background(), 2)
+
+		s.NoError(err)
+		s.NotNil(stocks)
+		s.Empty(stocks)
+	})
+
+	s.Run("Product Not Found", func() {
+		s.mockRepo.On("FetchStocks", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		_, err := s.uc.ListProductStocks(context.Background(), 9)
+
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func TestLocationUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(LocationUsecaseTestSuite))
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// importColumns are the header names an import file may use, matched without
+// regard to case. sku, barcode and brand_id are optional; created_by falls back
+// to the uploader when absent.
+var (
+	importColumns         = []string{"name", "price", "description", "quantity", "sku", "barcode", "brand_id", "created_by"}
+	importRequiredColumns = []string{"name", "price", "description", "quantity"}
+)
+
+// importRecord is one line of an import file with its 1-based row number.
+type importRecord struct {
+	row    int
+	fields []string
+}
+
+// readImportRecords returns the non-blank rows of a CSV file or of the first
+// sheet of an XLSX workbook, header included.
+func readImportRecords(format string, content []byte) ([]importRecord, error) {
+	switch format {
+	case entity.ImportFormatCSV:
+		return readCSVRecords(content)
+	case entity.ImportFormatXLSX:
+		return readXLSXRecords(content)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func readCSVRecords(content []byte) ([]importRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var records []importRecord
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, importRecord{row: line, fields: fields})
+	}
+}
+
+func readXLSXRecords(content []byte) ([]importRecord, error) {
+	file, err := excelize.OpenReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	// Raw values keep numbers free of the display format, e.g. 5000000
+	// rather than "5,000,000".
+	rows, err := file.GetRows(file.GetSheetName(0), excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var records []importRecord
+	for i, fields := range rows {
+		if strings.TrimSpace(strings.Join(fields, "")) == "" {
+			continue
+		}
+		records = append(records, importRecord{row: i + 1, fields: fields})
+	}
+	return records, nil
+}
+
+// mapImportHeader returns the position of each known column in the header.
+func mapImportHeader(header []string) (map[string]int, error) {
+	positions := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for _, column := range importColumns {
+			if name == column {
+				positions[column] = i
+			}
+		}
+	}
+
+	var missing []string
+	for _, column := range importRequiredColumns {
+		if _, ok := positions[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing column %s", strings.Join(missing, ", "))
+	}
+
+	return positions, nil
+}
+
+// parseImportRow maps a row onto request.Product. Cells that cannot be parsed
+// are reported instead of being left for validation, which would only say
+// they are required.
+func parseImportRow(positions map[string]int, fields []string, createdBy string) (request.Product, []string) {
+	value := func(column string) string {
+		i, ok := positions[column]
+		if !ok || i >= len(fields) {
+			return ""
+		}
+		return strings.TrimSpace(fields[i])
+	}
+
+	product := request.Product{
+		Name:        value("name"),
+		Description: value("description"),
+		SKU:         value("sku"),
+		Barcode:     value("barcode"),
+		CreatedBy:   value("created_by"),
+	}
+	if product.CreatedBy == "" {
+		product.CreatedBy = createdBy
+	}
+
+	var problems []string
+	if raw := value("price"); raw != "" {
+		price, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			problems = append(problems, "price must be an integer")
+		} else {
+			product.Price = &price
+		}
+	}
+	if raw := value("brand_id"); raw != "" {
+		brandID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			problems = append(problems, "brand_id must be an integer")
+		} else {
+			product.BrandID = &brandID
+		}
+	}
+	if raw := value("quantity"); raw != "" {
+		quantity, err := strconv.Atoi(raw)
+		if err != nil {
+			problems = append(problems, "quantity must be an integer")
+		} else {
+			product.Quantity = &quantity
+		}
+	}
+
+	return product, problems
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/utils"
+)
+
+// importBatchSize is how many rows an import hands to a bulk create at a time,
+// and so how often its progress is saved.
+const importBatchSize = 500
+
+// importLease is how long a processing job may go without saving its progress
+// before it is taken for abandoned by a worker that stopped. A batch saves
+// progress, and takes far less.
+const importLease = 15 * time.Minute
+
+type productImportUsecase struct {
+	repo     interfaces.ProductImportRepository
+	products interfaces.ProductUsecase
+}
+
+func NewProductImportUsecase(repo interfaces.ProductImportRepository, products interfaces.ProductUsecase) interfaces.ProductImportUsecase {
+	return &productImportUsecase{
+		repo:     repo,
+		products: products,
+	}
+}
+
+// CreateImport stores the upload as a pending job for the import worker.
+func (u *productImportUsecase) CreateImport(ctx context.Context, req *request.ProductImport) (*entity.ProductImport, error) {
+
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(req.FileName)), ".")
+	if format != entity.ImportFormatCSV && format != entity.ImportFormatXLSX {
+		return nil, fmt.Errorf("%w: file must be a .csv or .xlsx", constant.ErrValidation)
+	}
+	if len(req.Content) == 0 {
+		return nil, fmt.Errorf("%w: file is empty", constant.ErrValidation)
+	}
+
+	now := time.Now()
+	job := &entity.ProductImport{
+		FileName:  filepath.Base(req.FileName),
+		Format:    format,
+		Status:    entity.ImportStatusPending,
+		Errors:    entity.ImportRowErrors{},
+		Payload:   req.Content,
+		CreatedBy: req.CreatedBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := u.repo.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (u *productImportUsecase) GetImport(ctx context.Context, id int64) (*entity.ProductImport, error) {
+	return u.repo.GetByID(ctx, id)
+}
+
+// ProcessNextImport runs the oldest pending import to completion. It reports
+// false when there was nothing to process. Jobs abandoned by a stopped worker
+// are failed first, since their file may already be partly imported and a
+// rerun would create those rows twice.
+func (u *productImportUsecase) ProcessNextImport(ctx context.Context) (bool, error) {
+
+	if err := u.repo.FailStale(ctx, time.Now().Add(-importLease), "import was interrupted; the processed rows were kept, upload the remaining rows again"); err != nil {
+		return false, err
+	}
+
+	job, err := u.repo.ClaimPending(ctx)
+	if errors.Is(err, constant.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, u.processImport(ctx, job)
+}
+
+func (u *productImportUsecase) processImport(ctx context.Context, job *entity.ProductImport) error {
+
+	records, err := readImportRecords(job.Format, job.Payload)
+	if err != nil {
+		return u.finishImport(ctx, job, "file could not be read: "+err.Error())
+	}
+	if len(records) == 0 {
+		return u.finishImport(ctx, job, "file is empty")
+	}
+
+	positions, err := mapImportHeader(records[0].fields)
+	if err != nil {
+		return u.finishImport(ctx, job, err.Error())
+	}
+
+	rows := records[1:]
+	job.TotalRows = len(rows)
+
+	for start := 0; start < len(rows); start += importBatchSize {
+		batch := rows[start:min(start+importBatchSize, len(rows))]
+
+		bulk := request.ProductBulk{Mode: request.BulkModeBestEffort}
+		bulkRows := make([]int, 0, len(batch))
+		for _, record := range batch {
+			product, problems := parseImportRow(positions, record.fields, job.CreatedBy)
+			if len(problems) > 0 {
+				job.Errors = append(job.Errors, entity.ImportRowError{Row: record.row, Errors: problems})
+				job.FailedRows++
+				continue
+			}
+			bulk.Products = append(bulk.Products, product)
+			bulkRows = append(bulkRows, record.row)
+		}
+
+		if len(bulk.Products) > 0 {
+			result, err := u.products.CreateProductsBulk(ctx, &bulk)
+			if err != nil {
+				return errors.Join(err, u.finishImport(ctx, job, "import stopped: "+err.Error()))
+			}
+
+			job.CreatedRows += result.Created
+			for _, item := range result.Items {
+				if item.Error == nil {
+					continue
+				}
+				job.Errors = append(job.Errors, entity.ImportRowError{Row: bulkRows[item.Index], Errors: importErrorMessages(item.Error)})
+				job.FailedRows++
+			}
+		}
+
+		job.ProcessedRows += len(batch)
+		// A job that was failed as stale in the meantime is not continued.
+		if err := u.repo.SaveProgress(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	return u.finishImport(ctx, job, "")
+}
+
+// finishImport closes a job. A message marks the whole job as failed.
+func (u *productImportUsecase) finishImport(ctx context.Context, job *entity.ProductImport, message string) error {
+	now := time.Now()
+	job.FinishedAt = &now
+	job.Status = entity.ImportStatusCompleted
+	if message != "" {
+		job.Status = entity.ImportStatusFailed
+		job.Message = message
+	}
+
+	return u.repo.SaveProgress(ctx, job)
+}
+
+// importErrorMessages flattens the error of a bulk item into report lines.
+func importErrorMessages(itemError any) []string {
+	switch e := itemError.(type) {
+	case []utils.ValidationError:
+		messages := make([]string, len(e))
+		for i, ve := range e {
+			messages[i] = ve.Parameter
+		}
+		return messages
+	case string:
+		return []string{e}
+	default:
+		return []string{fmt.Sprint(e)}
+	}
+}
@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/xuri/excelize/v2"
+)
+
+type ProductImportUsecaseTestSuite struct {
+	suite.Suite
+	mockRepo     *mocks.ProductImportRepository
+	mockProducts *mocks.ProductUsecase
+	uc           interfaces.ProductImportUsecase
+}
+
+func (s *ProductImportUsecaseTestSuite) SetupTest() {
+	s.mockRepo = new(mocks.ProductImportRepository)
+	s.mockProducts = new(mocks.ProductUsecase)
+	s.uc = NewProductImportUsecase(s.mockRepo, s.mockProducts)
+}
+
+func (s *ProductImportUsecaseTestSuite) TestCreateImport() {
+
+	s.Run("Success", func() {
+		req := &request.ProductImport{CreatedBy: "arya", FileName: "Catalog.XLSX", Content: []byte("data")}
+
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(job *entity.ProductImport) bool {
+			return job.Format == entity.ImportFormatXLSX && job.Status == entity.ImportStatusPending && job.CreatedBy == "arya"
+		})).Return(nil).Once()
+
+		job, err := s.uc.CreateImport(context.Background(), req)
+
+		s.NoError(err)
+		s.Equal("Catalog.XLSX", job.FileName)
+	})
+
+	s.Run("Unsupported Format", func() {
+		req := &request.ProductImport{CreatedBy: "arya", FileName: "catalog.pdf", Content: []byte("data")}
+
+		job, err := s.uc.CreateImport(context.Background(), req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(job)
+	})
+
+	s.Run("Empty File", func() {
+		req := &request.ProductImport{CreatedBy: "arya", FileName: "catalog.csv"}
+
+		_, err := s.uc.CreateImport(context.Background(), req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+}
+
+func (s *ProductImportUsecaseTestSuite) TestProcessNextImport() {
+
+	s.Run("Nothing Pending", func() {
+		s.mockRepo.On("FailStale", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.mockRepo.On("ClaimPending", mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		processed, err := s.uc.ProcessNextImport(context.Background())
+
+		s.NoError(err)
+		s.False(processed)
+	})
+
+	s.Run("CSV With Row Errors", func() {
+		csv := "\xef\xbb\xbfName,Price,Description,Quantity\n" +
+			"LG TV,5000000,Desc,10\n" +
+			"OLED,cheap,Desc,10\n" +
+			"\n" +
+			",1000,Desc,1\n"
+		job := &entity.ProductImport{ID: 1, Format: entity.ImportFormatCSV, Payload: []byte(csv), CreatedBy: "arya"}
+
+		s.mockRepo.On("FailStale", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.mockRepo.On("ClaimPending", mock.Anything).Return(job, nil).Once()
+		s.mockProducts.On("CreateProductsBulk", mock.Anything, mock.MatchedBy(func(r *request.ProductBulk) bool {
+			return r.Mode == request.BulkModeBestEffort && len(r.Products) == 2 &&
+				r.Products[0].Name == "LG TV" && *r.Products[0].Price == 5000000 && r.Products[0].CreatedBy == "arya"
+		})).Return(response.BulkResult{
+			Created: 1,
+			Failed:  1,
+			Items: []response.BulkItem{
+				{Index: 0, ID: 1},
+				{Index: 1, Error: []utils.ValidationError{{Parameter: "name is required"}}},
+			},
+		}, nil).Once()
+		s.mockRepo.On("SaveProgress", mock.Anything, job).Return(nil).Twice()
+
+		processed, err := s.uc.ProcessNextImport(context.Background())
+
+		s.NoError(err)
+		s.True(processed)
+		s.Equal(entity.ImportStatusCompleted, job.Status)
+		s.Equal(3, job.TotalRows)
+		s.Equal(3, job.ProcessedRows)
+		s.Equal(1, job.CreatedRows)
+		s.Equal(2, job.FailedRows)
+		s.Equal(entity.ImportRowErrors{
+			{Row: 3, Errors: []string{"price must be an integer"}},
+			{Row: 5, Errors: []string{"name is required"}},
+		}, job.Errors)
+		s.NotNil(job.FinishedAt)
+	})
+
+	s.Run("XLSX", func() {
+		book := excelize.NewFile()
+		sheet := book.GetSheetName(0)
+		s.NoError(book.SetSheetRow(sheet, "A1", &[]interface{}{"name", "price", "description", "quantity", "created_by", "brand_id"}))
+		s.NoError(book.SetSheetRow(sheet, "A2", &[]interface{}{"LG TV", 5000000, "Desc", 10, "budi", 3}))
+		content, err := book.WriteToBuffer()
+		s.Require().NoError(err)
+
+		job := &entity.ProductImport{ID: 2, Format: entity.ImportFormatXLSX, Payload: content.Bytes(), CreatedBy: "arya"}
+
+		s.mockRepo.On("FailStale", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.mockRepo.On("ClaimPending", mock.Anything).Return(job, nil).Once()
+		s.mockProducts.On("CreateProductsBulk", mock.Anything, mock.MatchedBy(func(r *request.ProductBulk) bool {
+			return len(r.Products) == 1 && *r.Products[0].Price == 5000000 && *r.Products[0].Quantity == 10 && r.Products[0].CreatedBy == "budi" && *r.Products[0].BrandID == 3
+		})).Return(response.BulkResult{Created: 1, Items: []response.BulkItem{{Index: 0, ID: 2}}}, nil).Once()
+		s.mockRepo.On("SaveProgress", mock.Anything, job).Return(nil).Twice()
+
+		processed, err := s.uc.ProcessNextImport(context.Background())
+
+		s.NoError(err)
+		s.True(processed)
+		s.Equal(entity.ImportStatusCompleted, job.Status)
+		s.Equal(1, job.CreatedRows)
+		s.Empty(job.Errors)
+	})
+
+	s.Run("Missing Column Fails Job", func() {
+		job := &entity.ProductImport{ID: 3, Format: entity.ImportFormatCSV, Payload: []byte("name,price\nLG TV,1000\n")}
+
+		s.mockRepo.On("FailStale", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.mockRepo.On("ClaimPending", mock.Anything).Return(job, nil).Once()
+		s.mockRepo.On("SaveProgress", mock.Anything, job).Return(nil).Once()
+
+		processed, err := s.uc.ProcessNextImport(context.Background())
+
+		s.NoError(err)
+		s.True(processed)
+		s.Equal(entity.ImportStatusFailed, job.Status)
+		s.Equal("missing column description, quantity", job.Message)
+	})
+
+	s.Run("Unreadable File Fails Job", func() {
+		job := &entity.ProductImport{ID: 4, Format: entity.ImportFormatXLSX, Payload: []byte("not a workbook")}
+
+		s.mockRepo.On("FailStale", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.mockRepo.On("ClaimPending", mock.Anything).Return(job, nil).Once()
+		s.mockRepo.On("SaveProgress", mock.Anything, job).Return(nil).Once()
+
+		processed, err := s.uc.ProcessNextImport(context.Background())
+
+		s.NoError(err)
+		s.True(processed)
+		s.Equal(entity.ImportStatusFailed, job.Status)
+	})
+
+	s.Run("Bulk Create Error Stops Job", func() {
+		job := &entity.ProductImport{ID: 5, Format: entity.ImportFormatCSV, Payload: []byte("name,price,description,quantity\nLG TV,1000,Desc,1\n"), CreatedBy: "arya"}
+
+		s.mockRepo.On("FailStale", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.mockRepo.On("ClaimPending", mock.Anything).Return(job, nil).Once()
+		s.mockProducts.On("CreateProductsBulk", mock.Anything, mock.Anything).Return(response.BulkResult{}, errors.New("db error")).Once()
+		s.mockRepo.On("SaveProgress", mock.Anything, job).Return(nil).Once()
+
+		processed, err := s.uc.ProcessNextImport(context.Background())
+
+		s.Error(err)
+		s.True(processed)
+		s.Equal(entity.ImportStatusFailed, job.Status)
+		s.Equal("import stopped: db error", job.Message)
+	})
+
+	s.Run("Job Failed Meanwhile Stops", func() {
+		job := &entity.ProductImport{ID: 6, Format: entity.ImportFormatCSV, Payload: []byte("name,price,description,quantity\nLG TV,1000,Desc,1\n"), CreatedBy: "arya"}
+
+		s.mockRepo.On("FailStale", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.mockRepo.On("ClaimPending", mock.Anything).Return(job, nil).Once()
+		s.mockProducts.On("CreateProductsBulk", mock.Anything, mock.Anything).Return(response.BulkResult{Created: 1, Items: []response.BulkItem{{Index: 0, ID: 7}}}, nil).Once()
+		s.mockRepo.On("SaveProgress", mock.Anything, job).Return(fmt.Errorf("%w: import 6 is no longer processing", constant.ErrConflict)).Once()
+
+		processed, err := s.uc.ProcessNextImport(context.Background())
+
+		s.ErrorIs(err, constant.ErrConflict)
+		s.True(processed)
+		s.Nil(job.FinishedAt, "A failed job is not finished again")
+	})
+
+	s.Run("Fails Abandoned Jobs First", func() {
+		s.mockRepo.On("FailStale", mock.Anything, mock.MatchedBy(func(staleBefore time.Time) bool {
+			return time.Since(staleBefore) >= importLease
+		}), mock.MatchedBy(func(message string) bool {
+			return strings.HasPrefix(message, "import was interrupted")
+		})).Return(errors.New("db error")).Once()
+
+		processed, err := s.uc.ProcessNextImport(context.Background())
+
+		s.Error(err)
+		s.False(processed)
+	})
+
+	s.Run("Claim Error", func() {
+		s.mockRepo.On("FailStale", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.mockRepo.On("ClaimPending", mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		processed, err := s.uc.ProcessNextImport(context.Background())
+
+		s.Error(err)
+		s.False(processed)
+	})
+}
+
+func TestProductImportUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(ProductImportUsecaseTestSuite))
+}
@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/internal/repository"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/storage"
+	"erajaya-test/shared/utils"
+
+	"github.com/google/uuid"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// maxMediaPixels bounds the decoded size of an upload, so a small file cannot
+// expand into a huge bitmap.
+const maxMediaPixels = 50_000_000
+
+// MediaConfig limits the uploads: MaxSize is in bytes, ThumbnailSize is the
+// longest side of a thumbnail in pixels.
+type MediaConfig struct {
+	MaxSize       int64
+	ThumbnailSize int
+}
+
+type productMediaUsecase struct {
+	repo      interfaces.ProductMediaRepository
+	redisRepo repository.RedisRepository
+	store     storage.Storage
+	config    MediaConfig
+	validator *utils.CustomValidator
+}
+
+func NewProductMediaUsecase(repo interfaces.ProductMediaRepository, redisRepo repository.RedisRepository, store storage.Storage, config MediaConfig) interfaces.ProductMediaUsecase {
+	return &productMediaUsecase{
+		repo:      repo,
+		redisRepo: redisRepo,
+		store:     store,
+		config:    config,
+		validator: utils.NewValidator(),
+	}
+}
+
+// UploadMedia stores an image of a product and a thumbnail of it. The content
+// type is sniffed from the file, whatever the client declared.
+func (u *productMediaUsecase) UploadMedia(ctx context.Context, productID int64, req *request.ProductMediaUpload) (*entity.ProductMedia, error) {
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+	if len(req.Content) == 0 {
+		return nil, fmt.Errorf("%w: file is empty", constant.ErrValidation)
+	}
+	if int64(len(req.Content)) > u.config.MaxSize {
+		return nil, fmt.Errorf("%w: file must be at most %d MB", constant.ErrValidation, u.config.MaxSize>>20)
+	}
+
+	contentType := http.DetectContentType(req.Content)
+	extension, ok := mediaExtensions[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: file must be a JPEG, PNG or WebP image", constant.ErrValidation)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(req.Content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: file is not a readable image", constant.ErrValidation)
+	}
+	if config.Width*config.Height > maxMediaPixels {
+		return nil, fmt.Errorf("%w: image must be at most %d megapixels", constant.ErrValidation, maxMediaPixels/1_000_000)
+	}
+
+	thumbnail, thumbnailType, err := makeThumbnail(req.Content, contentType, u.config.ThumbnailSize)
+	if err != nil {
+		return nil, fmt.Errorf("%w: file is not a readable image", constant.ErrValidation)
+	}
+
+	name := fmt.Sprintf("products/%d/%s", productID, uuid.NewString())
+	key := name + extension
+	thumbnailKey := name + "_thumb" + mediaExtensions[thumbnailType]
+
+	if err := u.store.Put(ctx, key, req.Content, contentType); err != nil {
+		return nil, err
+	}
+	if err := u.store.Put(ctx, thumbnailKey, thumbnail, thumbnailType); err != nil {
+		_ = u.store.Delete(ctx, key)
+		return nil, err
+	}
+
+	now := time.Now()
+	media := &entity.ProductMedia{
+		ProductID:    productID,
+		StorageKey:   key,
+		ThumbnailKey: thumbnailKey,
+		URL:          u.store.URL(key),
+		ThumbnailURL: u.store.URL(thumbnailKey),
+		ContentType:  contentType,
+		Size:         int64(len(req.Content)),
+		Width:        config.Width,
+		Height:       config.Height,
+		IsPrimary:    req.IsPrimary,
+		CreatedAt:    now,
+		CreatedBy:    req.CreatedBy,
+		UpdatedAt:    now,
+		UpdatedBy:    req.CreatedBy,
+	}
+
+	if err := u.repo.Create(ctx, media); err != nil {
+		u.removeFiles(ctx, media)
+		return nil, err
+	}
+
+	invalidateProductCaches(ctx, u.redisRepo, productID)
+
+	return media, nil
+}
+
+func (u *productMediaUsecase) ListMedia(ctx context.Context, productID int64) ([]entity.ProductMedia, error) {
+	media, err := u.repo.FetchByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if media == nil {
+		media = []entity.ProductMedia{}
+	}
+	return media, nil
+}
+
+func (u *productMediaUsecase) ReorderMedia(ctx context.Context, productID int64, req *request.ProductMediaOrder) ([]entity.ProductMedia, error) {
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	media, err := u.repo.Reorder(ctx, productID, req.MediaIDs, req.UpdatedBy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateProductCaches(ctx, u.redisRepo, productID)
+
+	return media, nil
+}
+
+func (u *productMediaUsecase) SetPrimaryMedia(ctx context.Context, productID int64, id int64, req *request.ProductMediaPrimary) (*entity.ProductMedia, error) {
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	media, err := u.repo.SetPrimary(ctx, productID, id, req.UpdatedBy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateProductCaches(ctx, u.redisRepo, productID)
+
+	return media, nil
+}
+
+// DeleteMedia deletes an image, then its files. A file left behind by a
+// failing storage is no longer referenced, so the delete still succeeds.
+func (u *productMediaUsecase) DeleteMedia(ctx context.Context, productID int64, id int64) error {
+	media, err := u.repo.Delete(ctx, productID, id)
+	if err != nil {
+		return err
+	}
+
+	u.removeFiles(ctx, media)
+	invalidateProductCaches(ctx, u.redisRepo, productID)
+
+	return nil
+}
+
+func (u *productMediaUsecase) removeFiles(ctx context.Context, media *entity.ProductMedia) {
+	_ = u.store.Delete(ctx, media.StorageKey)
+	_ = u.store.Delete(ctx, media.ThumbnailKey)
+}
+
+var mediaExtensions = map[string]string{
+	entity.MediaJPEG: ".jpg",
+	entity.MediaPNG:  ".png",
+	entity.MediaWebP: ".webp",
+}
+
+// makeThumbnail scales an image down to fit size pixels on its longest side,
+// never up. PNG thumbnails stay PNG to keep their transparency, the others
+// are JPEG on a white background.
+func makeThumbnail(data []byte, contentType string, size int) ([]byte, string, error) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(1, height*size/width)
+		} else {
+			width, height = max(1, width*size/height), size
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	var buf bytes.Buffer
+	if contentType == entity.MediaPNG {
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Src, nil)
+		err = png.Encode(&buf, dst)
+		return buf.Bytes(), entity.MediaPNG, err
+	}
+
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+	err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 85})
+	return buf.Bytes(), entity.MediaJPEG, err
+}
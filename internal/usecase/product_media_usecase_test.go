@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/storage"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductMediaUsecaseTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.ProductMediaRepository
+	mockRedisRepo *mocks.RedisRepository
+	root          string
+	uc            interfaces.ProductMediaUsecase
+}
+
+func (s *ProductMediaUsecaseTestSuite) SetupTest() {
+	s.mockRepo = new(mocks.ProductMediaRepository)
+	s.mockRedisRepo = new(mocks.RedisRepository)
+	s.root = s.T().TempDir()
+
+	store, err := storage.NewLocalStorage(storage.Config{Root: s.root, BaseURL: "http://cdn.test/media"})
+	s.Require().NoError(err)
+
+	s.uc = NewProductMediaUsecase(s.mockRepo, s.mockRedisRepo, store, MediaConfig{MaxSize: 1 << 20, ThumbnailSize: 100})
+}
+
+func (s *ProductMediaUsecaseTestSuite) expectInvalidation(productID int64) {
+	s.mockRedisRepo.On("Delete", mock.Anything, fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, productID)).Return(nil).Once()
+	s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+	s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+}
+
+// storedFiles lists the files kept by the local storage, relative to its root.
+func (s *ProductMediaUsecaseTestSuite) storedFiles() []string {
+	var files []string
+	_ = filepath.WalkDir(s.root, func(path string, entry os.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() {
+			rel, _ := filepath.Rel(s.root, path)
+			files = append(files, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	return files
+}
+
+func encodeImage(format string, width int, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, 0, color.NRGBA{R: 200, A: 255})
+	}
+
+	var buf bytes.Buffer
+	if format == "png" {
+		_ = png.Encode(&buf, img)
+	} else {
+		_ = jpeg.Encode(&buf, img, nil)
+	}
+	return buf.Bytes()
+}
+
+func (s *ProductMediaUsecaseTestSuite) TestUploadMedia() {
+	s.Run("Success Stores Image And Thumbnail", func() {
+		req := &request.ProductMediaUpload{FileName: "front.bin", Content: encodeImage("png", 400, 200), CreatedBy: "arya"}
+
+		var created *entity.ProductMedia
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(m *entity.ProductMedia) bool {
+			return m.ProductID == 1 && m.ContentType == entity.MediaPNG && m.Width == 400 && m.Height == 200 &&
+				m.Size == int64(len(req.Content)) && m.UpdatedBy == "arya" && !m.IsPrimary
+		})).Run(func(args mock.Arguments) {
+			created = args.Get(1).(*entity.ProductMedia)
+			created.ID = 5
+		}).Return(nil).Once()
+		s.expectInvalidation(1)
+
+		media, err := s.uc.UploadMedia(context.Background(), 1, req)
+
+		s.Require().NoError(err)
+		s.Equal(int64(5), media.ID)
+		s.Regexp(`^products/1/[0-9a-f-]{36}\.png$`, media.StorageKey)
+		s.Regexp(`^products/1/[0-9a-f-]{36}_thumb\.png$`, media.ThumbnailKey)
+		s.Equal("http://cdn.test/media/"+media.StorageKey, media.URL)
+		s.Equal("http://cdn.test/media/"+media.ThumbnailKey, media.ThumbnailURL)
+		s.ElementsMatch([]string{media.StorageKey, media.ThumbnailKey}, s.storedFiles())
+
+		thumbnail, err := os.ReadFile(filepath.Join(s.root, media.ThumbnailKey))
+		s.Require().NoError(err)
+		config, format, err := image.DecodeConfig(bytes.NewReader(thumbnail))
+		s.Require().NoError(err)
+		s.Equal("png", format)
+		s.Equal(100, config.Width)
+		s.Equal(50, config.Height)
+		s.mockRedisRepo.AssertExpectations(s.T())
+
+		s.Require().NoError(os.RemoveAll(filepath.Join(s.root, "products")))
+	})
+
+	s.Run("Small JPEG Is Not Upscaled", func() {
+		req := &request.ProductMediaUpload{Content: encodeImage("jpeg", 60, 80), IsPrimary: true, CreatedBy: "arya"}
+
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(m *entity.ProductMedia) bool {
+			return m.ProductID == 2 && m.ContentType == entity.MediaJPEG && m.IsPrimary
+		})).Return(nil).Once()
+		s.expectInvalidation(2)
+
+		media, err := s.uc.UploadMedia(context.Background(), 2, req)
+
+		s.Require().NoError(err)
+		s.Regexp(`\.jpg$`, media.StorageKey)
+		s.Regexp(`_thumb\.jpg$`, media.ThumbnailKey)
+
+		thumbnail, err := os.ReadFile(filepath.Join(s.root, media.ThumbnailKey))
+		s.Require().NoError(err)
+		config, _, err := image.DecodeConfig(bytes.NewReader(thumbnail))
+		s.Require().NoError(err)
+		s.Equal(60, config.Width)
+		s.Equal(80, config.Height)
+
+		s.Require().NoError(os.RemoveAll(filepath.Join(s.root, "products")))
+	})
+
+	s.Run("Rejected Files", func() {
+		cases := []struct {
+			name    string
+			content []byte
+			message string
+		}{
+			{"Empty", nil, "validation error: file is empty"},
+			{"Too Large", append(encodeImage("png", 10, 10), make([]byte, 1<<20)...), "validation error: file must be at most 1 MB"},
+			{"Not An Image", []byte("%PDF-1.4 not an image"), "validation error: file must be a JPEG, PNG or WebP image"},
+			{"Corrupt Image", encodeImage("png", 10, 10)[:20], "validation error: file is not a readable image"},
+		}
+		for _, tc := range cases {
+			req := &request.ProductMediaUpload{Content: tc.content, CreatedBy: "arya"}
+
+			media, err := s.uc.UploadMedia(context.Background(), 1, req)
+
+			s.Nil(media, tc.name)
+			s.ErrorIs(err, constant.ErrValidation, tc.name)
+			s.EqualError(err, tc.message, tc.name)
+		}
+		s.Empty(s.storedFiles())
+	})
+
+	s.Run("Validation Error", func() {
+		media, err := s.uc.UploadMedia(context.Background(), 1, &request.ProductMediaUpload{Content: encodeImage("png", 10, 10)})
+
+		s.Error(err)
+		s.Nil(media)
+	})
+
+	s.Run("Repository Error Removes Files", func() {
+		req := &request.ProductMediaUpload{Content: encodeImage("png", 10, 10), CreatedBy: "arya"}
+
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(m *entity.ProductMedia) bool {
+			return m.ProductID == 9
+		})).Return(constant.ErrNotFound).Once()
+
+		media, err := s.uc.UploadMedia(context.Background(), 9, req)
+
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(media)
+		s.Empty(s.storedFiles())
+	})
+}
+
+func (s *ProductMediaUsecaseTestSuite) TestListMedia() {
+	s.Run("Success", func() {
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(1)).Return([]entity.ProductMedia{{ID: 5, IsPrimary: true}}, nil).Once()
+
+		media, err := s.uc.ListMedia(context.Background(), 1)
+
+		s.NoError(err)
+		s.Len(media, 1)
+	})
+
+	s.Run("Empty", func() {
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(2)).Return(nil, nil).Once()
+
+		media, err := s.uc.ListMedia(context.Background(), 2)
+
+		s.NoError(err)
+		s.NotNil(media)
+		s.Empty(media)
+	})
+
+	s.Run("Error", func() {
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(3)).Return(nil, errors.New("db error")).Once()
+
+		media, err := s.uc.ListMedia(context.Background(), 3)
+
+		s.Error(err)
+		s.Nil(media)
+	})
+}
+
+func (s *ProductMediaUsecaseTestSuite) TestReorderMedia() {
+	s.Run("Success", func() {
+		req := &request.ProductMediaOrder{MediaIDs: []int64{6, 5}, UpdatedBy: "arya"}
+
+		s.mockRepo.On("Reorder", mock.Anything, int64(1), []int64{6, 5}, "arya", mock.Anything).
+			Return([]entity.ProductMedia{{ID: 6, Position: 1}, {ID: 5, Position: 2}}, nil).Once()
+		s.expectInvalidation(1)
+
+		media, err := s.uc.ReorderMedia(context.Background(), 1, req)
+
+		s.NoError(err)
+		s.Equal(int64(6), media[0].ID)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Validation Error", func() {
+		media, err := s.uc.ReorderMedia(context.Background(), 1, &request.ProductMediaOrder{MediaIDs: []int64{0}, UpdatedBy: "arya"})
+
+		s.Error(err)
+		s.Nil(media)
+	})
+
+	s.Run("Incomplete Order", func() {
+		req := &request.ProductMediaOrder{MediaIDs: []int64{6}, UpdatedBy: "arya"}
+		s.mockRepo.On("Reorder", mock.Anything, int64(2), []int64{6}, "arya", mock.Anything).
+			Return(nil, constant.ErrValidation).Once()
+
+		media, err := s.uc.ReorderMedia(context.Background(), 2, req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(media)
+	})
+}
+
+func (s *ProductMediaUsecaseTestSuite) TestSetPrimaryMedia() {
+	s.Run("Success", func() {
+		s.mockRepo.On("SetPrimary", mock.Anything, int64(1), int64(6), "arya", mock.Anything).
+			Return(&entity.ProductMedia{ID: 6, IsPrimary: true}, nil).Once()
+		s.expectInvalidation(1)
+
+		media, err := s.uc.SetPrimaryMedia(context.Background(), 1, 6, &request.ProductMediaPrimary{UpdatedBy: "arya"})
+
+		s.NoError(err)
+		s.True(media.IsPrimary)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Not Found", func() {
+		s.mockRepo.On("SetPrimary", mock.Anything, int64(1), int64(9), "arya", mock.Anything).
+			Return(nil, constant.ErrNotFound).Once()
+
+		media, err := s.uc.SetPrimaryMedia(context.Background(), 1, 9, &request.ProductMediaPrimary{UpdatedBy: "arya"})
+
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(media)
+	})
+}
+
+func (s *ProductMediaUsecaseTestSuite) TestDeleteMedia() {
+	s.Run("Success Removes Files", func() {
+		key, thumbnailKey := "products/1/a.png", "products/1/a_thumb.png"
+		s.Require().NoError(os.MkdirAll(filepath.Join(s.root, "products/1"), 0o755))
+		for _, name := range []string{key, thumbnailKey} {
+			s.Require().NoError(os.WriteFile(filepath.Join(s.root, name), []byte("x"), 0o644))
+		}
+
+		s.mockRepo.On("Delete", mock.Anything, int64(1), int64(5)).
+			Return(&entity.ProductMedia{ID: 5, StorageKey: key, ThumbnailKey: thumbnailKey}, nil).Once()
+		s.expectInvalidation(1)
+
+		err := s.uc.DeleteMedia(context.Background(), 1, 5)
+
+		s.NoError(err)
+		s.Empty(s.storedFiles())
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Not Found", func() {
+		s.mockRepo.On("Delete", mock.Anything, int64(1), int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		err := s.uc.DeleteMedia(context.Background(), 1, 9)
+
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func TestProductMediaUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(ProductMediaUsecaseTestSuite))
+}
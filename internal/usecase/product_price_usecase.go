@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/internal/repository"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+)
+
+type productPriceUsecase struct {
+	repo      interfaces.ProductPriceRepository
+	redisRepo repository.RedisRepository
+	validator *utils.CustomValidator
+}
+
+func NewProductPriceUsecase(repo interfaces.ProductPriceRepository, redisRepo repository.RedisRepository) interfaces.ProductPriceUsecase {
+	return &productPriceUsecase{
+		repo:      repo,
+		redisRepo: redisRepo,
+		validator: utils.NewValidator(),
+	}
+}
+
+// SchedulePrice stores a price the product takes on at valid_from. The
+// scheduler applies it once it is due.
+func (u *productPriceUsecase) SchedulePrice(ctx context.Context, productID int64, req *request.ProductPriceSchedule) (*entity.ProductPrice, error) {
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !req.ValidFrom.After(now) {
+		return nil, fmt.Errorf("%w: valid_from must be in the future", constant.ErrValidation)
+	}
+
+	price := &entity.ProductPrice{
+		ProductID: productID,
+		Price:     *req.Price,
+		ValidFrom: *req.ValidFrom,
+		Status:    entity.PriceScheduled,
+		CreatedAt: now,
+		CreatedBy: req.CreatedBy,
+	}
+	if err := u.repo.Schedule(ctx, price); err != nil {
+		return nil, err
+	}
+	return price, nil
+}
+
+func (u *productPriceUsecase) ListPriceHistory(ctx context.Context, productID int64, page int, limit int) ([]entity.ProductPrice, response.StdPagination, error) {
+	prices, total, err := u.repo.FetchByProduct(ctx, productID, page, limit)
+	if err != nil {
+		return nil, response.StdPagination{}, err
+	}
+	if prices == nil {
+		prices = []entity.ProductPrice{}
+	}
+	return prices, response.StandardPagination(page, limit, total), nil
+}
+
+// ApplyDuePrices applies every scheduled price that came due, one per
+// transaction, and returns how many it applied. A price that failed is left
+// in the history with its reason and does not stop the others.
+func (u *productPriceUsecase) ApplyDuePrices(ctx context.Context) (int, error) {
+	applied := 0
+	for ctx.Err() == nil {
+		price, err := u.repo.ApplyNext(ctx, time.Now())
+		if errors.Is(err, constant.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return applied, err
+		}
+		if price.Status == entity.PriceFailed {
+			continue
+		}
+
+		invalidateProductCaches(ctx, u.redisRepo, price.ProductID)
+		applied++
+	}
+	return applied, nil
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductPriceUsecaseTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.ProductPriceRepository
+	mockRedisRepo *mocks.RedisRepository
+	uc            interfaces.ProductPriceUsecase
+}
+
+func (s *ProductPriceUsecaseTestSuite) SetupTest() {
+	s.mockRepo = new(mocks.ProductPriceRepository)
+	s.mockRedisRepo = new(mocks.RedisRepository)
+	s.uc = NewProductPriceUsecase(s.mockRepo, s.mockRedisRepo)
+}
+
+func (s *ProductPriceUsecaseTestSuite) TestSchedulePrice() {
+	price := int64(4500000)
+
+	s.Run("Success", func() {
+		validFrom := time.Now().Add(24 * time.Hour)
+		req := &request.ProductPriceSchedule{Price: &price, ValidFrom: &validFrom, CreatedBy: "arya"}
+
+		s.mockRepo.On("Schedule", mock.Anything, mock.MatchedBy(func(p *entity.ProductPrice) bool {
+			return p.ProductID == 1 && p.Price == price && p.ValidFrom.Equal(validFrom) && p.AppliedAt == nil && p.CreatedBy == "arya"
+		})).Return(nil).Once()
+
+		res, err := s.uc.SchedulePrice(context.Background(), 1, req)
+
+		s.NoError(err)
+		s.Equal(entity.PriceScheduled, res.Status)
+	})
+
+	s.Run("Valid From In The Past", func() {
+		validFrom := time.Now().Add(-time.Minute)
+		req := &request.ProductPriceSchedule{Price: &price, ValidFrom: &validFrom, CreatedBy: "arya"}
+
+		res, err := s.uc.SchedulePrice(context.Background(), 1, req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(res)
+	})
+
+	s.Run("Validation Error", func() {
+		res, err := s.uc.SchedulePrice(context.Background(), 1, &request.ProductPriceSchedule{CreatedBy: "arya"})
+
+		s.Error(err)
+		s.Nil(res)
+	})
+
+	s.Run("Product Not Found", func() {
+		validFrom := time.Now().Add(time.Hour)
+		req := &request.ProductPriceSchedule{Price: &price, ValidFrom: &validFrom, CreatedBy: "arya"}
+
+		s.mockRepo.On("Schedule", mock.Anything, mock.Anything).Return(constant.ErrNotFound).Once()
+
+		res, err := s.uc.SchedulePrice(context.Background(), 999, req)
+
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(res)
+	})
+}
+
+func (s *ProductPriceUsecaseTestSuite) TestListPriceHistory() {
+
+	s.Run("Success", func() {
+		prices := []entity.ProductPrice{{ID: 2, ProductID: 1, Price: 4500000, Status: entity.PriceCurrent}}
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(1), 1, 10).Return(prices, int64(1), nil).Once()
+
+		result, pagination, err := s.uc.ListPriceHistory(context.Background(), 1, 1, 10)
+
+		s.NoError(err)
+		s.Equal(prices, result)
+		s.Equal(1, pagination.Total)
+	})
+
+	s.Run("Empty", func() {
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(9), 1, 10).Return(nil, int64(0), nil).Once()
+
+		result, _, err := s.uc.ListPriceHistory(context.Background(), 9, 1, 10)
+
+		s.NoError(err)
+		s.NotNil(result)
+		s.Empty(result)
+	})
+}
+
+func (s *ProductPriceUsecaseTestSuite) TestApplyDuePrices() {
+
+	s.Run("Applies Until None Due", func() {
+		s.mockRepo.On("ApplyNext", mock.Anything, mock.Anything).Return(&entity.ProductPrice{ID: 4, ProductID: 1}, nil).Once()
+		s.mockRepo.On("ApplyNext", mock.Anything, mock.Anything).Return(&entity.ProductPrice{ID: 5, ProductID: 2}, nil).Once()
+		s.mockRepo.On("ApplyNext", mock.Anything, mock.Anything).Return(nil, constant.ErrNotFound).Once()
+		for _, id := range []int64{1, 2} {
+			s.mockRedisRepo.On("Delete", mock.Anything, fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, id)).Return(nil).Once()
+			s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+			s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+		}
+
+		applied, err := s.uc.ApplyDuePrices(context.Background())
+
+		s.NoError(err)
+		s.Equal(2, applied)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Carries On After A Failed Price", func() {
+		s.mockRepo.On("ApplyNext", mock.Anything, mock.Anything).Return(&entity.ProductPrice{ID: 4, ProductID: 1, Status: entity.PriceFailed}, nil).Once()
+		s.mockRepo.On("ApplyNext", mock.Anything, mock.Anything).Return(&entity.ProductPrice{ID: 5, ProductID: 2, Status: entity.PriceCurrent}, nil).Once()
+		s.mockRepo.On("ApplyNext", mock.Anything, mock.Anything).Return(nil, constant.ErrNotFound).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, 2)).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+
+		applied, err := s.uc.ApplyDuePrices(context.Background())
+
+		s.NoError(err)
+		s.Equal(1, applied)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Repository Error", func() {
+		s.mockRepo.On("ApplyNext", mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		applied, err := s.uc.ApplyDuePrices(context.Background())
+
+		s.Error(err)
+		s.Zero(applied)
+	})
+}
+
+func TestProductPriceUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(ProductPriceUsecaseTestSuite))
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"erajaya-test/internal/interfaces"
@@ -12,29 +16,58 @@ import (
 	"erajaya-test/internal/repository"
 	"erajaya-test/shared/constant"
 	"erajaya-test/shared/response"
+	"erajaya-test/shared/storage"
+	"erajaya-test/shared/utils"
 
 	"github.com/google/go-querystring/query"
 )
 
+const (
+	maxSuggestions = 10
+	// autocompleteRebuildBatch is how many products a rebuild reads per query.
+	autocompleteRebuildBatch = 500
+	// bulkInsertBatch is how many products a bulk create inserts per statement.
+	bulkInsertBatch = 200
+)
+
 type productUsecase struct {
-	repo      interfaces.ProductRepository
-	redisRepo repository.RedisRepository
+	repo         interfaces.ProductRepository
+	redisRepo    repository.RedisRepository
+	autocomplete interfaces.AutocompleteRepository
+	attributes   interfaces.AttributeRepository
+	store        storage.Storage
+	validator    *utils.CustomValidator
 }
 
-func NewProductUsecase(repo interfaces.ProductRepository, redisRepo repository.RedisRepository) interfaces.ProductUsecase {
+func NewProductUsecase(repo interfaces.ProductRepository, redisRepo repository.RedisRepository, autocomplete interfaces.AutocompleteRepository, attributes interfaces.AttributeRepository, store storage.Storage) interfaces.ProductUsecase {
 	return &productUsecase{
-		repo:      repo,
-		redisRepo: redisRepo,
+		repo:         repo,
+		redisRepo:    redisRepo,
+		autocomplete: autocomplete,
+		attributes:   attributes,
+		store:        store,
+		validator:    utils.NewValidator(),
 	}
 }
 
 func (u *productUsecase) CreateProduct(ctx context.Context, req *request.Product) error {
 
+	if err := publishWindow(req.PublishAt, req.UnpublishAt); err != nil {
+		return err
+	}
+
 	product := &entity.Product{
 		Name:        req.Name,
+		SKU:         optionalString(req.SKU),
+		Barcode:     optionalString(req.Barcode),
+		BrandID:     req.BrandID,
 		Price:       req.Price,
+		Currency:    currencyOrBase(req.Currency),
 		Description: req.Description,
 		Quantity:    req.Quantity,
+		Status:      statusOrActive(req.Status),
+		PublishAt:   req.PublishAt,
+		UnpublishAt: req.UnpublishAt,
 		CreatedBy:   req.CreatedBy,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
@@ -46,10 +79,101 @@ func (u *productUsecase) CreateProduct(ctx context.Context, req *request.Product
 	}
 
 	_ = u.redisRepo.Delete(ctx, "products*")
+	if product.IsPublished(time.Now()) {
+		_ = u.autocomplete.Index(ctx, *product)
+	}
 
 	return nil
 }
 
+// CreateProductsBulk validates every item on its own and reports the outcome by
+// item index. In atomic mode (the default) nothing is inserted unless every
+// item is valid. In best-effort mode valid items are inserted batch by batch,
+// and a batch the database rejects is retried row by row to single out the
+// rows at fault.
+func (u *productUsecase) CreateProductsBulk(ctx context.Context, req *request.ProductBulk) (response.BulkResult, error) {
+
+	result := response.BulkResult{Items: make([]response.BulkItem, len(req.Products))}
+
+	now := time.Now()
+	products := make([]*entity.Product, 0, len(req.Products))
+	indexes := make([]int, 0, len(req.Products))
+	for i, item := range req.Products {
+		result.Items[i].Index = i
+
+		if err := u.validator.Validate(&item); err != nil {
+			result.Items[i].Error = utils.ParseValidationErrors(err)
+			result.Failed++
+			continue
+		}
+		if err := publishWindow(item.PublishAt, item.UnpublishAt); err != nil {
+			result.Items[i].Error = err.Error()
+			result.Failed++
+			continue
+		}
+
+		products = append(products, &entity.Product{
+			Name:        item.Name,
+			SKU:         optionalString(item.SKU),
+			Barcode:     optionalString(item.Barcode),
+			BrandID:     item.BrandID,
+			Price:       item.Price,
+			Currency:    currencyOrBase(item.Currency),
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			Status:      statusOrActive(item.Status),
+			PublishAt:   item.PublishAt,
+			UnpublishAt: item.UnpublishAt,
+			CreatedBy:   item.CreatedBy,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+		indexes = append(indexes, i)
+	}
+
+	if req.Mode == request.BulkModeBestEffort {
+		for start := 0; start < len(products); start += bulkInsertBatch {
+			batch := products[start:min(start+bulkInsertBatch, len(products))]
+			if err := u.repo.CreateBatch(ctx, batch, bulkInsertBatch); err == nil {
+				continue
+			}
+
+			for j, product := range batch {
+				product.ID = 0
+				if err := u.repo.Create(ctx, product); err != nil {
+					product.ID = 0
+					result.Items[indexes[start+j]].Error = err.Error()
+					result.Failed++
+				}
+			}
+		}
+	} else {
+		if result.Failed > 0 {
+			return result, nil
+		}
+		if err := u.repo.CreateBatch(ctx, products, bulkInsertBatch); err != nil {
+			return response.BulkResult{}, err
+		}
+	}
+
+	for j, product := range products {
+		if product.ID == 0 {
+			continue
+		}
+		result.Items[indexes[j]].ID = product.ID
+		result.Created++
+		if product.IsPublished(now) {
+			_ = u.autocomplete.Index(ctx, *product)
+		}
+	}
+
+	if result.Created > 0 {
+		_ = u.redisRepo.Delete(ctx, "products*")
+	}
+
+	return result, nil
+}
+
 func (u *productUsecase) GetProductByID(ctx context.Context, id int64) (*entity.Product, error) {
 
 	key := fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, id)
@@ -73,36 +197,644 @@ func (u *productUsecase) GetProductByID(ctx context.Context, id int64) (*entity.
 	return product, nil
 }
 
-func (u *productUsecase) ListProducts(ctx context.Context, filter request.ProductFilter) ([]entity.Product, response.StdPagination, error) {
+func (u *productUsecase) GetProductBySKU(ctx context.Context, sku string) (*entity.Product, error) {
+	return u.getProductByAlias(ctx, constant.RedisKeyProductSKU, sku, u.repo.GetBySKU, func(product *entity.Product) bool {
+		return product.SKU != nil && *product.SKU == sku
+	})
+}
 
-	query, _ := query.Values(filter)
-	queryString := query.Encode()
+func (u *productUsecase) GetProductBySlug(ctx context.Context, slug string) (*entity.Product, error) {
+	return u.getProductByAlias(ctx, constant.RedisKeyProductSlug, slug, u.repo.GetBySlug, func(product *entity.Product) bool {
+		return product.Slug == slug
+	})
+}
 
-	key := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, queryString)
+// getProductByAlias resolves an alternate key to the product id through a
+// cached alias, then reads the product through the detail cache so every
+// lookup shares one cached copy. An alias left behind by a changed or deleted
+// product fails the matches check and falls back to the database.
+func (u *productUsecase) getProductByAlias(ctx context.Context, prefix string, value string, lookup func(ctx context.Context, value string) (*entity.Product, error), matches func(product *entity.Product) bool) (*entity.Product, error) {
+
+	key := fmt.Sprintf("%s:%s", prefix, value)
 
 	val, err := u.redisRepo.Get(ctx, key)
 	if err == nil {
-		var result entity.FetchResult
-		if err := json.Unmarshal([]byte(val), &result); err == nil {
-			pagination := response.StandardPagination(filter.Page, filter.Limit, result.Total)
-			return result.Products, pagination, nil
+		if id, err := strconv.ParseInt(val, 10, 64); err == nil {
+			product, err := u.GetProductByID(ctx, id)
+			if err == nil && matches(product) {
+				return product, nil
+			}
 		}
 	}
 
-	products, total, err := u.repo.Fetch(ctx, filter)
+	product, err := lookup(ctx, value)
 	if err != nil {
+		return nil, err
+	}
+
+	data, _ := json.Marshal(product)
+	_ = u.redisRepo.Set(ctx, fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, product.ID), data, 5*time.Minute)
+	_ = u.redisRepo.Set(ctx, key, strconv.FormatInt(product.ID, 10), 5*time.Minute)
+
+	return product, nil
+}
+
+func (u *productUsecase) ListProducts(ctx context.Context, filter request.ProductFilter) ([]entity.Product, response.StdPagination, error) {
+
+	if err := u.validator.Validate(&filter); err != nil {
+		return nil, response.StdPagination{}, err
+	}
+	if err := filterRanges(filter); err != nil {
 		return nil, response.StdPagination{}, err
 	}
 
-	pagination := response.StandardPagination(filter.Page, filter.Limit, total)
+	sortFields, err := request.ParseProductSort(filter.Sort, filter.Search)
+	if err != nil {
+		return nil, response.StdPagination{}, err
+	}
+	filter.Sort = request.FormatProductSort(sortFields)
 
-	if len(products) > 0 {
-		result := entity.FetchResult{
+	// The same id set in any order must share one cache entry.
+	if len(filter.IDs) > 0 {
+		filter.IDs = slices.Compact(slices.Sorted(slices.Values(filter.IDs)))
+	}
+	if len(filter.BrandIDs) > 0 {
+		filter.BrandIDs = slices.Compact(slices.Sorted(slices.Values(filter.BrandIDs)))
+	}
+	if len(filter.Facets) > 0 {
+		filter.Facets = slices.Compact(slices.Sorted(slices.Values(filter.Facets)))
+	}
+	if len(filter.Statuses) > 0 {
+		filter.Statuses = slices.Compact(slices.Sorted(slices.Values(filter.Statuses)))
+	}
+	if err := u.resolveAttributeFilters(ctx, &filter); err != nil {
+		return nil, response.StdPagination{}, err
+	}
+
+	if filter.Cursor != "" {
+		cursor, err := request.DecodeProductCursor(filter.Cursor)
+		if err != nil || cursor.Sort != filter.Sort {
+			return nil, response.StdPagination{}, fmt.Errorf("%w: cursor does not belong to this listing", constant.ErrValidation)
+		}
+	}
+
+	// Keyset pages read one extra row to know whether another page follows.
+	keyset := filter.Cursor != "" || filter.SkipTotal
+	fetchFilter := filter
+	if keyset {
+		fetchFilter.Limit = filter.Limit + 1
+	}
+
+	// Facets are cached on their own, so the listing is stored the same with or
+	// without them.
+	listFilter := filter
+	listFilter.Facets = nil
+	query, _ := query.Values(listFilter)
+	queryString := query.Encode()
+
+	key := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, queryString)
+
+	var result entity.FetchResult
+	val, err := u.redisRepo.Get(ctx, key)
+	if err != nil || json.Unmarshal([]byte(val), &result) != nil {
+		products, total, err := u.repo.Fetch(ctx, fetchFilter)
+		if err != nil {
+			return nil, response.StdPagination{}, err
+		}
+
+		result = entity.FetchResult{
 			Products: products,
 			Total:    total,
 		}
-		data, _ := json.Marshal(result)
-		_ = u.redisRepo.Set(ctx, key, data, 5*time.Minute)
+		if len(products) > 0 {
+			data, _ := json.Marshal(result)
+			_ = u.redisRepo.Set(ctx, key, data, 5*time.Minute)
+		}
+	}
+
+	facets, err := u.productFacets(ctx, filter)
+	if err != nil {
+		return nil, response.StdPagination{}, err
+	}
+
+	products, pagination := paginateProducts(filter, keyset, result.Products, result.Total)
+	if facets != nil {
+		pagination.Facets = facets
 	}
 	return products, pagination, nil
 }
+
+// productFacets counts the facets the filter asks for, or returns nil when it
+// asks for none. The counts do not depend on sorting or paging, so their
+// cache key leaves those out and every page of a listing shares one entry.
+func (u *productUsecase) productFacets(ctx context.Context, filter request.ProductFilter) (*entity.ProductFacets, error) {
+	if len(filter.Facets) == 0 {
+		return nil, nil
+	}
+
+	filter.Sort, filter.Page, filter.Limit, filter.Cursor, filter.SkipTotal = "", 0, 0, "", false
+	query, _ := query.Values(filter)
+	key := fmt.Sprintf("%s:%s", constant.RedisKeyProductFacets, query.Encode())
+
+	val, err := u.redisRepo.Get(ctx, key)
+	if err == nil {
+		var facets entity.ProductFacets
+		if err := json.Unmarshal([]byte(val), &facets); err == nil {
+			return &facets, nil
+		}
+	}
+
+	facets := &entity.ProductFacets{}
+	for _, facet := range filter.Facets {
+		var err error
+		switch facet {
+		case request.FacetBrand:
+			facets.Brands, err = u.repo.BrandFacets(ctx, filter)
+		case request.FacetCategory:
+			facets.Categories, err = u.repo.CategoryFacets(ctx, filter)
+		case request.FacetPrice:
+			facets.Prices, err = u.repo.PriceFacets(ctx, filter, request.PriceFacetBounds)
+		case request.FacetInStock:
+			facets.Stock, err = u.repo.StockFacets(ctx, filter)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data, _ := json.Marshal(facets)
+	_ = u.redisRepo.Set(ctx, key, data, 5*time.Minute)
+
+	return facets, nil
+}
+
+// ExportProducts streams every product the filter selects to fn, uncached and
+// without paging. The filter is checked before the first row is read so a bad
+// request can still be answered with an error.
+func (u *productUsecase) ExportProducts(ctx context.Context, filter request.ProductFilter, fn func(product entity.Product) error) error {
+
+	if err := u.validator.Validate(&filter); err != nil {
+		return err
+	}
+	if err := filterRanges(filter); err != nil {
+		return err
+	}
+
+	if _, err := request.ParseProductSort(filter.Sort, filter.Search); err != nil {
+		return err
+	}
+	if err := u.resolveAttributeFilters(ctx, &filter); err != nil {
+		return err
+	}
+
+	return u.repo.Stream(ctx, filter, fn)
+}
+
+// resolveAttributeFilters checks the attribute filters against the attribute
+// definitions and sets filter.AttributeConditions with the values typed. The
+// filters are rewritten in a canonical form and order, so equal filters share
+// one cache entry.
+func (u *productUsecase) resolveAttributeFilters(ctx context.Context, filter *request.ProductFilter) error {
+	if len(filter.Attributes) == 0 {
+		return nil
+	}
+
+	type parsedFilter struct {
+		code     string
+		operator string
+		values   []string
+	}
+	parsed := make([]parsedFilter, len(filter.Attributes))
+	codes := make([]string, len(filter.Attributes))
+	for i, raw := range filter.Attributes {
+		code, operator, values, err := request.ParseAttributeFilter(raw)
+		if err != nil {
+			return err
+		}
+		parsed[i] = parsedFilter{code: code, operator: operator, values: values}
+		codes[i] = code
+	}
+
+	attributes, err := u.attributes.FetchByCodes(ctx, slices.Compact(slices.Sorted(slices.Values(codes))))
+	if err != nil {
+		return err
+	}
+	known := make(map[string]entity.Attribute, len(attributes))
+	for _, attribute := range attributes {
+		known[attribute.Code] = attribute
+	}
+
+	conditions := make(map[string]request.AttributeCondition, len(parsed))
+	for _, p := range parsed {
+		attribute, ok := known[p.code]
+		if !ok {
+			return fmt.Errorf("%w: attr.%s is not a known attribute", constant.ErrValidation, p.code)
+		}
+		if p.operator != request.AttributeEqual && !attribute.Numeric() {
+			return fmt.Errorf("%w: attr.%s can only be compared with =", constant.ErrValidation, p.code)
+		}
+
+		condition := request.AttributeCondition{Code: p.code, Operator: p.operator}
+		texts := make([]string, len(p.values))
+		for i, text := range p.values {
+			value, err := parseAttributeValue(attribute, text)
+			if err != nil {
+				return err
+			}
+			condition.Values = append(condition.Values, value)
+			texts[i] = fmt.Sprint(value)
+		}
+		conditions[p.code+p.operator+strings.Join(texts, ",")] = condition
+	}
+
+	filter.Attributes = slices.Sorted(maps.Keys(conditions))
+	filter.AttributeConditions = make([]request.AttributeCondition, len(filter.Attributes))
+	for i, key := range filter.Attributes {
+		filter.AttributeConditions[i] = conditions[key]
+	}
+	return nil
+}
+
+func paginateProducts(filter request.ProductFilter, keyset bool, products []entity.Product, total int64) ([]entity.Product, response.StdPagination) {
+
+	var pagination response.StdPagination
+	if keyset {
+		hasMore := len(products) > filter.Limit
+		if hasMore {
+			products = products[:filter.Limit]
+		}
+		pagination = response.KeysetPagination(filter.Page, filter.Limit, total, hasMore, filter.Cursor != "" || filter.Page > 1, "")
+	} else {
+		pagination = response.StandardPagination(filter.Page, filter.Limit, total)
+	}
+
+	if pagination.NextPage && len(products) > 0 {
+		sortFields, _ := request.ParseProductSort(filter.Sort, filter.Search)
+		last := products[len(products)-1]
+		values := make([]string, len(sortFields))
+		for i, field := range sortFields {
+			values[i] = last.SortValue(field.Field)
+		}
+		pagination.NextCursor = request.EncodeProductCursor(request.ProductCursor{
+			Sort:   filter.Sort,
+			Values: values,
+		})
+	}
+
+	return products, pagination
+}
+
+func (u *productUsecase) UpdateProduct(ctx context.Context, id int64, version int64, req *request.ProductUpdate) (*entity.Product, error) {
+
+	product := &entity.Product{
+		ID:          id,
+		Version:     version,
+		Name:        req.Name,
+		SKU:         optionalString(req.SKU),
+		Barcode:     optionalString(req.Barcode),
+		BrandID:     req.BrandID,
+		Price:       req.Price,
+		Currency:    req.Currency,
+		Description: req.Description,
+		UpdatedBy:   req.UpdatedBy,
+		UpdatedAt:   time.Now(),
+	}
+
+	err := u.repo.Update(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	u.invalidateProductCache(ctx, id)
+	u.syncAutocomplete(ctx, *product)
+
+	return product, nil
+}
+
+func (u *productUsecase) PatchProduct(ctx context.Context, id int64, version int64, patch map[string]interface{}) (*entity.Product, error) {
+
+	current, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if version > 0 && current.Version != version {
+		return nil, constant.ErrVersionMismatch
+	}
+
+	if _, ok := patch["quantity"]; ok {
+		return nil, fmt.Errorf("%w: quantity only changes through stock movements", constant.ErrValidation)
+	}
+	for _, field := range []string{"status", "publish_at", "unpublish_at"} {
+		if _, ok := patch[field]; ok {
+			return nil, fmt.Errorf("%w: %s only changes through a status transition", constant.ErrValidation, field)
+		}
+	}
+
+	// updated_by is left out of the base document so every patch has to name its actor.
+	base, _ := json.Marshal(request.ProductUpdate{
+		Name:        current.Name,
+		SKU:         derefString(current.SKU),
+		Barcode:     derefString(current.Barcode),
+		BrandID:     current.BrandID,
+		Price:       current.Price,
+		Currency:    current.Currency,
+		Description: current.Description,
+	})
+
+	var doc map[string]interface{}
+	_ = json.Unmarshal(base, &doc)
+	delete(doc, "updated_by")
+
+	merged, _ := json.Marshal(utils.MergePatch(doc, patch))
+
+	var req request.ProductUpdate
+	if err := json.Unmarshal(merged, &req); err != nil {
+		return nil, fmt.Errorf("%w: %v", constant.ErrValidation, err)
+	}
+
+	if err := u.validator.Validate(&req); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{
+		"updated_at": time.Now(),
+		"updated_by": req.UpdatedBy,
+	}
+	if _, ok := patch["name"]; ok {
+		fields["name"] = req.Name
+	}
+	if _, ok := patch["sku"]; ok {
+		fields["sku"] = optionalString(req.SKU)
+	}
+	if _, ok := patch["barcode"]; ok {
+		fields["barcode"] = optionalString(req.Barcode)
+	}
+	if _, ok := patch["brand_id"]; ok {
+		fields["brand_id"] = req.BrandID
+	}
+	if _, ok := patch["price"]; ok {
+		fields["price"] = req.Price
+	}
+	if _, ok := patch["currency"]; ok && req.Currency != "" {
+		fields["currency"] = req.Currency
+	}
+	if _, ok := patch["description"]; ok {
+		fields["description"] = req.Description
+	}
+
+	product, err := u.repo.Patch(ctx, id, version, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	u.invalidateProductCache(ctx, id)
+	if _, renamed := fields["name"]; renamed {
+		u.syncAutocomplete(ctx, *product)
+	}
+
+	return product, nil
+}
+
+func (u *productUsecase) DeleteProduct(ctx context.Context, id int64, version int64, req *request.ProductDelete) error {
+
+	err := u.repo.Delete(ctx, id, version, req.DeletedBy)
+	if err != nil {
+		return err
+	}
+
+	u.invalidateProductCache(ctx, id)
+	_ = u.autocomplete.Remove(ctx, id)
+
+	return nil
+}
+
+func (u *productUsecase) RestoreProduct(ctx context.Context, id int64, version int64, req *request.ProductRestore) (*entity.Product, error) {
+
+	product, err := u.repo.Restore(ctx, id, version, req.UpdatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	u.invalidateProductCache(ctx, id)
+	u.syncAutocomplete(ctx, *product)
+
+	return product, nil
+}
+
+// TransitionProductStatus moves a product to another status and replaces its
+// publish window. The transition is checked against the status the product
+// is in, and written only if the product is still at the version it was
+// checked at.
+func (u *productUsecase) TransitionProductStatus(ctx context.Context, id int64, version int64, req *request.ProductStatus) (*entity.Product, error) {
+
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+	if err := publishWindow(req.PublishAt, req.UnpublishAt); err != nil {
+		return nil, err
+	}
+
+	current, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if version > 0 && current.Version != version {
+		return nil, constant.ErrVersionMismatch
+	}
+	if !entity.CanTransition(current.Status, req.Status) {
+		return nil, fmt.Errorf("%w: a %s product cannot become %s", constant.ErrConflict, current.Status, req.Status)
+	}
+
+	product, err := u.repo.Patch(ctx, id, current.Version, map[string]interface{}{
+		"status":       req.Status,
+		"publish_at":   req.PublishAt,
+		"unpublish_at": req.UnpublishAt,
+		"updated_at":   time.Now(),
+		"updated_by":   req.UpdatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.invalidateProductCache(ctx, id)
+	u.syncAutocomplete(ctx, *product)
+
+	return product, nil
+}
+
+func (u *productUsecase) PurgeDeletedProducts(ctx context.Context, retention time.Duration) (int64, error) {
+
+	purged, keys, err := u.repo.Purge(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return 0, err
+	}
+
+	// The media rows went with the products, their files are removed here.
+	for _, key := range keys {
+		_ = u.store.Delete(ctx, key)
+	}
+
+	if purged > 0 {
+		_ = u.redisRepo.Delete(ctx, constant.RedisKeyProductList+"*")
+		_ = u.redisRepo.Delete(ctx, constant.RedisKeyProductFacets+"*")
+	}
+
+	return purged, nil
+}
+
+// SyncPublishWindows brings the autocomplete index and the cached listings in
+// line with the publish windows that opened or closed after from and no later
+// than to, which no write announces.
+func (u *productUsecase) SyncPublishWindows(ctx context.Context, from time.Time, to time.Time) (int64, error) {
+
+	products, err := u.repo.FetchPublishChanges(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, product := range products {
+		u.syncAutocomplete(ctx, product)
+	}
+	if len(products) > 0 {
+		_ = u.redisRepo.Delete(ctx, constant.RedisKeyProductList+"*")
+		_ = u.redisRepo.Delete(ctx, constant.RedisKeyProductFacets+"*")
+	}
+
+	return int64(len(products)), nil
+}
+
+func (u *productUsecase) ListProductHistory(ctx context.Context, id int64, page int, limit int) ([]entity.AuditEvent, response.StdPagination, error) {
+	events, total, err := u.repo.FetchHistory(ctx, id, page, limit)
+	if err != nil {
+		return nil, response.StdPagination{}, err
+	}
+	if events == nil {
+		events = []entity.AuditEvent{}
+	}
+	return events, response.StandardPagination(page, limit, total), nil
+}
+
+func (u *productUsecase) SuggestProducts(ctx context.Context, term string, limit int) ([]entity.ProductSuggestion, error) {
+
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return nil, fmt.Errorf("%w: q is required", constant.ErrValidation)
+	}
+	if limit <= 0 || limit > maxSuggestions {
+		limit = maxSuggestions
+	}
+
+	return u.repo.Suggest(ctx, term, limit)
+}
+
+func (u *productUsecase) AutocompleteProducts(ctx context.Context, prefix string, limit int) ([]entity.ProductAutocomplete, error) {
+
+	if strings.TrimSpace(prefix) == "" {
+		return nil, fmt.Errorf("%w: q is required", constant.ErrValidation)
+	}
+	if limit <= 0 || limit > maxSuggestions {
+		limit = maxSuggestions
+	}
+
+	return u.autocomplete.Search(ctx, prefix, limit)
+}
+
+// RebuildAutocomplete repopulates the autocomplete index from every published
+// product, walking the table in id order.
+func (u *productUsecase) RebuildAutocomplete(ctx context.Context) (int64, error) {
+
+	filter := request.ProductFilter{Published: true, Sort: "id", Page: 1, Limit: autocompleteRebuildBatch, SkipTotal: true}
+
+	return u.autocomplete.Rebuild(ctx, func(ctx context.Context) ([]entity.Product, error) {
+		products, _, err := u.repo.Fetch(ctx, filter)
+		if err != nil || len(products) == 0 {
+			return products, err
+		}
+
+		last := products[len(products)-1]
+		filter.Cursor = request.EncodeProductCursor(request.ProductCursor{
+			Sort:   filter.Sort,
+			Values: []string{last.SortValue("id")},
+		})
+		return products, nil
+	})
+}
+
+// optionalString stores a blank identifier as NULL, which the unique indexes
+// accept on any number of products.
+func optionalString(value string) *string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// currencyOrBase prices a new product in the base currency unless the request
+// names another.
+func currencyOrBase(currency string) string {
+	if currency == "" {
+		return constant.BaseCurrency
+	}
+	return currency
+}
+
+// statusOrActive starts a new product active, as products were before they
+// had a lifecycle, unless the request names another status.
+func statusOrActive(status string) string {
+	if status == "" {
+		return entity.ProductActive
+	}
+	return status
+}
+
+// publishWindow checks that a publish window ends after it begins.
+func publishWindow(publishAt, unpublishAt *time.Time) error {
+	if publishAt != nil && unpublishAt != nil && !unpublishAt.After(*publishAt) {
+		return fmt.Errorf("%w: unpublish_at must be after publish_at", constant.ErrValidation)
+	}
+	return nil
+}
+
+// filterRanges checks that a range filter with both bounds does not end before
+// it begins. Either bound alone leaves that end of the range open.
+func filterRanges(filter request.ProductFilter) error {
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MaxPrice < *filter.MinPrice {
+		return fmt.Errorf("%w: max_price must not be below min_price", constant.ErrValidation)
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && filter.CreatedTo.Before(*filter.CreatedFrom) {
+		return fmt.Errorf("%w: created_to must not be before created_from", constant.ErrValidation)
+	}
+	return nil
+}
+
+// syncAutocomplete keeps a product in the autocomplete index only while the
+// public can see it.
+func (u *productUsecase) syncAutocomplete(ctx context.Context, product entity.Product) {
+	if product.IsPublished(time.Now()) {
+		_ = u.autocomplete.Index(ctx, product)
+		return
+	}
+	_ = u.autocomplete.Remove(ctx, product.ID)
+}
+
+func (u *productUsecase) invalidateProductCache(ctx context.Context, id int64) {
+	invalidateProductCaches(ctx, u.redisRepo, id)
+}
+
+// invalidateProductCaches drops the cached detail of a product after a write to
+// it or to anything the detail embeds, and every cached listing and facet
+// count, which carry its version and may show, filter or count what changed.
+func invalidateProductCaches(ctx context.Context, redisRepo repository.RedisRepository, id int64) {
+	_ = redisRepo.Delete(ctx, fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, id))
+	_ = redisRepo.Delete(ctx, constant.RedisKeyProductList+"*")
+	_ = redisRepo.Delete(ctx, constant.RedisKeyProductFacets+"*")
+}
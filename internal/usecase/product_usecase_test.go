@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,7 +15,10 @@ import (
 	"erajaya-test/internal/models/request"
 	"erajaya-test/mocks"
 	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/storage"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/google/go-querystring/query"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -21,15 +26,27 @@ import (
 
 type ProductUsecaseTestSuite struct {
 	suite.Suite
-	mockRepo      *mocks.ProductRepository
-	mockRedisRepo *mocks.RedisRepository
-	uc            interfaces.ProductUsecase
+	mockRepo         *mocks.ProductRepository
+	mockRedisRepo    *mocks.RedisRepository
+	mockAutocomplete *mocks.AutocompleteRepository
+	mockAttributes   *mocks.AttributeRepository
+	store            storage.Storage
+	root             string
+	uc               interfaces.ProductUsecase
 }
 
 func (s *ProductUsecaseTestSuite) SetupTest() {
 	s.mockRepo = new(mocks.ProductRepository)
 	s.mockRedisRepo = new(mocks.RedisRepository)
-	s.uc = NewProductUsecase(s.mockRepo, s.mockRedisRepo)
+	s.mockAutocomplete = new(mocks.AutocompleteRepository)
+	s.mockAttributes = new(mocks.AttributeRepository)
+
+	s.root = s.T().TempDir()
+	store, err := storage.NewLocalStorage(storage.Config{Root: s.root, BaseURL: "http://cdn.test/media"})
+	s.Require().NoError(err)
+	s.store = store
+
+	s.uc = NewProductUsecase(s.mockRepo, s.mockRedisRepo, s.mockAutocomplete, s.mockAttributes, s.store)
 }
 
 func (s *ProductUsecaseTestSuite) TestCreateProduct() {
@@ -46,16 +63,43 @@ func (s *ProductUsecaseTestSuite) TestCreateProduct() {
 	s.Run("Success", func() {
 
 		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(p *entity.Product) bool {
-			return p.Name == "LG TV" && p.CreatedBy == "arya"
+			return p.Name == "LG TV" && p.Currency == constant.BaseCurrency && p.Status == entity.ProductActive && p.CreatedBy == "arya"
 		})).Return(nil).Once()
 
 		s.mockRedisRepo.On("Delete", mock.Anything, "products*").Return(nil).Once()
+		s.mockAutocomplete.On("Index", mock.Anything, mock.MatchedBy(func(p entity.Product) bool {
+			return p.Name == "LG TV"
+		})).Return(nil).Once()
 
 		err := s.uc.CreateProduct(context.Background(), req)
 
 		s.NoError(err)
 	})
 
+	s.Run("Draft", func() {
+		draft := *req
+		draft.Status = entity.ProductDraft
+
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(p *entity.Product) bool {
+			return p.Status == entity.ProductDraft
+		})).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products*").Return(nil).Once()
+
+		err := s.uc.CreateProduct(context.Background(), &draft)
+
+		s.NoError(err)
+	})
+
+	s.Run("Publish Window Inverted", func() {
+		publishAt := time.Now()
+		windowed := *req
+		windowed.PublishAt, windowed.UnpublishAt = &publishAt, &publishAt
+
+		err := s.uc.CreateProduct(context.Background(), &windowed)
+
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+
 	s.Run("Repository Error", func() {
 		s.mockRepo.On("Create", mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
 
@@ -66,6 +110,108 @@ func (s *ProductUsecaseTestSuite) TestCreateProduct() {
 	})
 }
 
+func (s *ProductUsecaseTestSuite) TestCreateProductsBulk() {
+	price := int64(5000000)
+	qty := 10
+	valid := func(name string) request.Product {
+		return request.Product{Name: name, Price: &price, Description: "Desc", Quantity: &qty, CreatedBy: "arya"}
+	}
+	assignIDs := func(ids ...int64) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			for i, p := range args.Get(1).([]*entity.Product) {
+				p.ID = ids[i]
+			}
+		}
+	}
+
+	s.Run("Atomic Success", func() {
+		req := &request.ProductBulk{Products: []request.Product{valid("LG TV"), valid("OLED")}}
+
+		s.mockRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(p []*entity.Product) bool {
+			return len(p) == 2 && p[0].Name == "LG TV" && p[1].Name == "OLED"
+		}), 200).Run(assignIDs(1, 2)).Return(nil).Once()
+		s.mockAutocomplete.On("Index", mock.Anything, mock.Anything).Return(nil).Twice()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products*").Return(nil).Once()
+
+		res, err := s.uc.CreateProductsBulk(context.Background(), req)
+
+		s.NoError(err)
+		s.Equal(2, res.Created)
+		s.Equal(0, res.Failed)
+		s.Equal([]response.BulkItem{{Index: 0, ID: 1}, {Index: 1, ID: 2}}, res.Items)
+	})
+
+	s.Run("Atomic Rejects Invalid Item", func() {
+		req := &request.ProductBulk{Products: []request.Product{valid("LG TV"), {Name: "OLED"}}}
+
+		res, err := s.uc.CreateProductsBulk(context.Background(), req)
+
+		s.NoError(err)
+		s.Equal(0, res.Created)
+		s.Equal(1, res.Failed)
+		s.Nil(res.Items[0].Error)
+		s.NotNil(res.Items[1].Error)
+	})
+
+	s.Run("Atomic Repository Error", func() {
+		req := &request.ProductBulk{Products: []request.Product{valid("LG TV")}}
+
+		s.mockRepo.On("CreateBatch", mock.Anything, mock.Anything, 200).Return(errors.New("db error")).Once()
+
+		_, err := s.uc.CreateProductsBulk(context.Background(), req)
+
+		s.Error(err)
+	})
+
+	s.Run("Best Effort Skips Invalid Item", func() {
+		req := &request.ProductBulk{
+			Mode:     request.BulkModeBestEffort,
+			Products: []request.Product{{Name: "LG TV"}, valid("OLED")},
+		}
+
+		s.mockRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(p []*entity.Product) bool {
+			return len(p) == 1 && p[0].Name == "OLED"
+		}), 200).Run(assignIDs(7)).Return(nil).Once()
+		s.mockAutocomplete.On("Index", mock.Anything, mock.Anything).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products*").Return(nil).Once()
+
+		res, err := s.uc.CreateProductsBulk(context.Background(), req)
+
+		s.NoError(err)
+		s.Equal(1, res.Created)
+		s.Equal(1, res.Failed)
+		s.NotNil(res.Items[0].Error)
+		s.Equal(int64(7), res.Items[1].ID)
+	})
+
+	s.Run("Best Effort Retries Failed Batch Row By Row", func() {
+		req := &request.ProductBulk{
+			Mode:     request.BulkModeBestEffort,
+			Products: []request.Product{valid("LG TV"), valid("OLED")},
+		}
+
+		s.mockRepo.On("CreateBatch", mock.Anything, mock.Anything, 200).Return(errors.New("db error")).Once()
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(p *entity.Product) bool {
+			return p.Name == "LG TV"
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.Product).ID = 3
+		}).Return(nil).Once()
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(p *entity.Product) bool {
+			return p.Name == "OLED"
+		})).Return(errors.New("duplicate key")).Once()
+		s.mockAutocomplete.On("Index", mock.Anything, mock.Anything).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products*").Return(nil).Once()
+
+		res, err := s.uc.CreateProductsBulk(context.Background(), req)
+
+		s.NoError(err)
+		s.Equal(1, res.Created)
+		s.Equal(1, res.Failed)
+		s.Equal(int64(3), res.Items[0].ID)
+		s.Equal("duplicate key", res.Items[1].Error)
+	})
+}
+
 func (s *ProductUsecaseTestSuite) TestGetProductByID() {
 	id := int64(1)
 	key := fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, id)
@@ -97,6 +243,24 @@ func (s *ProductUsecaseTestSuite) TestGetProductByID() {
 		s.Equal(mockProduct.ID, result.ID)
 	})
 
+	s.Run("Cache Entry Embeds Variants", func() {
+		variants := []entity.ProductVariant{{ID: 7, ProductID: id, Options: entity.VariantOptions{"color": "Black"}}}
+		withVariants := &entity.Product{ID: id, Name: "Test Product", Variants: variants, Options: entity.OptionsOf(variants)}
+
+		s.mockRedisRepo.On("Get", mock.Anything, key).Return("", errors.New("redis: nil")).Once()
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(withVariants, nil).Once()
+		s.mockRedisRepo.On("Set", mock.Anything, key, mock.MatchedBy(func(data []byte) bool {
+			var cached entity.Product
+			_ = json.Unmarshal(data, &cached)
+			return len(cached.Variants) == 1 && cached.Options[0].Name == "color"
+		}), 5*time.Minute).Return(nil).Once()
+
+		result, err := s.uc.GetProductByID(context.Background(), id)
+
+		s.NoError(err)
+		s.Len(result.Variants, 1)
+	})
+
 	s.Run("Repository Error", func() {
 		s.mockRedisRepo.On("Get", mock.Anything, key).Return("", errors.New("redis: nil")).Once()
 		s.mockRepo.On("GetByID", mock.Anything, id).Return(nil, errors.New("db error")).Once()
@@ -108,6 +272,76 @@ func (s *ProductUsecaseTestSuite) TestGetProductByID() {
 	})
 }
 
+func (s *ProductUsecaseTestSuite) TestGetProductBySKU() {
+	sku := "LG-TV-42"
+	aliasKey := fmt.Sprintf("%s:%s", constant.RedisKeyProductSKU, sku)
+	detailKey := fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, 1)
+	mockProduct := &entity.Product{ID: 1, SKU: &sku, Name: "LG TV"}
+
+	s.Run("Alias Hit", func() {
+		dataBytes, _ := json.Marshal(mockProduct)
+		s.mockRedisRepo.On("Get", mock.Anything, aliasKey).Return("1", nil).Once()
+		s.mockRedisRepo.On("Get", mock.Anything, detailKey).Return(string(dataBytes), nil).Once()
+
+		result, err := s.uc.GetProductBySKU(context.Background(), sku)
+
+		s.NoError(err)
+		s.Equal(int64(1), result.ID)
+	})
+
+	s.Run("Alias Miss", func() {
+		s.mockRedisRepo.On("Get", mock.Anything, aliasKey).Return("", errors.New("redis: nil")).Once()
+		s.mockRepo.On("GetBySKU", mock.Anything, sku).Return(mockProduct, nil).Once()
+		s.mockRedisRepo.On("Set", mock.Anything, detailKey, mock.Anything, 5*time.Minute).Return(nil).Once()
+		s.mockRedisRepo.On("Set", mock.Anything, aliasKey, "1", 5*time.Minute).Return(nil).Once()
+
+		result, err := s.uc.GetProductBySKU(context.Background(), sku)
+
+		s.NoError(err)
+		s.Equal("LG TV", result.Name)
+	})
+
+	s.Run("Stale Alias Falls Back To Repository", func() {
+		otherSKU := "OLED-55"
+		dataBytes, _ := json.Marshal(&entity.Product{ID: 1, SKU: &otherSKU})
+		renamed := &entity.Product{ID: 2, SKU: &sku}
+		s.mockRedisRepo.On("Get", mock.Anything, aliasKey).Return("1", nil).Once()
+		s.mockRedisRepo.On("Get", mock.Anything, detailKey).Return(string(dataBytes), nil).Once()
+		s.mockRepo.On("GetBySKU", mock.Anything, sku).Return(renamed, nil).Once()
+		s.mockRedisRepo.On("Set", mock.Anything, fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, 2), mock.Anything, 5*time.Minute).Return(nil).Once()
+		s.mockRedisRepo.On("Set", mock.Anything, aliasKey, "2", 5*time.Minute).Return(nil).Once()
+
+		result, err := s.uc.GetProductBySKU(context.Background(), sku)
+
+		s.NoError(err)
+		s.Equal(int64(2), result.ID)
+	})
+
+	s.Run("Not Found", func() {
+		s.mockRedisRepo.On("Get", mock.Anything, aliasKey).Return("", errors.New("redis: nil")).Once()
+		s.mockRepo.On("GetBySKU", mock.Anything, sku).Return(nil, constant.ErrNotFound).Once()
+
+		result, err := s.uc.GetProductBySKU(context.Background(), sku)
+
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(result)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestGetProductBySlug() {
+	aliasKey := fmt.Sprintf("%s:%s", constant.RedisKeyProductSlug, "lg-tv")
+	mockProduct := &entity.Product{ID: 1, Slug: "lg-tv"}
+
+	s.mockRedisRepo.On("Get", mock.Anything, aliasKey).Return("", errors.New("redis: nil")).Once()
+	s.mockRepo.On("GetBySlug", mock.Anything, "lg-tv").Return(mockProduct, nil).Once()
+	s.mockRedisRepo.On("Set", mock.Anything, mock.Anything, mock.Anything, 5*time.Minute).Return(nil).Twice()
+
+	result, err := s.uc.GetProductBySlug(context.Background(), "lg-tv")
+
+	s.NoError(err)
+	s.Equal("lg-tv", result.Slug)
+}
+
 func (s *ProductUsecaseTestSuite) TestListProducts() {
 	filter := request.ProductFilter{
 		Search: "LG",
@@ -116,7 +350,11 @@ func (s *ProductUsecaseTestSuite) TestListProducts() {
 		Limit:  10,
 	}
 
-	v, _ := query.Values(filter)
+	// The legacy sort alias is stored under its canonical field expression.
+	normalized := filter
+	normalized.Sort = "-created_at,-id"
+
+	v, _ := query.Values(normalized)
 	expectedKey := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, v.Encode())
 
 	mockProducts := []entity.Product{
@@ -145,7 +383,7 @@ func (s *ProductUsecaseTestSuite) TestListProducts() {
 
 		s.mockRedisRepo.On("Get", mock.Anything, expectedKey).Return("", errors.New("redis: nil")).Once()
 
-		s.mockRepo.On("Fetch", mock.Anything, filter).Return(mockProducts, mockTotal, nil).Once()
+		s.mockRepo.On("Fetch", mock.Anything, normalized).Return(mockProducts, mockTotal, nil).Once()
 
 		s.mockRedisRepo.On("Set", mock.Anything, expectedKey, mock.Anything, 5*time.Minute).Return(nil).Once()
 
@@ -159,7 +397,7 @@ func (s *ProductUsecaseTestSuite) TestListProducts() {
 
 		s.mockRedisRepo.On("Get", mock.Anything, expectedKey).Return("", errors.New("redis: nil")).Once()
 
-		s.mockRepo.On("Fetch", mock.Anything, filter).Return([]entity.Product{}, int64(0), nil).Once()
+		s.mockRepo.On("Fetch", mock.Anything, normalized).Return([]entity.Product{}, int64(0), nil).Once()
 
 		results, pagination, err := s.uc.ListProducts(context.Background(), filter)
 
@@ -170,7 +408,7 @@ func (s *ProductUsecaseTestSuite) TestListProducts() {
 
 	s.Run("Repository Error", func() {
 		s.mockRedisRepo.On("Get", mock.Anything, expectedKey).Return("", errors.New("redis: nil")).Once()
-		s.mockRepo.On("Fetch", mock.Anything, filter).Return(nil, int64(0), errors.New("db error")).Once()
+		s.mockRepo.On("Fetch", mock.Anything, normalized).Return(nil, int64(0), errors.New("db error")).Once()
 
 		results, _, err := s.uc.ListProducts(context.Background(), filter)
 
@@ -179,6 +417,973 @@ func (s *ProductUsecaseTestSuite) TestListProducts() {
 	})
 }
 
+func (s *ProductUsecaseTestSuite) TestListProductsStructuredFilter() {
+	s.Run("Normalizes IDs For Cache Key", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, IDs: []int64{3, 1, 3, 2}}
+		normalized := filter
+		normalized.Sort = "-created_at,-id"
+		normalized.IDs = []int64{1, 2, 3}
+		v, _ := query.Values(normalized)
+		key := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, v.Encode())
+
+		s.mockRedisRepo.On("Get", mock.Anything, key).Return("", errors.New("redis: nil")).Once()
+		s.mockRepo.On("Fetch", mock.Anything, normalized).Return([]entity.Product{}, int64(0), nil).Once()
+
+		results, _, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.NoError(err)
+		s.Empty(results)
+	})
+
+	s.Run("Price Range Inverted", func() {
+		minPrice, maxPrice := int64(5000), int64(1000)
+		filter := request.ProductFilter{Page: 1, Limit: 10, MinPrice: &minPrice, MaxPrice: &maxPrice}
+
+		results, _, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.ErrorContains(err, "max_price must not be below min_price")
+		s.Nil(results)
+	})
+
+	s.Run("Date Range Inverted", func() {
+		from := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+		to := from.Add(-time.Hour)
+		filter := request.ProductFilter{Page: 1, Limit: 10, CreatedFrom: &from, CreatedTo: &to}
+
+		_, _, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.ErrorContains(err, "created_to must not be before created_from")
+	})
+
+	s.Run("Single Bounds", func() {
+		price := int64(500000)
+		day := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+		for name, filter := range map[string]request.ProductFilter{
+			"min_price":    {Page: 1, Limit: 10, MinPrice: &price},
+			"max_price":    {Page: 1, Limit: 10, MaxPrice: &price},
+			"created_from": {Page: 1, Limit: 10, CreatedFrom: &day},
+			"created_to":   {Page: 1, Limit: 10, CreatedTo: &day},
+		} {
+			normalized := filter
+			normalized.Sort = "-created_at,-id"
+			v, _ := query.Values(normalized)
+			key := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, v.Encode())
+
+			s.mockRedisRepo.On("Get", mock.Anything, key).Return("", errors.New("redis: nil")).Once()
+			s.mockRepo.On("Fetch", mock.Anything, normalized).Return([]entity.Product{}, int64(0), nil).Once()
+
+			_, _, err := s.uc.ListProducts(context.Background(), filter)
+
+			s.NoError(err, name)
+		}
+	})
+
+	s.Run("Negative ID", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, IDs: []int64{1, -2}}
+
+		_, _, err := s.uc.ListProducts(context.Background(), filter)
+
+		var validationErrors validator.ValidationErrors
+		s.ErrorAs(err, &validationErrors)
+	})
+
+	s.Run("Facets", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, BrandIDs: []int64{2, 1}, Facets: []string{"price", "brand", "in_stock", "category"}}
+		normalized := filter
+		normalized.Sort = "-created_at,-id"
+		normalized.BrandIDs = []int64{1, 2}
+		normalized.Facets = []string{"brand", "category", "in_stock", "price"}
+
+		// The listing is cached as without facets, the facets without paging.
+		listed := normalized
+		listed.Facets = nil
+		v, _ := query.Values(listed)
+		key := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, v.Encode())
+		counted := request.ProductFilter{BrandIDs: []int64{1, 2}, Facets: normalized.Facets}
+		v, _ = query.Values(counted)
+		facetKey := fmt.Sprintf("%s:%s", constant.RedisKeyProductFacets, v.Encode())
+
+		products := []entity.Product{{ID: 1, Name: "Galaxy S24"}}
+		brands := []entity.FacetCount{{ID: 2, Name: "Samsung", Count: 1}, {ID: 1, Name: "Apple", Count: 4}}
+		categories := []entity.FacetCount{{ID: 3, Name: "Phones", Count: 5}}
+		prices := []entity.PriceBucket{{Min: 0, Count: 5}}
+		stock := &entity.StockCount{InStock: 4, OutOfStock: 1}
+
+		s.mockRedisRepo.On("Get", mock.Anything, key).Return("", errors.New("redis: nil")).Once()
+		s.mockRepo.On("Fetch", mock.Anything, normalized).Return(products, int64(1), nil).Once()
+		s.mockRedisRepo.On("Set", mock.Anything, key, mock.Anything, 5*time.Minute).Return(nil).Once()
+		s.mockRedisRepo.On("Get", mock.Anything, facetKey).Return("", errors.New("redis: nil")).Once()
+		s.mockRepo.On("BrandFacets", mock.Anything, counted).Return(brands, nil).Once()
+		s.mockRepo.On("CategoryFacets", mock.Anything, counted).Return(categories, nil).Once()
+		s.mockRepo.On("StockFacets", mock.Anything, counted).Return(stock, nil).Once()
+		s.mockRepo.On("PriceFacets", mock.Anything, counted, request.PriceFacetBounds).Return(prices, nil).Once()
+		s.mockRedisRepo.On("Set", mock.Anything, facetKey, mock.MatchedBy(func(data []byte) bool {
+			return strings.Contains(string(data), `"in_stock":{"in_stock":4,"out_of_stock":1}`)
+		}), 5*time.Minute).Return(nil).Once()
+
+		results, pagination, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.NoError(err)
+		s.Len(results, 1)
+		s.Equal(&entity.ProductFacets{Brands: brands, Categories: categories, Prices: prices, Stock: stock}, pagination.Facets)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Facets From Cache", func() {
+		filter := request.ProductFilter{Page: 3, Limit: 10, Sort: "price", Facets: []string{"brand"}}
+		normalized := filter
+		normalized.Sort = "price,id"
+		normalized.Facets = nil
+		v, _ := query.Values(normalized)
+		key := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, v.Encode())
+		v, _ = query.Values(request.ProductFilter{Facets: []string{"brand"}})
+		facetKey := fmt.Sprintf("%s:%s", constant.RedisKeyProductFacets, v.Encode())
+
+		s.mockRedisRepo.On("Get", mock.Anything, key).Return(`{"products":[{"id":1}],"total":21}`, nil).Once()
+		s.mockRedisRepo.On("Get", mock.Anything, facetKey).Return(`{"brand":[{"id":2,"name":"Samsung","count":21}]}`, nil).Once()
+
+		_, pagination, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.NoError(err)
+		s.Equal(&entity.ProductFacets{Brands: []entity.FacetCount{{ID: 2, Name: "Samsung", Count: 21}}}, pagination.Facets)
+	})
+
+	s.Run("Facet Error", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, Facets: []string{"in_stock"}}
+
+		s.mockRedisRepo.On("Get", mock.Anything, mock.Anything).Return("", errors.New("redis: nil")).Twice()
+		s.mockRepo.On("Fetch", mock.Anything, mock.Anything).Return([]entity.Product{}, int64(0), nil).Once()
+		s.mockRepo.On("StockFacets", mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		_, _, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.Error(err)
+	})
+
+	s.Run("Attributes", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, Attributes: []string{"ram_gb>=8.0", "color=black, White"}}
+		attributes := []entity.Attribute{
+			{Code: "color", Type: entity.AttributeEnum, Options: entity.AttributeOptions{"Black", "White"}},
+			{Code: "ram_gb", Type: entity.AttributeUnit, Unit: "GB"},
+		}
+		normalized := filter
+		normalized.Sort = "-created_at,-id"
+		normalized.Attributes = []string{"color=Black,White", "ram_gb>=8"}
+		normalized.AttributeConditions = []request.AttributeCondition{
+			{Code: "color", Operator: request.AttributeEqual, Values: []interface{}{"Black", "White"}},
+			{Code: "ram_gb", Operator: request.AttributeGreaterEqual, Values: []interface{}{float64(8)}},
+		}
+		v, _ := query.Values(normalized)
+		key := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, v.Encode())
+
+		s.mockAttributes.On("FetchByCodes", mock.Anything, []string{"color", "ram_gb"}).Return(attributes, nil).Once()
+		s.mockRedisRepo.On("Get", mock.Anything, key).Return(`{"products":[{"id":1}],"total":1}`, nil).Once()
+
+		results, _, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.NoError(err)
+		s.Len(results, 1)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Unknown Attribute", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, Attributes: []string{"weight=1"}}
+
+		s.mockAttributes.On("FetchByCodes", mock.Anything, []string{"weight"}).Return([]entity.Attribute{}, nil).Once()
+
+		_, _, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+
+	s.Run("Attribute Range On Text", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, Attributes: []string{"color>Black"}}
+
+		s.mockAttributes.On("FetchByCodes", mock.Anything, []string{"color"}).
+			Return([]entity.Attribute{{Code: "color", Type: entity.AttributeEnum, Options: entity.AttributeOptions{"Black"}}}, nil).Once()
+
+		_, _, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.ErrorContains(err, "attr.color can only be compared with =")
+	})
+
+	s.Run("Attribute Value Of Wrong Type", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, Attributes: []string{"ram_gb>=lots"}}
+
+		s.mockAttributes.On("FetchByCodes", mock.Anything, []string{"ram_gb"}).
+			Return([]entity.Attribute{{Code: "ram_gb", Type: entity.AttributeNumber}}, nil).Once()
+
+		_, _, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+
+	s.Run("Unknown Facet", func() {
+		filter := request.ProductFilter{Page: 1, Limit: 10, Facets: []string{"color"}}
+
+		_, _, err := s.uc.ListProducts(context.Background(), filter)
+
+		var validationErrors validator.ValidationErrors
+		s.ErrorAs(err, &validationErrors)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestListProductsKeyset() {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	page := []entity.Product{
+		{ID: 3, Name: "LG TV 3", CreatedAt: createdAt.Add(2 * time.Hour)},
+		{ID: 2, Name: "LG TV 2", CreatedAt: createdAt.Add(time.Hour)},
+		{ID: 1, Name: "LG TV 1", CreatedAt: createdAt},
+	}
+
+	s.Run("Skip Total - More Rows Follow", func() {
+		filter := request.ProductFilter{Sort: "-created_at,-id", Page: 1, Limit: 2, SkipTotal: true}
+		v, _ := query.Values(filter)
+		key := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, v.Encode())
+
+		s.mockRedisRepo.On("Get", mock.Anything, key).Return("", errors.New("redis: nil")).Once()
+		s.mockRepo.On("Fetch", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return f.Limit == 3 && f.SkipTotal
+		})).Return(page, int64(0), nil).Once()
+		s.mockRedisRepo.On("Set", mock.Anything, key, mock.Anything, 5*time.Minute).Return(nil).Once()
+
+		results, pagination, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.NoError(err)
+		s.Len(results, 2)
+		s.True(pagination.NextPage)
+		s.False(pagination.PrevPage)
+
+		cursor, err := request.DecodeProductCursor(pagination.NextCursor)
+		s.NoError(err)
+		s.Equal([]string{createdAt.Add(time.Hour).Format(time.RFC3339Nano), "2"}, cursor.Values)
+	})
+
+	s.Run("Cursor - Last Page From Cache", func() {
+		filter := request.ProductFilter{
+			Sort:  "-created_at,-id",
+			Page:  1,
+			Limit: 5,
+			Cursor: request.EncodeProductCursor(request.ProductCursor{
+				Sort:   "-created_at,-id",
+				Values: []string{createdAt.Format(time.RFC3339Nano), "4"},
+			}),
+		}
+		v, _ := query.Values(filter)
+		key := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, v.Encode())
+
+		dataBytes, _ := json.Marshal(entity.FetchResult{Products: page, Total: 10})
+		s.mockRedisRepo.On("Get", mock.Anything, key).Return(string(dataBytes), nil).Once()
+
+		results, pagination, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.NoError(err)
+		s.Len(results, 3)
+		s.False(pagination.NextPage)
+		s.True(pagination.PrevPage)
+		s.Equal(10, pagination.Total)
+		s.Empty(pagination.NextCursor)
+	})
+
+	s.Run("Page Mode Exposes Next Cursor", func() {
+		price := int64(1000)
+		filter := request.ProductFilter{Sort: "price,id", Page: 1, Limit: 1}
+		v, _ := query.Values(filter)
+		key := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, v.Encode())
+
+		s.mockRedisRepo.On("Get", mock.Anything, key).Return("", errors.New("redis: nil")).Once()
+		s.mockRepo.On("Fetch", mock.Anything, filter).Return([]entity.Product{{ID: 9, Price: &price, BasePrice: &price}}, int64(4), nil).Once()
+		s.mockRedisRepo.On("Set", mock.Anything, key, mock.Anything, 5*time.Minute).Return(nil).Once()
+
+		_, pagination, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.NoError(err)
+		cursor, err := request.DecodeProductCursor(pagination.NextCursor)
+		s.NoError(err)
+		s.Equal(request.ProductCursor{Sort: "price,id", Values: []string{"1000", "9"}}, cursor)
+	})
+
+	s.Run("Cursor From Another Sort", func() {
+		filter := request.ProductFilter{
+			Sort:   "cheapest",
+			Limit:  5,
+			Cursor: request.EncodeProductCursor(request.ProductCursor{Sort: "-created_at,-id", Values: []string{"x", "1"}}),
+		}
+
+		results, _, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(results)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestListProductsSort() {
+	s.Run("Multi Field Cursor", func() {
+		price := int64(1000)
+		qty := 3
+		filter := request.ProductFilter{Sort: "price, -quantity", Page: 1, Limit: 1, SkipTotal: true}
+		normalized := filter
+		normalized.Sort = "price,-quantity,-id"
+		v, _ := query.Values(normalized)
+		key := fmt.Sprintf("%s:%s", constant.RedisKeyProductList, v.Encode())
+
+		s.mockRedisRepo.On("Get", mock.Anything, key).Return("", errors.New("redis: nil")).Once()
+		s.mockRepo.On("Fetch", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return f.Sort == normalized.Sort && f.Limit == 2
+		})).Return([]entity.Product{{ID: 9, Price: &price, BasePrice: &price, Quantity: &qty}, {ID: 8, Price: &price, BasePrice: &price}}, int64(0), nil).Once()
+		s.mockRedisRepo.On("Set", mock.Anything, key, mock.Anything, 5*time.Minute).Return(nil).Once()
+
+		_, pagination, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.NoError(err)
+		cursor, err := request.DecodeProductCursor(pagination.NextCursor)
+		s.NoError(err)
+		s.Equal(request.ProductCursor{Sort: "price,-quantity,-id", Values: []string{"1000", "3", "9"}}, cursor)
+	})
+
+	s.Run("Unknown Field", func() {
+		filter := request.ProductFilter{Sort: "price,description", Page: 1, Limit: 10}
+
+		results, _, err := s.uc.ListProducts(context.Background(), filter)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Contains(err.Error(), "name, price, quantity, created_at, updated_at, id")
+		s.Nil(results)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestExportProducts() {
+	minPrice, maxPrice := int64(5000), int64(1000)
+
+	s.Run("Streams From Repository", func() {
+		filter := request.ProductFilter{Sort: "price"}
+
+		s.mockRepo.On("Stream", mock.Anything, filter, mock.Anything).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(2).(func(entity.Product) error)
+				_ = fn(entity.Product{ID: 1})
+				_ = fn(entity.Product{ID: 2})
+			}).Return(nil).Once()
+
+		var ids []int64
+		err := s.uc.ExportProducts(context.Background(), filter, func(product entity.Product) error {
+			ids = append(ids, product.ID)
+			return nil
+		})
+
+		s.NoError(err)
+		s.Equal([]int64{1, 2}, ids)
+	})
+
+	s.Run("Invalid Filter", func() {
+		filter := request.ProductFilter{MinPrice: &minPrice, MaxPrice: &maxPrice}
+
+		err := s.uc.ExportProducts(context.Background(), filter, func(product entity.Product) error { return nil })
+
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+
+	s.Run("Invalid Sort", func() {
+		err := s.uc.ExportProducts(context.Background(), request.ProductFilter{Sort: "stock"}, func(product entity.Product) error { return nil })
+
+		s.ErrorIs(err, constant.ErrValidation)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestUpdateProduct() {
+	id := int64(1)
+	price := int64(5500000)
+	req := &request.ProductUpdate{
+		Name:        "LG TV",
+		Price:       &price,
+		Description: "Desc",
+		UpdatedBy:   "arya",
+	}
+	detailKey := fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, id)
+
+	s.Run("Success", func() {
+		s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(p *entity.Product) bool {
+			return p.ID == id && p.Version == 2 && p.Name == "LG TV" && p.UpdatedBy == "arya"
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.Product).Status = entity.ProductActive
+		}).Return(nil).Once()
+
+		s.mockRedisRepo.On("Delete", mock.Anything, detailKey).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+		s.mockAutocomplete.On("Index", mock.Anything, mock.MatchedBy(func(p entity.Product) bool {
+			return p.ID == id && p.Name == "LG TV"
+		})).Return(nil).Once()
+
+		result, err := s.uc.UpdateProduct(context.Background(), id, 2, req)
+
+		s.NoError(err)
+		s.Equal(id, result.ID)
+	})
+
+	s.Run("Unpublished Product Leaves Autocomplete", func() {
+		s.mockRepo.On("Update", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.Product).Status = entity.ProductArchived
+		}).Return(nil).Once()
+
+		s.mockRedisRepo.On("Delete", mock.Anything, detailKey).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+		s.mockAutocomplete.On("Remove", mock.Anything, id).Return(nil).Once()
+
+		_, err := s.uc.UpdateProduct(context.Background(), id, 2, req)
+
+		s.NoError(err)
+		s.mockAutocomplete.AssertExpectations(s.T())
+	})
+
+	s.Run("Repository Error", func() {
+		s.mockRepo.On("Update", mock.Anything, mock.Anything).Return(constant.ErrNotFound).Once()
+
+		result, err := s.uc.UpdateProduct(context.Background(), id, 2, req)
+
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(result)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestPatchProduct() {
+	id := int64(1)
+	price := int64(5000000)
+	qty := 10
+	current := &entity.Product{ID: id, Name: "LG TV", Price: &price, Description: "Desc", Quantity: &qty, UpdatedBy: "budi", Version: 2}
+	detailKey := fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, id)
+
+	s.Run("Success", func() {
+		patch := map[string]interface{}{"name": "LG OLED", "updated_by": "arya"}
+		patched := &entity.Product{ID: id, Name: "LG OLED", Price: &price, Description: "Desc", Quantity: &qty, UpdatedBy: "arya", Status: entity.ProductActive}
+
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(current, nil).Once()
+		s.mockRepo.On("Patch", mock.Anything, id, int64(2), mock.MatchedBy(func(fields map[string]interface{}) bool {
+			_, hasPrice := fields["price"]
+			return fields["name"] == "LG OLED" && fields["updated_by"] == "arya" && !hasPrice
+		})).Return(patched, nil).Once()
+
+		s.mockRedisRepo.On("Delete", mock.Anything, detailKey).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+		s.mockAutocomplete.On("Index", mock.Anything, *patched).Return(nil).Once()
+
+		result, err := s.uc.PatchProduct(context.Background(), id, 2, patch)
+
+		s.NoError(err)
+		s.Equal("LG OLED", result.Name)
+	})
+
+	s.Run("Identifiers", func() {
+		sku := "LG-OLED-55"
+		patch := map[string]interface{}{"sku": " LG-OLED-55 ", "barcode": "", "updated_by": "arya"}
+		patched := &entity.Product{ID: id, SKU: &sku, Name: "LG TV", Price: &price, Description: "Desc", Quantity: &qty, UpdatedBy: "arya"}
+
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(current, nil).Once()
+		s.mockRepo.On("Patch", mock.Anything, id, int64(2), mock.MatchedBy(func(fields map[string]interface{}) bool {
+			patchedSKU, _ := fields["sku"].(*string)
+			barcode, hasBarcode := fields["barcode"]
+			return patchedSKU != nil && *patchedSKU == sku && hasBarcode && barcode.(*string) == nil
+		})).Return(patched, nil).Once()
+
+		s.mockRedisRepo.On("Delete", mock.Anything, detailKey).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+		s.mockAutocomplete.On("Index", mock.Anything, *patched).Return(nil).Once()
+
+		result, err := s.uc.PatchProduct(context.Background(), id, 2, patch)
+
+		s.NoError(err)
+		s.Equal(sku, *result.SKU)
+	})
+
+	s.Run("Brand", func() {
+		brandID := int64(3)
+		patch := map[string]interface{}{"brand_id": float64(3), "updated_by": "arya"}
+		patched := &entity.Product{ID: id, BrandID: &brandID, Name: "LG TV", Price: &price, Description: "Desc", Quantity: &qty, UpdatedBy: "arya"}
+
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(current, nil).Once()
+		s.mockRepo.On("Patch", mock.Anything, id, int64(2), mock.MatchedBy(func(fields map[string]interface{}) bool {
+			patchedBrand, _ := fields["brand_id"].(*int64)
+			_, hasName := fields["name"]
+			return patchedBrand != nil && *patchedBrand == brandID && !hasName
+		})).Return(patched, nil).Once()
+
+		s.mockRedisRepo.On("Delete", mock.Anything, detailKey).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+
+		result, err := s.uc.PatchProduct(context.Background(), id, 2, patch)
+
+		s.NoError(err)
+		s.Equal(brandID, *result.BrandID)
+	})
+
+	s.Run("Invalid Barcode", func() {
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(current, nil).Once()
+
+		result, err := s.uc.PatchProduct(context.Background(), id, 2, map[string]interface{}{"barcode": "1234567890123", "updated_by": "arya"})
+
+		s.Error(err)
+		s.Nil(result)
+	})
+
+	s.Run("Not Found", func() {
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(nil, constant.ErrNotFound).Once()
+
+		result, err := s.uc.PatchProduct(context.Background(), id, 2, map[string]interface{}{"updated_by": "arya"})
+
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(result)
+	})
+
+	s.Run("Stale Version", func() {
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(current, nil).Once()
+
+		result, err := s.uc.PatchProduct(context.Background(), id, 1, map[string]interface{}{"name": "LG OLED", "updated_by": "arya"})
+
+		s.ErrorIs(err, constant.ErrVersionMismatch)
+		s.Nil(result)
+	})
+
+	s.Run("Invalid Type", func() {
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(current, nil).Once()
+
+		result, err := s.uc.PatchProduct(context.Background(), id, 2, map[string]interface{}{"price": "abc", "updated_by": "arya"})
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(result)
+	})
+
+	s.Run("Validation Error (Missing Actor)", func() {
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(current, nil).Once()
+
+		result, err := s.uc.PatchProduct(context.Background(), id, 2, map[string]interface{}{"name": "LG OLED"})
+
+		s.Error(err)
+		s.Nil(result)
+	})
+
+	s.Run("Validation Error (Null Removes Required Field)", func() {
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(current, nil).Once()
+
+		result, err := s.uc.PatchProduct(context.Background(), id, 2, map[string]interface{}{"description": nil, "updated_by": "arya"})
+
+		s.Error(err)
+		s.Nil(result)
+	})
+
+	s.Run("Repository Error", func() {
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(current, nil).Once()
+		s.mockRepo.On("Patch", mock.Anything, id, int64(2), mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		result, err := s.uc.PatchProduct(context.Background(), id, 2, map[string]interface{}{"price": 10, "description": "New", "updated_by": "arya"})
+
+		s.Error(err)
+		s.Nil(result)
+	})
+
+	s.Run("Quantity Rejected", func() {
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(current, nil).Once()
+
+		result, err := s.uc.PatchProduct(context.Background(), id, 2, map[string]interface{}{"quantity": 1, "updated_by": "arya"})
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(result)
+	})
+
+	s.Run("Status Rejected", func() {
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(current, nil).Once()
+
+		_, err := s.uc.PatchProduct(context.Background(), id, 2, map[string]interface{}{"status": "archived", "updated_by": "arya"})
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.ErrorContains(err, "status only changes through a status transition")
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestTransitionProductStatus() {
+	id := int64(1)
+	detailKey := fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, id)
+	publishAt := time.Now().Add(14 * 24 * time.Hour).Truncate(time.Second)
+	unpublishAt := publishAt.Add(30 * 24 * time.Hour)
+
+	s.Run("Publish Draft", func() {
+		req := &request.ProductStatus{Status: entity.ProductActive, PublishAt: &publishAt, UnpublishAt: &unpublishAt, UpdatedBy: "arya"}
+		published := &entity.Product{ID: id, Status: entity.ProductActive, PublishAt: &publishAt, UnpublishAt: &unpublishAt, Version: 4}
+
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(&entity.Product{ID: id, Status: entity.ProductDraft, Version: 3}, nil).Once()
+		s.mockRepo.On("Patch", mock.Anything, id, int64(3), mock.MatchedBy(func(fields map[string]interface{}) bool {
+			return fields["status"] == entity.ProductActive && fields["publish_at"] == &publishAt &&
+				fields["unpublish_at"] == &unpublishAt && fields["updated_by"] == "arya"
+		})).Return(published, nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, detailKey).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+		// The window only opens in two weeks, until then it is not suggested.
+		s.mockAutocomplete.On("Remove", mock.Anything, id).Return(nil).Once()
+
+		result, err := s.uc.TransitionProductStatus(context.Background(), id, 0, req)
+
+		s.NoError(err)
+		s.Equal(entity.ProductActive, result.Status)
+		s.mockRedisRepo.AssertExpectations(s.T())
+		s.mockAutocomplete.AssertExpectations(s.T())
+	})
+
+	s.Run("Publish Now", func() {
+		req := &request.ProductStatus{Status: entity.ProductActive, UpdatedBy: "arya"}
+		published := entity.Product{ID: id, Name: "LG TV", Status: entity.ProductActive, Version: 4}
+
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(&entity.Product{ID: id, Status: entity.ProductDraft, Version: 3}, nil).Once()
+		s.mockRepo.On("Patch", mock.Anything, id, int64(3), mock.Anything).Return(&published, nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, detailKey).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+		s.mockAutocomplete.On("Index", mock.Anything, published).Return(nil).Once()
+
+		_, err := s.uc.TransitionProductStatus(context.Background(), id, 0, req)
+
+		s.NoError(err)
+		s.mockAutocomplete.AssertExpectations(s.T())
+	})
+
+	s.Run("Archive", func() {
+		req := &request.ProductStatus{Status: entity.ProductArchived, UpdatedBy: "arya"}
+
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(&entity.Product{ID: id, Status: entity.ProductActive, Version: 4}, nil).Once()
+		s.mockRepo.On("Patch", mock.Anything, id, int64(4), mock.Anything).
+			Return(&entity.Product{ID: id, Name: "LG TV", Status: entity.ProductArchived, Version: 5}, nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, detailKey).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+		s.mockAutocomplete.On("Remove", mock.Anything, id).Return(nil).Once()
+
+		_, err := s.uc.TransitionProductStatus(context.Background(), id, 0, req)
+
+		s.NoError(err)
+		s.mockAutocomplete.AssertExpectations(s.T())
+	})
+
+	s.Run("Transition Not Allowed", func() {
+		req := &request.ProductStatus{Status: entity.ProductActive, UpdatedBy: "arya"}
+
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(&entity.Product{ID: id, Status: entity.ProductDiscontinued, Version: 3}, nil).Once()
+
+		_, err := s.uc.TransitionProductStatus(context.Background(), id, 3, req)
+
+		s.ErrorIs(err, constant.ErrConflict)
+		s.ErrorContains(err, "a discontinued product cannot become active")
+	})
+
+	s.Run("Stale Version", func() {
+		req := &request.ProductStatus{Status: entity.ProductArchived, UpdatedBy: "arya"}
+
+		s.mockRepo.On("GetByID", mock.Anything, id).Return(&entity.Product{ID: id, Status: entity.ProductActive, Version: 3}, nil).Once()
+
+		_, err := s.uc.TransitionProductStatus(context.Background(), id, 2, req)
+
+		s.ErrorIs(err, constant.ErrVersionMismatch)
+	})
+
+	s.Run("Window Inverted", func() {
+		req := &request.ProductStatus{Status: entity.ProductActive, PublishAt: &unpublishAt, UnpublishAt: &publishAt, UpdatedBy: "arya"}
+
+		_, err := s.uc.TransitionProductStatus(context.Background(), id, 0, req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.ErrorContains(err, "unpublish_at must be after publish_at")
+	})
+
+	s.Run("Unknown Status", func() {
+		_, err := s.uc.TransitionProductStatus(context.Background(), id, 0, &request.ProductStatus{Status: "sold_out", UpdatedBy: "arya"})
+
+		var validationErrors validator.ValidationErrors
+		s.ErrorAs(err, &validationErrors)
+	})
+
+	s.Run("Not Found", func() {
+		s.mockRepo.On("GetByID", mock.Anything, int64(9)).Return(nil, constant.ErrNotFound).Once()
+
+		_, err := s.uc.TransitionProductStatus(context.Background(), 9, 0, &request.ProductStatus{Status: entity.ProductArchived, UpdatedBy: "arya"})
+
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestDeleteProduct() {
+	id := int64(1)
+	req := &request.ProductDelete{DeletedBy: "arya"}
+	detailKey := fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, id)
+
+	s.Run("Success", func() {
+		s.mockRepo.On("Delete", mock.Anything, id, int64(2), "arya").Return(nil).Once()
+
+		s.mockRedisRepo.On("Delete", mock.Anything, detailKey).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+		s.mockAutocomplete.On("Remove", mock.Anything, id).Return(nil).Once()
+
+		err := s.uc.DeleteProduct(context.Background(), id, 2, req)
+
+		s.NoError(err)
+	})
+
+	s.Run("Repository Error", func() {
+		s.mockRepo.On("Delete", mock.Anything, id, int64(2), "arya").Return(constant.ErrNotFound).Once()
+
+		err := s.uc.DeleteProduct(context.Background(), id, 2, req)
+
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestRestoreProduct() {
+	id := int64(1)
+	req := &request.ProductRestore{UpdatedBy: "arya"}
+	detailKey := fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, id)
+
+	s.Run("Success", func() {
+		restored := entity.Product{ID: id, Name: "LG TV", Status: entity.ProductActive}
+		s.mockRepo.On("Restore", mock.Anything, id, int64(4), "arya").Return(&restored, nil).Once()
+
+		s.mockRedisRepo.On("Delete", mock.Anything, detailKey).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+		s.mockAutocomplete.On("Index", mock.Anything, restored).Return(nil).Once()
+
+		result, err := s.uc.RestoreProduct(context.Background(), id, 4, req)
+
+		s.NoError(err)
+		s.Equal(id, result.ID)
+	})
+
+	s.Run("Draft Stays Out Of Autocomplete", func() {
+		s.mockRepo.On("Restore", mock.Anything, id, int64(4), "arya").Return(&entity.Product{ID: id, Name: "LG TV", Status: entity.ProductDraft}, nil).Once()
+
+		s.mockRedisRepo.On("Delete", mock.Anything, detailKey).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+		s.mockAutocomplete.On("Remove", mock.Anything, id).Return(nil).Once()
+
+		_, err := s.uc.RestoreProduct(context.Background(), id, 4, req)
+
+		s.NoError(err)
+		s.mockAutocomplete.AssertExpectations(s.T())
+	})
+
+	s.Run("Repository Error", func() {
+		s.mockRepo.On("Restore", mock.Anything, id, int64(4), "arya").Return(nil, constant.ErrNotFound).Once()
+
+		result, err := s.uc.RestoreProduct(context.Background(), id, 4, req)
+
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(result)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestPurgeDeletedProducts() {
+	retention := 720 * time.Hour
+
+	s.Run("Success - Rows Purged", func() {
+		keys := []string{"products/1/a1b2.jpg", "products/1/a1b2_thumb.jpg"}
+		for _, key := range keys {
+			s.Require().NoError(s.store.Put(context.Background(), key, []byte("image"), "image/jpeg"))
+		}
+
+		s.mockRepo.On("Purge", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+			return time.Since(before) >= retention
+		})).Return(int64(2), keys, nil).Once()
+
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+
+		purged, err := s.uc.PurgeDeletedProducts(context.Background(), retention)
+
+		s.NoError(err)
+		s.Equal(int64(2), purged)
+		for _, key := range keys {
+			s.NoFileExists(filepath.Join(s.root, key))
+		}
+	})
+
+	s.Run("Success - Nothing To Purge", func() {
+		s.mockRepo.On("Purge", mock.Anything, mock.Anything).Return(int64(0), nil, nil).Once()
+
+		purged, err := s.uc.PurgeDeletedProducts(context.Background(), retention)
+
+		s.NoError(err)
+		s.Equal(int64(0), purged)
+	})
+
+	s.Run("Repository Error", func() {
+		s.mockRepo.On("Purge", mock.Anything, mock.Anything).Return(int64(0), nil, errors.New("db error")).Once()
+
+		purged, err := s.uc.PurgeDeletedProducts(context.Background(), retention)
+
+		s.Error(err)
+		s.Equal(int64(0), purged)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestSyncPublishWindows() {
+	to := time.Now()
+	from := to.Add(-time.Minute)
+
+	s.Run("Windows Opened And Closed", func() {
+		opened := to.Add(-time.Second)
+		published := entity.Product{ID: 1, Name: "LG TV", Status: entity.ProductActive, PublishAt: &opened}
+		unpublished := entity.Product{ID: 2, Name: "Sony TV", Status: entity.ProductActive, UnpublishAt: &opened}
+
+		s.mockRepo.On("FetchPublishChanges", mock.Anything, from, to).Return([]entity.Product{published, unpublished}, nil).Once()
+		s.mockAutocomplete.On("Index", mock.Anything, published).Return(nil).Once()
+		s.mockAutocomplete.On("Remove", mock.Anything, int64(2)).Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+
+		synced, err := s.uc.SyncPublishWindows(context.Background(), from, to)
+
+		s.NoError(err)
+		s.Equal(int64(2), synced)
+		s.mockAutocomplete.AssertExpectations(s.T())
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Nothing Passed", func() {
+		s.mockRepo.On("FetchPublishChanges", mock.Anything, from, to).Return(nil, nil).Once()
+
+		synced, err := s.uc.SyncPublishWindows(context.Background(), from, to)
+
+		s.NoError(err)
+		s.Zero(synced)
+	})
+
+	s.Run("Repository Error", func() {
+		s.mockRepo.On("FetchPublishChanges", mock.Anything, from, to).Return(nil, errors.New("db error")).Once()
+
+		synced, err := s.uc.SyncPublishWindows(context.Background(), from, to)
+
+		s.Error(err)
+		s.Zero(synced)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestListProductHistory() {
+
+	s.Run("Success", func() {
+		events := []entity.AuditEvent{{ID: 2, EntityType: entity.AuditProduct, EntityID: 1, Action: entity.AuditUpdate, Actor: "arya",
+			Before: entity.AuditValues{"price": float64(5000000)}, After: entity.AuditValues{"price": float64(4500000)}}}
+		s.mockRepo.On("FetchHistory", mock.Anything, int64(1), 1, 10).Return(events, int64(11), nil).Once()
+
+		result, pagination, err := s.uc.ListProductHistory(context.Background(), 1, 1, 10)
+
+		s.NoError(err)
+		s.Equal(events, result)
+		s.Equal(11, pagination.Total)
+		s.True(pagination.NextPage)
+	})
+
+	s.Run("Empty", func() {
+		s.mockRepo.On("FetchHistory", mock.Anything, int64(9), 1, 10).Return(nil, int64(0), nil).Once()
+
+		result, _, err := s.uc.ListProductHistory(context.Background(), 9, 1, 10)
+
+		s.NoError(err)
+		s.NotNil(result)
+		s.Empty(result)
+	})
+
+	s.Run("Repository Error", func() {
+		s.mockRepo.On("FetchHistory", mock.Anything, int64(1), 1, 10).Return(nil, int64(0), errors.New("db error")).Once()
+
+		_, _, err := s.uc.ListProductHistory(context.Background(), 1, 1, 10)
+
+		s.EqualError(err, "db error")
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestSuggestProducts() {
+	suggestions := []entity.ProductSuggestion{{Name: "LG Television", Score: 0.6}}
+
+	s.Run("Success", func() {
+		s.mockRepo.On("Suggest", mock.Anything, "televsion", 3).Return(suggestions, nil).Once()
+
+		result, err := s.uc.SuggestProducts(context.Background(), " televsion ", 3)
+
+		s.NoError(err)
+		s.Equal(suggestions, result)
+	})
+
+	s.Run("Limit Capped", func() {
+		s.mockRepo.On("Suggest", mock.Anything, "tv", 10).Return(suggestions, nil).Once()
+
+		_, err := s.uc.SuggestProducts(context.Background(), "tv", 500)
+
+		s.NoError(err)
+	})
+
+	s.Run("Empty Term", func() {
+		result, err := s.uc.SuggestProducts(context.Background(), "  ", 5)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(result)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestAutocompleteProducts() {
+	s.Run("Success", func() {
+		expected := []entity.ProductAutocomplete{{ID: 1, Name: "LG TV"}}
+		s.mockAutocomplete.On("Search", mock.Anything, "lg", 10).Return(expected, nil).Once()
+
+		result, err := s.uc.AutocompleteProducts(context.Background(), "lg", 0)
+
+		s.NoError(err)
+		s.Equal(expected, result)
+	})
+
+	s.Run("Empty Prefix", func() {
+		result, err := s.uc.AutocompleteProducts(context.Background(), " ", 5)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(result)
+	})
+}
+
+func (s *ProductUsecaseTestSuite) TestRebuildAutocomplete() {
+	s.Run("Pages Through Products", func() {
+		first := []entity.Product{{ID: 1, Name: "LG TV"}, {ID: 7, Name: "Sony TV"}}
+
+		s.mockRepo.On("Fetch", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			return f.Published && f.Sort == "id" && f.Cursor == "" && f.SkipTotal
+		})).Return(first, int64(0), nil).Once()
+		s.mockRepo.On("Fetch", mock.Anything, mock.MatchedBy(func(f request.ProductFilter) bool {
+			cursor, err := request.DecodeProductCursor(f.Cursor)
+			return err == nil && cursor.Values[0] == "7"
+		})).Return([]entity.Product{}, int64(0), nil).Once()
+
+		s.mockAutocomplete.On("Rebuild", mock.Anything, mock.Anything).
+			Return(func(ctx context.Context, next func(ctx context.Context) ([]entity.Product, error)) (int64, error) {
+				var indexed int64
+				for {
+					products, err := next(ctx)
+					if err != nil || len(products) == 0 {
+						return indexed, err
+					}
+					indexed += int64(len(products))
+				}
+			}).Once()
+
+		indexed, err := s.uc.RebuildAutocomplete(context.Background())
+
+		s.NoError(err)
+		s.Equal(int64(2), indexed)
+	})
+}
+
 func TestProductUsecaseSuite(t *testing.T) {
 	suite.Run(t, new(ProductUsecaseTestSuite))
 }
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/internal/repository"
+	"erajaya-test/shared/utils"
+)
+
+type productVariantUsecase struct {
+	repo      interfaces.ProductVariantRepository
+	redisRepo repository.RedisRepository
+	validator *utils.CustomValidator
+}
+
+func NewProductVariantUsecase(repo interfaces.ProductVariantRepository, redisRepo repository.RedisRepository) interfaces.ProductVariantUsecase {
+	return &productVariantUsecase{
+		repo:      repo,
+		redisRepo: redisRepo,
+		validator: utils.NewValidator(),
+	}
+}
+
+func (u *productVariantUsecase) CreateVariant(ctx context.Context, productID int64, req *request.ProductVariant) (*entity.ProductVariant, error) {
+
+	req.Options = normalizeVariantOptions(req.Options)
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	variant := &entity.ProductVariant{
+		ProductID: productID,
+		SKU:       optionalString(req.SKU),
+		Options:   req.Options,
+		Price:     req.Price,
+		Quantity:  req.Quantity,
+		CreatedAt: now,
+		CreatedBy: req.CreatedBy,
+		UpdatedAt: now,
+		UpdatedBy: req.CreatedBy,
+	}
+
+	if err := u.repo.Create(ctx, variant); err != nil {
+		return nil, err
+	}
+
+	invalidateProductCaches(ctx, u.redisRepo, productID)
+
+	return variant, nil
+}
+
+func (u *productVariantUsecase) ListVariants(ctx context.Context, productID int64) ([]entity.ProductVariant, error) {
+	variants, err := u.repo.FetchByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if variants == nil {
+		variants = []entity.ProductVariant{}
+	}
+	return variants, nil
+}
+
+func (u *productVariantUsecase) UpdateVariant(ctx context.Context, productID int64, id int64, req *request.ProductVariantUpdate) (*entity.ProductVariant, error) {
+
+	req.Options = normalizeVariantOptions(req.Options)
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	variant := &entity.ProductVariant{
+		ID:        id,
+		ProductID: productID,
+		SKU:       optionalString(req.SKU),
+		Options:   req.Options,
+		Price:     req.Price,
+		Quantity:  req.Quantity,
+		UpdatedAt: time.Now(),
+		UpdatedBy: req.UpdatedBy,
+	}
+
+	if err := u.repo.Update(ctx, variant); err != nil {
+		return nil, err
+	}
+
+	invalidateProductCaches(ctx, u.redisRepo, productID)
+
+	return variant, nil
+}
+
+func (u *productVariantUsecase) DeleteVariant(ctx context.Context, productID int64, id int64) error {
+
+	if err := u.repo.Delete(ctx, productID, id); err != nil {
+		return err
+	}
+
+	invalidateProductCaches(ctx, u.redisRepo, productID)
+
+	return nil
+}
+
+// normalizeVariantOptions lower cases the axis names and trims both names and
+// values, so "Color" and "color " name the same axis.
+func normalizeVariantOptions(options map[string]string) map[string]string {
+	if options == nil {
+		return nil
+	}
+
+	normalized := make(map[string]string, len(options))
+	for axis, value := range options {
+		normalized[strings.ToLower(strings.TrimSpace(axis))] = strings.TrimSpace(value)
+	}
+	return normalized
+}
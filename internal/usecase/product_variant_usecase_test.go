@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductVariantUsecaseTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.ProductVariantRepository
+	mockRedisRepo *mocks.RedisRepository
+	uc            interfaces.ProductVariantUsecase
+}
+
+func (s *ProductVariantUsecaseTestSuite) SetupTest() {
+	s.mockRepo = new(mocks.ProductVariantRepository)
+	s.mockRedisRepo = new(mocks.RedisRepository)
+	s.uc = NewProductVariantUsecase(s.mockRepo, s.mockRedisRepo)
+}
+
+func (s *ProductVariantUsecaseTestSuite) expectInvalidation(productID int64) {
+	s.mockRedisRepo.On("Delete", mock.Anything, fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, productID)).Return(nil).Once()
+	s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+	s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+}
+
+func (s *ProductVariantUsecaseTestSuite) TestCreateVariant() {
+	price := int64(20000000)
+	qty := 5
+
+	s.Run("Success Normalizes Options", func() {
+		req := &request.ProductVariant{
+			SKU:       " S24-BLK-256 ",
+			Options:   map[string]string{" Color": "Black ", "STORAGE": "256GB"},
+			Price:     &price,
+			Quantity:  &qty,
+			CreatedBy: "arya",
+		}
+
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(v *entity.ProductVariant) bool {
+			return v.ProductID == 1 && *v.SKU == "S24-BLK-256" && v.UpdatedBy == "arya" &&
+				v.Options["color"] == "Black" && v.Options["storage"] == "256GB" && len(v.Options) == 2
+		})).Return(nil).Once()
+		s.expectInvalidation(1)
+
+		variant, err := s.uc.CreateVariant(context.Background(), 1, req)
+
+		s.NoError(err)
+		s.Equal([]string{"color", "storage"}, variant.Options.Axes())
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Validation Error", func() {
+		req := &request.ProductVariant{Options: map[string]string{"color": " "}, Price: &price, Quantity: &qty, CreatedBy: "arya"}
+
+		variant, err := s.uc.CreateVariant(context.Background(), 1, req)
+
+		s.Error(err)
+		s.Nil(variant)
+	})
+
+	s.Run("Product Not Found", func() {
+		req := &request.ProductVariant{Options: map[string]string{"color": "Black"}, Price: &price, Quantity: &qty, CreatedBy: "arya"}
+
+		s.mockRepo.On("Create", mock.Anything, mock.Anything).Return(constant.ErrNotFound).Once()
+
+		variant, err := s.uc.CreateVariant(context.Background(), 9, req)
+
+		s.ErrorIs(err, constant.ErrNotFound)
+		s.Nil(variant)
+	})
+}
+
+func (s *ProductVariantUsecaseTestSuite) TestListVariants() {
+
+	s.Run("Success", func() {
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(1)).
+			Return([]entity.ProductVariant{{ID: 7, ProductID: 1, Options: entity.VariantOptions{"color": "Black"}}}, nil).Once()
+
+		variants, err := s.uc.ListVariants(context.Background(), 1)
+
+		s.NoError(err)
+		s.Len(variants, 1)
+	})
+
+	s.Run("Empty", func() {
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(2)).Return(nil, nil).Once()
+
+		variants, err := s.uc.ListVariants(context.Background(), 2)
+
+		s.NoError(err)
+		s.NotNil(variants)
+		s.Empty(variants)
+	})
+
+	s.Run("Error", func() {
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(3)).Return(nil, errors.New("db error")).Once()
+
+		variants, err := s.uc.ListVariants(context.Background(), 3)
+
+		s.Error(err)
+		s.Nil(variants)
+	})
+}
+
+func (s *ProductVariantUsecaseTestSuite) TestUpdateVariant() {
+	price := int64(21000000)
+	qty := 3
+
+	s.Run("Success", func() {
+		req := &request.ProductVariantUpdate{Options: map[string]string{"color": "White"}, Price: &price, Quantity: &qty, UpdatedBy: "arya"}
+
+		s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(v *entity.ProductVariant) bool {
+			return v.ID == 7 && v.ProductID == 1 && v.SKU == nil && v.Options["color"] == "White" && v.UpdatedBy == "arya"
+		})).Return(nil).Once()
+		s.expectInvalidation(1)
+
+		variant, err := s.uc.UpdateVariant(context.Background(), 1, 7, req)
+
+		s.NoError(err)
+		s.Equal(int64(21000000), *variant.Price)
+	})
+
+	s.Run("Axes Mismatch", func() {
+		req := &request.ProductVariantUpdate{Options: map[string]string{"size": "XL"}, Price: &price, Quantity: &qty, UpdatedBy: "arya"}
+
+		s.mockRepo.On("Update", mock.Anything, mock.Anything).
+			Return(fmt.Errorf("%w: options must name the axes color like the other variants", constant.ErrValidation)).Once()
+
+		variant, err := s.uc.UpdateVariant(context.Background(), 1, 7, req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(variant)
+	})
+
+	s.Run("Validation Error", func() {
+		req := &request.ProductVariantUpdate{Options: map[string]string{"color": "White"}, Price: &price, Quantity: &qty}
+
+		variant, err := s.uc.UpdateVariant(context.Background(), 1, 7, req)
+
+		s.Error(err)
+		s.Nil(variant)
+	})
+}
+
+func (s *ProductVariantUsecaseTestSuite) TestDeleteVariant() {
+
+	s.Run("Success", func() {
+		s.mockRepo.On("Delete", mock.Anything, int64(1), int64(7)).Return(nil).Once()
+		s.expectInvalidation(1)
+
+		err := s.uc.DeleteVariant(context.Background(), 1, 7)
+
+		s.NoError(err)
+	})
+
+	s.Run("Not Found", func() {
+		s.mockRepo.On("Delete", mock.Anything, int64(1), int64(9)).Return(constant.ErrNotFound).Once()
+
+		err := s.uc.DeleteVariant(context.Background(), 1, 9)
+
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func TestProductVariantUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(ProductVariantUsecaseTestSuite))
+}
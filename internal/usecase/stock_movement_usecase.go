@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/internal/repository"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/response"
+	"erajaya-test/shared/utils"
+)
+
+type stockMovementUsecase struct {
+	repo      interfaces.StockMovementRepository
+	redisRepo repository.RedisRepository
+	validator *utils.CustomValidator
+}
+
+func NewStockMovementUsecase(repo interfaces.StockMovementRepository, redisRepo repository.RedisRepository) interfaces.StockMovementUsecase {
+	return &stockMovementUsecase{
+		repo:      repo,
+		redisRepo: redisRepo,
+		validator: utils.NewValidator(),
+	}
+}
+
+// PostMovement records a movement and returns it with the resulting balance.
+// A sale is stored with a negative quantity, so the ledger of a product sums
+// up to its stock.
+func (u *stockMovementUsecase) PostMovement(ctx context.Context, productID int64, req *request.StockMovement) (*entity.StockMovement, error) {
+
+	req.Reason = strings.TrimSpace(req.Reason)
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	quantity := req.Quantity
+	if req.Type == entity.StockMovementAdjustment {
+		if req.Reason == "" {
+			return nil, fmt.Errorf("%w: an adjustment needs a reason", constant.ErrValidation)
+		}
+	} else {
+		if quantity < 0 {
+			return nil, fmt.Errorf("%w: quantity of a %s must be positive", constant.ErrValidation, req.Type)
+		}
+		if req.Type == entity.StockMovementSale {
+			quantity = -quantity
+		}
+	}
+
+	movement := &entity.StockMovement{
+		ProductID: productID,
+		Type:      req.Type,
+		Quantity:  quantity,
+		Reason:    req.Reason,
+		CreatedAt: time.Now(),
+		CreatedBy: req.CreatedBy,
+	}
+	if req.LocationID != nil {
+		movement.LocationID = *req.LocationID
+	}
+
+	if err := u.repo.Post(ctx, movement); err != nil {
+		return nil, err
+	}
+
+	invalidateProductCaches(ctx, u.redisRepo, productID)
+
+	return movement, nil
+}
+
+func (u *stockMovementUsecase) ListMovements(ctx context.Context, productID int64, page int, limit int) ([]entity.StockMovement, response.StdPagination, error) {
+	movements, total, err := u.repo.FetchByProduct(ctx, productID, page, limit)
+	if err != nil {
+		return nil, response.StdPagination{}, err
+	}
+	if movements == nil {
+		movements = []entity.StockMovement{}
+	}
+	return movements, response.StandardPagination(page, limit, total), nil
+}
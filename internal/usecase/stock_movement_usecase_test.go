@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type StockMovementUsecaseTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.StockMovementRepository
+	mockRedisRepo *mocks.RedisRepository
+	uc            interfaces.StockMovementUsecase
+}
+
+func (s *StockMovementUsecaseTestSuite) SetupTest() {
+	s.mockRepo = new(mocks.StockMovementRepository)
+	s.mockRedisRepo = new(mocks.RedisRepository)
+	s.uc = NewStockMovementUsecase(s.mockRepo, s.mockRedisRepo)
+}
+
+func (s *StockMovementUsecaseTestSuite) expectInvalidation(productID int64) {
+	s.mockRedisRepo.On("Delete", mock.Anything, fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, productID)).Return(nil).Once()
+	s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+	s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+}
+
+func (s *StockMovementUsecaseTestSuite) TestPostMovement() {
+
+	s.Run("Sale Removes Stock", func() {
+		req := &request.StockMovement{Type: entity.StockMovementSale, Quantity: 3, CreatedBy: "arya"}
+
+		s.mockRepo.On("Post", mock.Anything, mock.MatchedBy(func(m *entity.StockMovement) bool {
+			return m.ProductID == 1 && m.Type == entity.StockMovementSale && m.Quantity == -3 && m.CreatedBy == "arya"
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.StockMovement).Balance = 7
+		}).Return(nil).Once()
+		s.expectInvalidation(1)
+
+		movement, err := s.uc.PostMovement(context.Background(), 1, req)
+
+		s.NoError(err)
+		s.Equal(7, movement.Balance)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Adjustment Keeps Sign And Reason", func() {
+		locationID := int64(3)
+		req := &request.StockMovement{Type: entity.StockMovementAdjustment, Quantity: -2, Reason: " damaged in storage ", LocationID: &locationID, CreatedBy: "arya"}
+
+		s.mockRepo.On("Post", mock.Anything, mock.MatchedBy(func(m *entity.StockMovement) bool {
+			return m.Quantity == -2 && m.Reason == "damaged in storage" && m.LocationID == 3
+		})).Return(nil).Once()
+		s.expectInvalidation(1)
+
+		_, err := s.uc.PostMovement(context.Background(), 1, req)
+
+		s.NoError(err)
+	})
+
+	s.Run("Adjustment Without Reason", func() {
+		req := &request.StockMovement{Type: entity.StockMovementAdjustment, Quantity: 5, Reason: " ", CreatedBy: "arya"}
+
+		movement, err := s.uc.PostMovement(context.Background(), 1, req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(movement)
+	})
+
+	s.Run("Negative Receipt", func() {
+		req := &request.StockMovement{Type: entity.StockMovementReceipt, Quantity: -5, CreatedBy: "arya"}
+
+		movement, err := s.uc.PostMovement(context.Background(), 1, req)
+
+		s.ErrorIs(err, constant.ErrValidation)
+		s.Nil(movement)
+	})
+
+	s.Run("Validation Error", func() {
+		req := &request.StockMovement{Type: "theft", Quantity: 1, CreatedBy: "arya"}
+
+		movement, err := s.uc.PostMovement(context.Background(), 1, req)
+
+		s.Error(err)
+		s.Nil(movement)
+	})
+
+	s.Run("Insufficient Stock", func() {
+		req := &request.StockMovement{Type: entity.StockMovementSale, Quantity: 50, CreatedBy: "arya"}
+
+		s.mockRepo.On("Post", mock.Anything, mock.Anything).Return(fmt.Errorf("%w: insufficient stock, 7 available", constant.ErrConflict)).Once()
+
+		movement, err := s.uc.PostMovement(context.Background(), 1, req)
+
+		s.ErrorIs(err, constant.ErrConflict)
+		s.Nil(movement)
+	})
+}
+
+func (s *StockMovementUsecaseTestSuite) TestListMovements() {
+
+	s.Run("Success", func() {
+		movements := []entity.StockMovement{{ID: 2, ProductID: 1, Quantity: -3, Balance: 7}}
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(1), 2, 10).Return(movements, int64(11), nil).Once()
+
+		result, pagination, err := s.uc.ListMovements(context.Background(), 1, 2, 10)
+
+		s.NoError(err)
+		s.Equal(movements, result)
+		s.Equal(11, pagination.Total)
+		s.True(pagination.PrevPage)
+		s.False(pagination.NextPage)
+	})
+
+	s.Run("Empty", func() {
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(9), 1, 10).Return(nil, int64(0), nil).Once()
+
+		result, _, err := s.uc.ListMovements(context.Background(), 9, 1, 10)
+
+		s.NoError(err)
+		s.NotNil(result)
+		s.Empty(result)
+	})
+
+	s.Run("Repository Error", func() {
+		s.mockRepo.On("FetchByProduct", mock.Anything, int64(1), 1, 10).Return(nil, int64(0), errors.New("db error")).Once()
+
+		result, _, err := s.uc.ListMovements(context.Background(), 1, 1, 10)
+
+		s.Error(err)
+		s.Nil(result)
+	})
+}
+
+func TestStockMovementUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(StockMovementUsecaseTestSuite))
+}
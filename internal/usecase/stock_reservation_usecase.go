@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/internal/repository"
+	"erajaya-test/shared/constant"
+	"erajaya-test/shared/utils"
+)
+
+type stockReservationUsecase struct {
+	repo      interfaces.StockReservationRepository
+	redisRepo repository.RedisRepository
+	validator *utils.CustomValidator
+}
+
+func NewStockReservationUsecase(repo interfaces.StockReservationRepository, redisRepo repository.RedisRepository) interfaces.StockReservationUsecase {
+	return &stockReservationUsecase{
+		repo:      repo,
+		redisRepo: redisRepo,
+		validator: utils.NewValidator(),
+	}
+}
+
+// Reserve holds stock of a product for the TTL of the request, or
+// DefaultReservationTTL seconds.
+func (u *stockReservationUsecase) Reserve(ctx context.Context, productID int64, req *request.StockReservation) (*entity.StockReservation, error) {
+
+	req.Reference = strings.TrimSpace(req.Reference)
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	ttl := req.TTL
+	if ttl == 0 {
+		ttl = request.DefaultReservationTTL
+	}
+
+	now := time.Now()
+	reservation := &entity.StockReservation{
+		ProductID: productID,
+		Quantity:  req.Quantity,
+		Status:    entity.ReservationActive,
+		Reference: req.Reference,
+		ExpiresAt: now.Add(time.Duration(ttl) * time.Second),
+		CreatedAt: now,
+		CreatedBy: req.CreatedBy,
+		UpdatedAt: now,
+		UpdatedBy: req.CreatedBy,
+	}
+	if req.LocationID != nil {
+		reservation.LocationID = *req.LocationID
+	}
+
+	if err := u.repo.Reserve(ctx, reservation); err != nil {
+		return nil, err
+	}
+
+	invalidateProductCaches(ctx, u.redisRepo, productID)
+
+	return reservation, nil
+}
+
+func (u *stockReservationUsecase) GetReservation(ctx context.Context, id int64) (*entity.StockReservation, error) {
+	return u.repo.GetByID(ctx, id)
+}
+
+// CommitReservation sells the reserved units.
+func (u *stockReservationUsecase) CommitReservation(ctx context.Context, id int64, req *request.StockReservationResolve) (*entity.StockReservation, error) {
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	reservation, err := u.repo.Commit(ctx, id, req.UpdatedBy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateProductCaches(ctx, u.redisRepo, reservation.ProductID)
+
+	return reservation, nil
+}
+
+func (u *stockReservationUsecase) ReleaseReservation(ctx context.Context, id int64, req *request.StockReservationResolve) (*entity.StockReservation, error) {
+	if err := u.validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	reservation, err := u.repo.Release(ctx, id, req.UpdatedBy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateProductCaches(ctx, u.redisRepo, reservation.ProductID)
+
+	return reservation, nil
+}
+
+// ReleaseExpired releases every reservation past its expiry, one per
+// transaction, and returns how many it released.
+func (u *stockReservationUsecase) ReleaseExpired(ctx context.Context) (int, error) {
+	released := 0
+	for ctx.Err() == nil {
+		reservation, err := u.repo.ExpireNext(ctx, time.Now())
+		if errors.Is(err, constant.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return released, err
+		}
+
+		invalidateProductCaches(ctx, u.redisRepo, reservation.ProductID)
+		released++
+	}
+	return released, nil
+}
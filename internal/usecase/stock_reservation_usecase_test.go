@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
+	"erajaya-test/internal/models/request"
+	"erajaya-test/mocks"
+	"erajaya-test/shared/constant"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type StockReservationUsecaseTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.StockReservationRepository
+	mockRedisRepo *mocks.RedisRepository
+	uc            interfaces.StockReservationUsecase
+}
+
+func (s *StockReservationUsecaseTestSuite) SetupTest() {
+	s.mockRepo = new(mocks.StockReservationRepository)
+	s.mockRedisRepo = new(mocks.RedisRepository)
+	s.uc = NewStockReservationUsecase(s.mockRepo, s.mockRedisRepo)
+}
+
+func (s *StockReservationUsecaseTestSuite) expectStockInvalidation(productID int64) {
+	s.mockRedisRepo.On("Delete", mock.Anything, fmt.Sprintf("%s:%d", constant.RedisKeyProductDetail, productID)).Return(nil).Once()
+	s.mockRedisRepo.On("Delete", mock.Anything, constant.RedisKeyProductList+"*").Return(nil).Once()
+	s.mockRedisRepo.On("Delete", mock.Anything, constant.RedisKeyProductFacets+"*").Return(nil).Once()
+}
+
+func (s *StockReservationUsecaseTestSuite) TestReserve() {
+
+	s.Run("Default TTL", func() {
+		req := &request.StockReservation{Quantity: 2, Reference: " cart-7 ", CreatedBy: "checkout"}
+
+		s.mockRepo.On("Reserve", mock.Anything, mock.MatchedBy(func(r *entity.StockReservation) bool {
+			ttl := r.ExpiresAt.Sub(r.CreatedAt)
+			return r.ProductID == 1 && r.Quantity == 2 && r.Status == entity.ReservationActive &&
+				r.Reference == "cart-7" && ttl == request.DefaultReservationTTL*time.Second && r.LocationID == 0
+		})).Return(nil).Once()
+		s.expectStockInvalidation(1)
+
+		reservation, err := s.uc.Reserve(context.Background(), 1, req)
+
+		s.NoError(err)
+		s.Equal("checkout", reservation.CreatedBy)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Requested TTL", func() {
+		locationID := int64(2)
+		req := &request.StockReservation{Quantity: 1, TTL: 60, LocationID: &locationID, CreatedBy: "checkout"}
+
+		s.mockRepo.On("Reserve", mock.Anything, mock.MatchedBy(func(r *entity.StockReservation) bool {
+			return r.ExpiresAt.Sub(r.CreatedAt) == time.Minute && r.LocationID == 2
+		})).Return(nil).Once()
+		s.expectStockInvalidation(1)
+
+		_, err := s.uc.Reserve(context.Background(), 1, req)
+
+		s.NoError(err)
+	})
+
+	s.Run("Validation Error", func() {
+		req := &request.StockReservation{Quantity: 0, CreatedBy: "checkout"}
+
+		reservation, err := s.uc.Reserve(context.Background(), 1, req)
+
+		s.Error(err)
+		s.Nil(reservation)
+	})
+
+	s.Run("Insufficient Stock", func() {
+		req := &request.StockReservation{Quantity: 5, CreatedBy: "checkout"}
+
+		s.mockRepo.On("Reserve", mock.Anything, mock.Anything).
+			Return(fmt.Errorf("%w: insufficient stock, 2 available", constant.ErrConflict)).Once()
+
+		reservation, err := s.uc.Reserve(context.Background(), 1, req)
+
+		s.ErrorIs(err, constant.ErrConflict)
+		s.Nil(reservation)
+	})
+}
+
+func (s *StockReservationUsecaseTestSuite) TestCommitReservation() {
+
+	s.Run("Success", func() {
+		s.mockRepo.On("Commit", mock.Anything, int64(4), "checkout", mock.Anything).
+			Return(&entity.StockReservation{ID: 4, ProductID: 1, Status: entity.ReservationCommitted}, nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:detail:1").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:list*").Return(nil).Once()
+		s.mockRedisRepo.On("Delete", mock.Anything, "products:facets*").Return(nil).Once()
+
+		reservation, err := s.uc.CommitReservation(context.Background(), 4, &request.StockReservationResolve{UpdatedBy: "checkout"})
+
+		s.NoError(err)
+		s.Equal(entity.ReservationCommitted, reservation.Status)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Expired", func() {
+		s.mockRepo.On("Commit", mock.Anything, int64(5), "checkout", mock.Anything).
+			Return(nil, fmt.Errorf("%w: reservation has expired", constant.ErrConflict)).Once()
+
+		reservation, err := s.uc.CommitReservation(context.Background(), 5, &request.StockReservationResolve{UpdatedBy: "checkout"})
+
+		s.ErrorIs(err, constant.ErrConflict)
+		s.Nil(reservation)
+	})
+
+	s.Run("Validation Error", func() {
+		_, err := s.uc.CommitReservation(context.Background(), 4, &request.StockReservationResolve{})
+
+		s.Error(err)
+	})
+}
+
+func (s *StockReservationUsecaseTestSuite) TestReleaseReservation() {
+
+	s.Run("Success", func() {
+		s.mockRepo.On("Release", mock.Anything, int64(4), "checkout", mock.Anything).
+			Return(&entity.StockReservation{ID: 4, ProductID: 1, Status: entity.ReservationReleased}, nil).Once()
+		s.expectStockInvalidation(1)
+
+		reservation, err := s.uc.ReleaseReservation(context.Background(), 4, &request.StockReservationResolve{UpdatedBy: "checkout"})
+
+		s.NoError(err)
+		s.Equal(entity.ReservationReleased, reservation.Status)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Not Found", func() {
+		s.mockRepo.On("Release", mock.Anything, int64(9), "checkout", mock.Anything).Return(nil, constant.ErrNotFound).Once()
+
+		_, err := s.uc.ReleaseReservation(context.Background(), 9, &request.StockReservationResolve{UpdatedBy: "checkout"})
+
+		s.ErrorIs(err, constant.ErrNotFound)
+	})
+}
+
+func (s *StockReservationUsecaseTestSuite) TestReleaseExpired() {
+
+	s.Run("Releases Until None Left", func() {
+		s.mockRepo.On("ExpireNext", mock.Anything, mock.Anything).Return(&entity.StockReservation{ID: 4, ProductID: 1}, nil).Once()
+		s.mockRepo.On("ExpireNext", mock.Anything, mock.Anything).Return(&entity.StockReservation{ID: 6, ProductID: 2}, nil).Once()
+		s.mockRepo.On("ExpireNext", mock.Anything, mock.Anything).Return(nil, constant.ErrNotFound).Once()
+		s.expectStockInvalidation(1)
+		s.expectStockInvalidation(2)
+
+		released, err := s.uc.ReleaseExpired(context.Background())
+
+		s.NoError(err)
+		s.Equal(2, released)
+		s.mockRedisRepo.AssertExpectations(s.T())
+	})
+
+	s.Run("Error", func() {
+		s.mockRepo.On("ExpireNext", mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		released, err := s.uc.ReleaseExpired(context.Background())
+
+		s.Error(err)
+		s.Equal(0, released)
+	})
+}
+
+func TestStockReservationUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(StockReservationUsecaseTestSuite))
+}
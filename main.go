@@ -62,9 +62,10 @@ func main() {
 
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete},
-		AllowHeaders: []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		AllowOrigins:  []string{"*"},
+		AllowMethods:  []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
+		AllowHeaders:  []string{"Origin", "Content-Type", "Accept", "Authorization", "If-Match", "If-None-Match"},
+		ExposeHeaders: []string{"ETag"},
 	}))
 
 	e.IPExtractor = echo.ExtractIPFromXFFHeader()
@@ -103,6 +104,10 @@ func main() {
 
 	e.Use(middleware.RateLimiterWithConfig(rateLimitConfig))
 	e.Use(middleware.TimeoutWithConfig(middleware.TimeoutConfig{
+		// The timeout handler buffers the whole response, exports must stream.
+		Skipper: func(c echo.Context) bool {
+			return c.Path() == "/api/v1/products/export"
+		},
 		Timeout: 60 * time.Second,
 		OnTimeoutRouteErrorHandler: func(err error, c echo.Context) {
 			c.JSON(http.StatusRequestTimeout, map[string]interface{}{
@@ -122,8 +127,23 @@ func main() {
 	api := e.Group("/api")
 
 	dbInstance := app.InitDatabase(initCtx)
+
+	// Maintenance commands, e.g. `go run main.go rebuild-autocomplete`
+	if len(os.Args) > 1 {
+		err := app.RunCommand(context.Background(), dbInstance, os.Args[1])
+		dbInstance.Close(context.Background())
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	app.InitRoutes(initCtx, api, dbInstance)
 
+	workerCtx, workerCancel := context.WithCancel(context.Background())
+	defer workerCancel()
+	app.InitWorkers(workerCtx, dbInstance)
+
 	host := viper.GetString("server.host")
 
 	app.InitSwagger(e, app.SwaggerInfo{
@@ -156,6 +176,9 @@ func main() {
 		e.Logger.Fatal(err)
 	}
 
+	// Stop background workers before closing their connections
+	workerCancel()
+
 	// Close all database connections
 	dbInstance.Close(shutdownCtx)
 
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_products_deleted_at_purge;
@@ -0,0 +1,9 @@
+-- Rows written before deleted_at became nullable in the application carry the
+-- zero timestamp instead of NULL, which hides them from every partial index.
+UPDATE products
+SET deleted_at = NULL, deleted_by = NULL
+WHERE deleted_at < '0002-01-01';
+
+CREATE INDEX IF NOT EXISTS idx_products_deleted_at_purge
+ON products (deleted_at)
+WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS idx_products_created_at_id_sort;
+DROP INDEX IF EXISTS idx_products_price_id_sort;
+DROP INDEX IF EXISTS idx_products_name_id_sort;
+
+CREATE INDEX IF NOT EXISTS idx_products_created_at_sort
+ON products (created_at DESC)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_products_price_sort
+ON products (price)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_products_name_sort
+ON products (name)
+WHERE deleted_at IS NULL;
@@ -0,0 +1,17 @@
+-- Keyset pagination seeks on (sort column, id), so the sort indexes carry the
+-- id tiebreaker to serve both the row comparison and the ORDER BY.
+DROP INDEX IF EXISTS idx_products_created_at_sort;
+DROP INDEX IF EXISTS idx_products_price_sort;
+DROP INDEX IF EXISTS idx_products_name_sort;
+
+CREATE INDEX IF NOT EXISTS idx_products_created_at_id_sort
+ON products (created_at DESC, id DESC)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_products_price_id_sort
+ON products (price, id)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_products_name_id_sort
+ON products (name, id)
+WHERE deleted_at IS NULL;
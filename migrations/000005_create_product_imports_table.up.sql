@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS product_imports (
+    id BIGSERIAL PRIMARY KEY,
+    file_name VARCHAR(255) NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    total_rows INT NOT NULL DEFAULT 0,
+    processed_rows INT NOT NULL DEFAULT 0,
+    created_rows INT NOT NULL DEFAULT 0,
+    failed_rows INT NOT NULL DEFAULT 0,
+    message TEXT,
+    errors JSONB NOT NULL DEFAULT '[]',
+    payload BYTEA,
+    created_by VARCHAR(255) NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP WITH TIME ZONE NULL,
+    finished_at TIMESTAMP WITH TIME ZONE NULL
+);
+
+-- The worker claims the oldest pending job.
+CREATE INDEX IF NOT EXISTS idx_product_imports_pending
+ON product_imports (id)
+WHERE status = 'pending';
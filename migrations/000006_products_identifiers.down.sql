@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_products_slug_unique;
+DROP INDEX IF EXISTS idx_products_barcode_unique;
+DROP INDEX IF EXISTS idx_products_sku_unique;
+
+ALTER TABLE products DROP COLUMN IF EXISTS slug;
+ALTER TABLE products DROP COLUMN IF EXISTS barcode;
+ALTER TABLE products DROP COLUMN IF EXISTS sku;
@@ -0,0 +1,18 @@
+ALTER TABLE products ADD COLUMN IF NOT EXISTS sku VARCHAR(64) NULL;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS barcode VARCHAR(13) NULL;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS slug VARCHAR(255) NULL;
+
+-- Existing products get their name as slug, suffixed with the id so the
+-- backfill can never collide.
+UPDATE products
+SET slug = COALESCE(NULLIF(trim(BOTH '-' FROM regexp_replace(lower(name), '[^a-z0-9]+', '-', 'g')), ''), 'product') || '-' || id
+WHERE slug IS NULL;
+
+ALTER TABLE products ALTER COLUMN slug SET NOT NULL;
+
+-- Identifiers stay reserved while a product is soft-deleted so a restore can
+-- never clash. The pattern ops let the slug index also serve the prefix scan
+-- used to pick the next free suffix.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_sku_unique ON products (sku);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_barcode_unique ON products (barcode);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_slug_unique ON products (slug varchar_pattern_ops);
@@ -0,0 +1,30 @@
+-- Categories form a tree stored as a materialized path: the ids from the root
+-- down to the category, e.g. '/1/4/9/'. All descendants of a category share
+-- its path as prefix, so a subtree is one index range scan.
+CREATE TABLE IF NOT EXISTS categories (
+    id BIGSERIAL PRIMARY KEY,
+    parent_id BIGINT NULL REFERENCES categories (id) ON DELETE RESTRICT,
+    name VARCHAR(255) NOT NULL,
+    path VARCHAR(1024) NOT NULL DEFAULT '',
+    depth INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255) NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by VARCHAR(255) NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_categories_path ON categories (path varchar_pattern_ops);
+
+-- Siblings need distinct names; roots are siblings of each other.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_parent_name_unique
+ON categories (COALESCE(parent_id, 0), lower(name));
+
+CREATE TABLE IF NOT EXISTS product_categories (
+    product_id BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    category_id BIGINT NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+    PRIMARY KEY (product_id, category_id)
+);
+
+-- The primary key serves lookups by product; this one serves the listing
+-- filter, which starts from the categories.
+CREATE INDEX IF NOT EXISTS idx_product_categories_category ON product_categories (category_id, product_id);
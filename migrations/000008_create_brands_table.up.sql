@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS brands (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255) NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by VARCHAR(255) NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_brands_name_unique ON brands (lower(name));
+
+-- A brand cannot be deleted while products, deleted ones included, still
+-- refer to it.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS brand_id BIGINT NULL
+    CONSTRAINT fk_products_brand REFERENCES brands (id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_products_brand_id
+ON products (brand_id)
+WHERE deleted_at IS NULL;
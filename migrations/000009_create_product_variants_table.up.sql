@@ -0,0 +1,23 @@
+-- A variant is one purchasable combination of option values of a product,
+-- e.g. {"color": "Black", "storage": "256GB"}, with its own SKU, price and
+-- stock. All variants of a product name the same option axes.
+CREATE TABLE IF NOT EXISTS product_variants (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    sku VARCHAR(64) NULL,
+    options JSONB NOT NULL,
+    price BIGINT NOT NULL,
+    quantity INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255) NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by VARCHAR(255) NULL
+);
+
+-- Also serves the lookup of the variants of a product.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_variants_options_unique
+ON product_variants (product_id, options);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_variants_sku_unique
+ON product_variants (sku)
+WHERE sku IS NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE products DROP CONSTRAINT IF EXISTS chk_products_quantity_not_negative;
+
+DROP TABLE IF EXISTS stock_movements;
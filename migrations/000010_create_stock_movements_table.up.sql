@@ -0,0 +1,26 @@
+-- The append-only stock ledger. quantity is the signed change of a movement
+-- and balance the stock of the product after it; products.quantity holds the
+-- latest balance and is only written together with a movement.
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('receipt', 'sale', 'adjustment', 'return')),
+    quantity INT NOT NULL CHECK (quantity <> 0),
+    balance INT NOT NULL,
+    reason VARCHAR(255) NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255) NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_movements_product_id
+ON stock_movements (product_id, id);
+
+-- The stock products hold today opens their ledger.
+INSERT INTO stock_movements (product_id, type, quantity, balance, reason, created_at, created_by)
+SELECT id, 'adjustment', quantity, quantity, 'opening balance', created_at, created_by
+FROM products
+WHERE COALESCE(quantity, 0) <> 0;
+
+-- Existing rows are left unchecked, new writes can no longer oversell.
+ALTER TABLE products ADD CONSTRAINT chk_products_quantity_not_negative
+CHECK (quantity >= 0) NOT VALID;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS stock_reservations;
+
+ALTER TABLE products DROP CONSTRAINT IF EXISTS chk_products_reserved;
+
+ALTER TABLE products DROP COLUMN IF EXISTS reserved;
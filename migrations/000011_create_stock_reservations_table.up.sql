@@ -0,0 +1,28 @@
+-- Stock held for checkouts. products.reserved is the sum of the active
+-- reservations of a product and is only written together with them, so the
+-- available stock is quantity - reserved.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS reserved INT NOT NULL DEFAULT 0;
+
+ALTER TABLE products ADD CONSTRAINT chk_products_reserved
+CHECK (reserved >= 0 AND reserved <= quantity) NOT VALID;
+
+CREATE TABLE IF NOT EXISTS stock_reservations (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'committed', 'released', 'expired')),
+    reference VARCHAR(255) NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255) NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by VARCHAR(255) NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_reservations_product_id
+ON stock_reservations (product_id);
+
+-- The reaper only ever looks for active reservations past their expiry.
+CREATE INDEX IF NOT EXISTS idx_stock_reservations_expires_at
+ON stock_reservations (expires_at)
+WHERE status = 'active';
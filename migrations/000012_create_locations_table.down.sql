@@ -0,0 +1,7 @@
+ALTER TABLE stock_reservations DROP COLUMN IF EXISTS location_id;
+
+ALTER TABLE stock_movements DROP COLUMN IF EXISTS location_id;
+
+DROP TABLE IF EXISTS product_stocks;
+
+DROP TABLE IF EXISTS locations;
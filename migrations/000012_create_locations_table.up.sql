@@ -0,0 +1,50 @@
+-- Stores and warehouses stock is kept at. Exactly one location is the
+-- default, which takes the stock movements and reservations that name none.
+CREATE TABLE IF NOT EXISTS locations (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(32) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('warehouse', 'store')),
+    address TEXT NOT NULL DEFAULT '',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255) NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by VARCHAR(255) NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_locations_code_unique ON locations (lower(code));
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_locations_default_unique ON locations (is_default) WHERE is_default;
+
+INSERT INTO locations (code, name, type, is_default, created_by, updated_by)
+VALUES ('MAIN', 'Main warehouse', 'warehouse', TRUE, 'system', 'system');
+
+-- The stock of a product per location. products.quantity and
+-- products.reserved hold the totals over all locations and are written
+-- together with these rows, under the lock of the product row.
+CREATE TABLE IF NOT EXISTS product_stocks (
+    product_id BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    location_id BIGINT NOT NULL REFERENCES locations (id) ON DELETE RESTRICT,
+    quantity INT NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    reserved INT NOT NULL DEFAULT 0 CHECK (reserved >= 0 AND reserved <= quantity),
+    PRIMARY KEY (product_id, location_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_stocks_location_id
+ON product_stocks (location_id, product_id);
+
+-- The stock products hold today is kept at the default location.
+INSERT INTO product_stocks (product_id, location_id, quantity, reserved)
+SELECT products.id, locations.id, GREATEST(COALESCE(products.quantity, 0), 0), products.reserved
+FROM products
+CROSS JOIN locations
+WHERE locations.is_default AND (COALESCE(products.quantity, 0) > 0 OR products.reserved > 0);
+
+ALTER TABLE stock_movements ADD COLUMN IF NOT EXISTS location_id BIGINT NULL REFERENCES locations (id) ON DELETE RESTRICT;
+UPDATE stock_movements SET location_id = (SELECT id FROM locations WHERE is_default);
+ALTER TABLE stock_movements ALTER COLUMN location_id SET NOT NULL;
+
+ALTER TABLE stock_reservations ADD COLUMN IF NOT EXISTS location_id BIGINT NULL REFERENCES locations (id) ON DELETE RESTRICT;
+UPDATE stock_reservations SET location_id = (SELECT id FROM locations WHERE is_default);
+ALTER TABLE stock_reservations ALTER COLUMN location_id SET NOT NULL;
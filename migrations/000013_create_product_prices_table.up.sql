@@ -0,0 +1,26 @@
+-- The price history of products. A price is in effect from valid_from until
+-- valid_to, open ended for the current one. Rows not applied yet are price
+-- changes scheduled for valid_from; the scheduler applies them to
+-- products.price once they are due.
+CREATE TABLE IF NOT EXISTS product_prices (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    price BIGINT NOT NULL,
+    valid_from TIMESTAMP WITH TIME ZONE NOT NULL,
+    valid_to TIMESTAMP WITH TIME ZONE NULL,
+    applied_at TIMESTAMP WITH TIME ZONE NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255) NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_prices_product_id
+ON product_prices (product_id, valid_from);
+
+CREATE INDEX IF NOT EXISTS idx_product_prices_due
+ON product_prices (valid_from) WHERE applied_at IS NULL;
+
+-- The price products have today opens their history.
+INSERT INTO product_prices (product_id, price, valid_from, applied_at, created_at, created_by)
+SELECT id, price, COALESCE(created_at, CURRENT_TIMESTAMP), COALESCE(created_at, CURRENT_TIMESTAMP), created_at, created_by
+FROM products
+WHERE price IS NOT NULL;
@@ -0,0 +1,22 @@
+-- Exchange rates of the currencies products are priced in, as the amount of
+-- the base currency IDR one unit of the currency is worth. minor_units is the
+-- number of decimals of the currency: prices are stored in its minor unit
+-- (cents for USD, whole rupiah for IDR).
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    currency CHAR(3) PRIMARY KEY,
+    rate NUMERIC(24, 10) NOT NULL CHECK (rate > 0),
+    minor_units SMALLINT NOT NULL DEFAULT 2 CHECK (minor_units BETWEEN 0 AND 4),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by VARCHAR(255) NULL
+);
+
+INSERT INTO exchange_rates (currency, rate, minor_units, updated_by)
+VALUES ('IDR', 1, 0, 'system')
+ON CONFLICT (currency) DO NOTHING;
+
+-- Existing prices are rupiah.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'IDR'
+    CONSTRAINT fk_products_currency REFERENCES exchange_rates (currency) ON DELETE RESTRICT;
+
+ALTER TABLE product_prices ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'IDR'
+    CONSTRAINT fk_product_prices_currency REFERENCES exchange_rates (currency) ON DELETE RESTRICT;
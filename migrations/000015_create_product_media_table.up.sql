@@ -0,0 +1,29 @@
+-- Images of a product. The file and its thumbnail are kept in the media
+-- storage under storage_key and thumbnail_key; url and thumbnail_url are where
+-- clients download them. position orders the images of a product, and at
+-- most one of them is the primary image.
+CREATE TABLE IF NOT EXISTS product_media (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    storage_key VARCHAR(255) NOT NULL,
+    thumbnail_key VARCHAR(255) NOT NULL,
+    url TEXT NOT NULL,
+    thumbnail_url TEXT NOT NULL,
+    content_type VARCHAR(50) NOT NULL,
+    size BIGINT NOT NULL,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    position INT NOT NULL,
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255) NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by VARCHAR(255) NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_media_product_id
+ON product_media (product_id, position);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_media_primary_unique
+ON product_media (product_id)
+WHERE is_primary;
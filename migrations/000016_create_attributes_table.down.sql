@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_products_attributes_gin;
+
+ALTER TABLE products DROP COLUMN IF EXISTS attributes;
+
+DROP TABLE IF EXISTS category_attributes;
+
+DROP TABLE IF EXISTS attributes;
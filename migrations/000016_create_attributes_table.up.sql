@@ -0,0 +1,37 @@
+-- Typed product attributes (specifications such as RAM or screen size). code
+-- is the key of the value in products.attributes; it and the type never
+-- change once defined. unit names what unit attributes are measured in,
+-- options lists the values of enum attributes.
+CREATE TABLE IF NOT EXISTS attributes (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(16) NOT NULL CHECK (type IN ('string', 'number', 'enum', 'boolean', 'unit')),
+    unit VARCHAR(32) NOT NULL DEFAULT '',
+    options JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255) NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_by VARCHAR(255) NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attributes_code_unique ON attributes (code);
+
+-- The attributes a category defines for its products. Subcategories inherit
+-- the attributes of their ancestors.
+CREATE TABLE IF NOT EXISTS category_attributes (
+    category_id BIGINT NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+    attribute_id BIGINT NOT NULL REFERENCES attributes (id) ON DELETE CASCADE,
+    PRIMARY KEY (category_id, attribute_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_category_attributes_attribute_id ON category_attributes (attribute_id);
+
+-- Attribute values by code, e.g. {"ram_gb": 8, "chipset": "Snapdragon 8 Gen 3"}.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
+
+-- jsonb_path_ops serves the containment (@>) and jsonpath (@@) filters of the
+-- listing.
+CREATE INDEX IF NOT EXISTS idx_products_attributes_gin
+ON products USING GIN (attributes jsonb_path_ops)
+WHERE deleted_at IS NULL;
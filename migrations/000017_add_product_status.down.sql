@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_products_status;
+
+ALTER TABLE products DROP CONSTRAINT IF EXISTS chk_products_publish_window;
+
+ALTER TABLE products DROP COLUMN IF EXISTS unpublish_at;
+ALTER TABLE products DROP COLUMN IF EXISTS publish_at;
+ALTER TABLE products DROP COLUMN IF EXISTS status;
@@ -0,0 +1,16 @@
+-- Where a product is in its lifecycle. Products created before there was a
+-- lifecycle were public, so they start out active.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'active'
+CHECK (status IN ('draft', 'active', 'archived', 'discontinued'));
+
+-- The window an active product is public in; an open end is unbounded.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP WITH TIME ZONE NULL;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS unpublish_at TIMESTAMP WITH TIME ZONE NULL;
+
+ALTER TABLE products ADD CONSTRAINT chk_products_publish_window
+CHECK (unpublish_at IS NULL OR publish_at IS NULL OR unpublish_at > publish_at);
+
+-- Public listings only read active products inside their publish window.
+CREATE INDEX IF NOT EXISTS idx_products_status
+ON products (status, publish_at, unpublish_at)
+WHERE deleted_at IS NULL;
@@ -0,0 +1,18 @@
+-- One row per write to an audited row, inserted in the same transaction as
+-- the write. before and after only hold the columns the write changed; before
+-- is NULL for a created row and after for a purged one. There is no foreign
+-- key to the audited table, so the history outlives purged rows.
+CREATE TABLE IF NOT EXISTS audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    entity_type VARCHAR(32) NOT NULL,
+    entity_id BIGINT NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    actor VARCHAR(255) NULL,
+    request_id TEXT NULL,
+    before JSONB NULL,
+    after JSONB NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_entity
+ON audit_events (entity_type, entity_id, id);
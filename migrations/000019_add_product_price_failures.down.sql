@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_product_prices_due;
+
+CREATE INDEX IF NOT EXISTS idx_product_prices_due
+ON product_prices (valid_from) WHERE applied_at IS NULL;
+
+ALTER TABLE product_prices DROP COLUMN IF EXISTS failure;
+ALTER TABLE product_prices DROP COLUMN IF EXISTS failed_at;
@@ -0,0 +1,9 @@
+-- A scheduled price the scheduler could not apply is marked failed, with the
+-- reason, so it no longer holds back the prices due after it.
+ALTER TABLE product_prices ADD COLUMN IF NOT EXISTS failed_at TIMESTAMP WITH TIME ZONE NULL;
+ALTER TABLE product_prices ADD COLUMN IF NOT EXISTS failure TEXT NULL;
+
+DROP INDEX IF EXISTS idx_product_prices_due;
+
+CREATE INDEX IF NOT EXISTS idx_product_prices_due
+ON product_prices (valid_from) WHERE applied_at IS NULL AND failed_at IS NULL;
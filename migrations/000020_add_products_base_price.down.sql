@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_products_base_price_id_sort;
+
+CREATE INDEX IF NOT EXISTS idx_products_price_id_sort
+ON products (price, id)
+WHERE deleted_at IS NULL;
+
+DROP TRIGGER IF EXISTS trg_exchange_rates_reprice ON exchange_rates;
+DROP FUNCTION IF EXISTS exchange_rates_reprice_products();
+
+DROP TRIGGER IF EXISTS trg_products_base_price ON products;
+DROP FUNCTION IF EXISTS products_set_base_price();
+
+ALTER TABLE products DROP COLUMN IF EXISTS base_price;
@@ -0,0 +1,48 @@
+-- Products can be priced in any currency with an exchange rate, so price
+-- filters, sorting and facets compare base_price: the price converted into
+-- the base currency IDR (whole rupiah) with the current rate.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS base_price BIGINT NULL;
+
+UPDATE products
+SET base_price = ROUND(products.price * exchange_rates.rate / power(10, exchange_rates.minor_units))
+FROM exchange_rates
+WHERE exchange_rates.currency = products.currency;
+
+-- A product written with a new price or currency is converted on the spot.
+CREATE OR REPLACE FUNCTION products_set_base_price() RETURNS trigger AS $$
+BEGIN
+    SELECT ROUND(NEW.price * rate / power(10, minor_units))
+    INTO NEW.base_price
+    FROM exchange_rates
+    WHERE currency = NEW.currency;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_products_base_price ON products;
+CREATE TRIGGER trg_products_base_price
+BEFORE INSERT OR UPDATE OF price, currency ON products
+FOR EACH ROW EXECUTE FUNCTION products_set_base_price();
+
+-- A new rate converts every product priced in the currency again.
+CREATE OR REPLACE FUNCTION exchange_rates_reprice_products() RETURNS trigger AS $$
+BEGIN
+    UPDATE products
+    SET base_price = ROUND(price * NEW.rate / power(10, NEW.minor_units))
+    WHERE currency = NEW.currency;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_exchange_rates_reprice ON exchange_rates;
+CREATE TRIGGER trg_exchange_rates_reprice
+AFTER UPDATE OF rate, minor_units ON exchange_rates
+FOR EACH ROW WHEN (OLD.rate IS DISTINCT FROM NEW.rate OR OLD.minor_units IS DISTINCT FROM NEW.minor_units)
+EXECUTE FUNCTION exchange_rates_reprice_products();
+
+-- Sorting by price seeks on the converted price.
+DROP INDEX IF EXISTS idx_products_price_id_sort;
+
+CREATE INDEX IF NOT EXISTS idx_products_base_price_id_sort
+ON products (base_price, id)
+WHERE deleted_at IS NULL;
@@ -0,0 +1,9 @@
+-- The publish job looks for the windows that opened or closed since its last
+-- run; each end is read through its own index and the results are combined.
+CREATE INDEX IF NOT EXISTS idx_products_publish_at
+ON products (publish_at)
+WHERE publish_at IS NOT NULL AND deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_products_unpublish_at
+ON products (unpublish_at)
+WHERE unpublish_at IS NOT NULL AND deleted_at IS NULL;
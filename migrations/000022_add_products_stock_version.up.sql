@@ -0,0 +1,4 @@
+-- Stock writes bump their own counter instead of the version, so checkouts
+-- moving the stock do not fail the If-Match check of catalog edits. The
+-- ETag of the detail carries both.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS stock_version BIGINT NOT NULL DEFAULT 0;
@@ -8,6 +8,7 @@ import (
 	"context"
 	"erajaya-test/internal/models/entity"
 	"erajaya-test/internal/models/request"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,6 +40,142 @@ func (_m *ProductRepository) EXPECT() *ProductRepository_Expecter {
 	return &ProductRepository_Expecter{mock: &_m.Mock}
 }
 
+// BrandFacets provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) BrandFacets(ctx context.Context, filter request.ProductFilter) ([]entity.FacetCount, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BrandFacets")
+	}
+
+	var r0 []entity.FacetCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter) ([]entity.FacetCount, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter) []entity.FacetCount); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.FacetCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, request.ProductFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductRepository_BrandFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BrandFacets'
+type ProductRepository_BrandFacets_Call struct {
+	*mock.Call
+}
+
+// BrandFacets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter request.ProductFilter
+func (_e *ProductRepository_Expecter) BrandFacets(ctx interface{}, filter interface{}) *ProductRepository_BrandFacets_Call {
+	return &ProductRepository_BrandFacets_Call{Call: _e.mock.On("BrandFacets", ctx, filter)}
+}
+
+func (_c *ProductRepository_BrandFacets_Call) Run(run func(ctx context.Context, filter request.ProductFilter)) *ProductRepository_BrandFacets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 request.ProductFilter
+		if args[1] != nil {
+			arg1 = args[1].(request.ProductFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_BrandFacets_Call) Return(facetCounts []entity.FacetCount, err error) *ProductRepository_BrandFacets_Call {
+	_c.Call.Return(facetCounts, err)
+	return _c
+}
+
+func (_c *ProductRepository_BrandFacets_Call) RunAndReturn(run func(ctx context.Context, filter request.ProductFilter) ([]entity.FacetCount, error)) *ProductRepository_BrandFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CategoryFacets provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) CategoryFacets(ctx context.Context, filter request.ProductFilter) ([]entity.FacetCount, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CategoryFacets")
+	}
+
+	var r0 []entity.FacetCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter) ([]entity.FacetCount, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter) []entity.FacetCount); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.FacetCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, request.ProductFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductRepository_CategoryFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CategoryFacets'
+type ProductRepository_CategoryFacets_Call struct {
+	*mock.Call
+}
+
+// CategoryFacets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter request.ProductFilter
+func (_e *ProductRepository_Expecter) CategoryFacets(ctx interface{}, filter interface{}) *ProductRepository_CategoryFacets_Call {
+	return &ProductRepository_CategoryFacets_Call{Call: _e.mock.On("CategoryFacets", ctx, filter)}
+}
+
+func (_c *ProductRepository_CategoryFacets_Call) Run(run func(ctx context.Context, filter request.ProductFilter)) *ProductRepository_CategoryFacets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 request.ProductFilter
+		if args[1] != nil {
+			arg1 = args[1].(request.ProductFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_CategoryFacets_Call) Return(facetCounts []entity.FacetCount, err error) *ProductRepository_CategoryFacets_Call {
+	_c.Call.Return(facetCounts, err)
+	return _c
+}
+
+func (_c *ProductRepository_CategoryFacets_Call) RunAndReturn(run func(ctx context.Context, filter request.ProductFilter) ([]entity.FacetCount, error)) *ProductRepository_CategoryFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type ProductRepository
 func (_mock *ProductRepository) Create(ctx context.Context, product *entity.Product) error {
 	ret := _mock.Called(ctx, product)
@@ -96,6 +233,138 @@ func (_c *ProductRepository_Create_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// CreateBatch provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) CreateBatch(ctx context.Context, products []*entity.Product, batchSize int) error {
+	ret := _mock.Called(ctx, products, batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entity.Product, int) error); ok {
+		r0 = returnFunc(ctx, products, batchSize)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ProductRepository_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type ProductRepository_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - products []*entity.Product
+//   - batchSize int
+func (_e *ProductRepository_Expecter) CreateBatch(ctx interface{}, products interface{}, batchSize interface{}) *ProductRepository_CreateBatch_Call {
+	return &ProductRepository_CreateBatch_Call{Call: _e.mock.On("CreateBatch", ctx, products, batchSize)}
+}
+
+func (_c *ProductRepository_CreateBatch_Call) Run(run func(ctx context.Context, products []*entity.Product, batchSize int)) *ProductRepository_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entity.Product
+		if args[1] != nil {
+			arg1 = args[1].([]*entity.Product)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_CreateBatch_Call) Return(err error) *ProductRepository_CreateBatch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ProductRepository_CreateBatch_Call) RunAndReturn(run func(ctx context.Context, products []*entity.Product, batchSize int) error) *ProductRepository_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) Delete(ctx context.Context, id int64, version int64, deletedBy string) error {
+	ret := _mock.Called(ctx, id, version, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, string) error); ok {
+		r0 = returnFunc(ctx, id, version, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ProductRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ProductRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - version int64
+//   - deletedBy string
+func (_e *ProductRepository_Expecter) Delete(ctx interface{}, id interface{}, version interface{}, deletedBy interface{}) *ProductRepository_Delete_Call {
+	return &ProductRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, version, deletedBy)}
+}
+
+func (_c *ProductRepository_Delete_Call) Run(run func(ctx context.Context, id int64, version int64, deletedBy string)) *ProductRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_Delete_Call) Return(err error) *ProductRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ProductRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64, version int64, deletedBy string) error) *ProductRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Fetch provides a mock function for the type ProductRepository
 func (_mock *ProductRepository) Fetch(ctx context.Context, filter request.ProductFilter) ([]entity.Product, int64, error) {
 	ret := _mock.Called(ctx, filter)
@@ -170,47 +439,55 @@ func (_c *ProductRepository_Fetch_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
-// GetByID provides a mock function for the type ProductRepository
-func (_mock *ProductRepository) GetByID(ctx context.Context, id int64) (*entity.Product, error) {
-	ret := _mock.Called(ctx, id)
+// FetchHistory provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) FetchHistory(ctx context.Context, id int64, page int, limit int) ([]entity.AuditEvent, int64, error) {
+	ret := _mock.Called(ctx, id, page, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for FetchHistory")
 	}
 
-	var r0 *entity.Product
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*entity.Product, error)); ok {
-		return returnFunc(ctx, id)
+	var r0 []entity.AuditEvent
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]entity.AuditEvent, int64, error)); ok {
+		return returnFunc(ctx, id, page, limit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *entity.Product); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) []entity.AuditEvent); ok {
+		r0 = returnFunc(ctx, id, page, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entity.Product)
+			r0 = ret.Get(0).([]entity.AuditEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int, int) int64); ok {
+		r1 = returnFunc(ctx, id, page, limit)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int64)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
+		r2 = returnFunc(ctx, id, page, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// ProductRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type ProductRepository_GetByID_Call struct {
+// ProductRepository_FetchHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchHistory'
+type ProductRepository_FetchHistory_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// FetchHistory is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
-func (_e *ProductRepository_Expecter) GetByID(ctx interface{}, id interface{}) *ProductRepository_GetByID_Call {
-	return &ProductRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+//   - page int
+//   - limit int
+func (_e *ProductRepository_Expecter) FetchHistory(ctx interface{}, id interface{}, page interface{}, limit interface{}) *ProductRepository_FetchHistory_Call {
+	return &ProductRepository_FetchHistory_Call{Call: _e.mock.On("FetchHistory", ctx, id, page, limit)}
 }
 
-func (_c *ProductRepository_GetByID_Call) Run(run func(ctx context.Context, id int64)) *ProductRepository_GetByID_Call {
+func (_c *ProductRepository_FetchHistory_Call) Run(run func(ctx context.Context, id int64, page int, limit int)) *ProductRepository_FetchHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -220,20 +497,878 @@ func (_c *ProductRepository_GetByID_Call) Run(run func(ctx context.Context, id i
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *ProductRepository_GetByID_Call) Return(product *entity.Product, err error) *ProductRepository_GetByID_Call {
-	_c.Call.Return(product, err)
+func (_c *ProductRepository_FetchHistory_Call) Return(auditEvents []entity.AuditEvent, n int64, err error) *ProductRepository_FetchHistory_Call {
+	_c.Call.Return(auditEvents, n, err)
 	return _c
 }
 
-func (_c *ProductRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id int64) (*entity.Product, error)) *ProductRepository_GetByID_Call {
+func (_c *ProductRepository_FetchHistory_Call) RunAndReturn(run func(ctx context.Context, id int64, page int, limit int) ([]entity.AuditEvent, int64, error)) *ProductRepository_FetchHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchPublishChanges provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) FetchPublishChanges(ctx context.Context, from time.Time, to time.Time) ([]entity.Product, error) {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchPublishChanges")
+	}
+
+	var r0 []entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]entity.Product, error)); ok {
+		return returnFunc(ctx, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []entity.Product); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductRepository_FetchPublishChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchPublishChanges'
+type ProductRepository_FetchPublishChanges_Call struct {
+	*mock.Call
+}
+
+// FetchPublishChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *ProductRepository_Expecter) FetchPublishChanges(ctx interface{}, from interface{}, to interface{}) *ProductRepository_FetchPublishChanges_Call {
+	return &ProductRepository_FetchPublishChanges_Call{Call: _e.mock.On("FetchPublishChanges", ctx, from, to)}
+}
+
+func (_c *ProductRepository_FetchPublishChanges_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *ProductRepository_FetchPublishChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_FetchPublishChanges_Call) Return(products []entity.Product, err error) *ProductRepository_FetchPublishChanges_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *ProductRepository_FetchPublishChanges_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) ([]entity.Product, error)) *ProductRepository_FetchPublishChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) GetByID(ctx context.Context, id int64) (*entity.Product, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*entity.Product, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *entity.Product); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type ProductRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *ProductRepository_Expecter) GetByID(ctx interface{}, id interface{}) *ProductRepository_GetByID_Call {
+	return &ProductRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *ProductRepository_GetByID_Call) Run(run func(ctx context.Context, id int64)) *ProductRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_GetByID_Call) Return(product *entity.Product, err error) *ProductRepository_GetByID_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *ProductRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id int64) (*entity.Product, error)) *ProductRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBySKU provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) GetBySKU(ctx context.Context, sku string) (*entity.Product, error) {
+	ret := _mock.Called(ctx, sku)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySKU")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entity.Product, error)); ok {
+		return returnFunc(ctx, sku)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entity.Product); ok {
+		r0 = returnFunc(ctx, sku)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, sku)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductRepository_GetBySKU_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBySKU'
+type ProductRepository_GetBySKU_Call struct {
+	*mock.Call
+}
+
+// GetBySKU is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sku string
+func (_e *ProductRepository_Expecter) GetBySKU(ctx interface{}, sku interface{}) *ProductRepository_GetBySKU_Call {
+	return &ProductRepository_GetBySKU_Call{Call: _e.mock.On("GetBySKU", ctx, sku)}
+}
+
+func (_c *ProductRepository_GetBySKU_Call) Run(run func(ctx context.Context, sku string)) *ProductRepository_GetBySKU_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_GetBySKU_Call) Return(product *entity.Product, err error) *ProductRepository_GetBySKU_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *ProductRepository_GetBySKU_Call) RunAndReturn(run func(ctx context.Context, sku string) (*entity.Product, error)) *ProductRepository_GetBySKU_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBySlug provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) GetBySlug(ctx context.Context, slug string) (*entity.Product, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySlug")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entity.Product, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entity.Product); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductRepository_GetBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBySlug'
+type ProductRepository_GetBySlug_Call struct {
+	*mock.Call
+}
+
+// GetBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *ProductRepository_Expecter) GetBySlug(ctx interface{}, slug interface{}) *ProductRepository_GetBySlug_Call {
+	return &ProductRepository_GetBySlug_Call{Call: _e.mock.On("GetBySlug", ctx, slug)}
+}
+
+func (_c *ProductRepository_GetBySlug_Call) Run(run func(ctx context.Context, slug string)) *ProductRepository_GetBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_GetBySlug_Call) Return(product *entity.Product, err error) *ProductRepository_GetBySlug_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *ProductRepository_GetBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*entity.Product, error)) *ProductRepository_GetBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) Patch(ctx context.Context, id int64, version int64, fields map[string]interface{}) (*entity.Product, error) {
+	ret := _mock.Called(ctx, id, version, fields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, map[string]interface{}) (*entity.Product, error)); ok {
+		return returnFunc(ctx, id, version, fields)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, map[string]interface{}) *entity.Product); ok {
+		r0 = returnFunc(ctx, id, version, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, map[string]interface{}) error); ok {
+		r1 = returnFunc(ctx, id, version, fields)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductRepository_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type ProductRepository_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - version int64
+//   - fields map[string]interface{}
+func (_e *ProductRepository_Expecter) Patch(ctx interface{}, id interface{}, version interface{}, fields interface{}) *ProductRepository_Patch_Call {
+	return &ProductRepository_Patch_Call{Call: _e.mock.On("Patch", ctx, id, version, fields)}
+}
+
+func (_c *ProductRepository_Patch_Call) Run(run func(ctx context.Context, id int64, version int64, fields map[string]interface{})) *ProductRepository_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 map[string]interface{}
+		if args[3] != nil {
+			arg3 = args[3].(map[string]interface{})
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_Patch_Call) Return(product *entity.Product, err error) *ProductRepository_Patch_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *ProductRepository_Patch_Call) RunAndReturn(run func(ctx context.Context, id int64, version int64, fields map[string]interface{}) (*entity.Product, error)) *ProductRepository_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PriceFacets provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) PriceFacets(ctx context.Context, filter request.ProductFilter, bounds []int64) ([]entity.PriceBucket, error) {
+	ret := _mock.Called(ctx, filter, bounds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceFacets")
+	}
+
+	var r0 []entity.PriceBucket
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter, []int64) ([]entity.PriceBucket, error)); ok {
+		return returnFunc(ctx, filter, bounds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter, []int64) []entity.PriceBucket); ok {
+		r0 = returnFunc(ctx, filter, bounds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PriceBucket)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, request.ProductFilter, []int64) error); ok {
+		r1 = returnFunc(ctx, filter, bounds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductRepository_PriceFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PriceFacets'
+type ProductRepository_PriceFacets_Call struct {
+	*mock.Call
+}
+
+// PriceFacets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter request.ProductFilter
+//   - bounds []int64
+func (_e *ProductRepository_Expecter) PriceFacets(ctx interface{}, filter interface{}, bounds interface{}) *ProductRepository_PriceFacets_Call {
+	return &ProductRepository_PriceFacets_Call{Call: _e.mock.On("PriceFacets", ctx, filter, bounds)}
+}
+
+func (_c *ProductRepository_PriceFacets_Call) Run(run func(ctx context.Context, filter request.ProductFilter, bounds []int64)) *ProductRepository_PriceFacets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 request.ProductFilter
+		if args[1] != nil {
+			arg1 = args[1].(request.ProductFilter)
+		}
+		var arg2 []int64
+		if args[2] != nil {
+			arg2 = args[2].([]int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_PriceFacets_Call) Return(priceBuckets []entity.PriceBucket, err error) *ProductRepository_PriceFacets_Call {
+	_c.Call.Return(priceBuckets, err)
+	return _c
+}
+
+func (_c *ProductRepository_PriceFacets_Call) RunAndReturn(run func(ctx context.Context, filter request.ProductFilter, bounds []int64) ([]entity.PriceBucket, error)) *ProductRepository_PriceFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, []string, error) {
+	ret := _mock.Called(ctx, deletedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 []string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, []string, error)); ok {
+		return returnFunc(ctx, deletedBefore)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) []string); ok {
+		r1 = returnFunc(ctx, deletedBefore)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, time.Time) error); ok {
+		r2 = returnFunc(ctx, deletedBefore)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// ProductRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type ProductRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+func (_e *ProductRepository_Expecter) Purge(ctx interface{}, deletedBefore interface{}) *ProductRepository_Purge_Call {
+	return &ProductRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, deletedBefore)}
+}
+
+func (_c *ProductRepository_Purge_Call) Run(run func(ctx context.Context, deletedBefore time.Time)) *ProductRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_Purge_Call) Return(n int64, ss []string, err error) *ProductRepository_Purge_Call {
+	_c.Call.Return(n, ss, err)
+	return _c
+}
+
+func (_c *ProductRepository_Purge_Call) RunAndReturn(run func(ctx context.Context, deletedBefore time.Time) (int64, []string, error)) *ProductRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) Restore(ctx context.Context, id int64, version int64, updatedBy string) (*entity.Product, error) {
+	ret := _mock.Called(ctx, id, version, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, string) (*entity.Product, error)); ok {
+		return returnFunc(ctx, id, version, updatedBy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, string) *entity.Product); ok {
+		r0 = returnFunc(ctx, id, version, updatedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, string) error); ok {
+		r1 = returnFunc(ctx, id, version, updatedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type ProductRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - version int64
+//   - updatedBy string
+func (_e *ProductRepository_Expecter) Restore(ctx interface{}, id interface{}, version interface{}, updatedBy interface{}) *ProductRepository_Restore_Call {
+	return &ProductRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id, version, updatedBy)}
+}
+
+func (_c *ProductRepository_Restore_Call) Run(run func(ctx context.Context, id int64, version int64, updatedBy string)) *ProductRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_Restore_Call) Return(product *entity.Product, err error) *ProductRepository_Restore_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *ProductRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, id int64, version int64, updatedBy string) (*entity.Product, error)) *ProductRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StockFacets provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) StockFacets(ctx context.Context, filter request.ProductFilter) (*entity.StockCount, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StockFacets")
+	}
+
+	var r0 *entity.StockCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter) (*entity.StockCount, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter) *entity.StockCount); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.StockCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, request.ProductFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductRepository_StockFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StockFacets'
+type ProductRepository_StockFacets_Call struct {
+	*mock.Call
+}
+
+// StockFacets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter request.ProductFilter
+func (_e *ProductRepository_Expecter) StockFacets(ctx interface{}, filter interface{}) *ProductRepository_StockFacets_Call {
+	return &ProductRepository_StockFacets_Call{Call: _e.mock.On("StockFacets", ctx, filter)}
+}
+
+func (_c *ProductRepository_StockFacets_Call) Run(run func(ctx context.Context, filter request.ProductFilter)) *ProductRepository_StockFacets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 request.ProductFilter
+		if args[1] != nil {
+			arg1 = args[1].(request.ProductFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_StockFacets_Call) Return(stockCount *entity.StockCount, err error) *ProductRepository_StockFacets_Call {
+	_c.Call.Return(stockCount, err)
+	return _c
+}
+
+func (_c *ProductRepository_StockFacets_Call) RunAndReturn(run func(ctx context.Context, filter request.ProductFilter) (*entity.StockCount, error)) *ProductRepository_StockFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stream provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) Stream(ctx context.Context, filter request.ProductFilter, fn func(product entity.Product) error) error {
+	ret := _mock.Called(ctx, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stream")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter, func(product entity.Product) error) error); ok {
+		r0 = returnFunc(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ProductRepository_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type ProductRepository_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter request.ProductFilter
+//   - fn func(product entity.Product) error
+func (_e *ProductRepository_Expecter) Stream(ctx interface{}, filter interface{}, fn interface{}) *ProductRepository_Stream_Call {
+	return &ProductRepository_Stream_Call{Call: _e.mock.On("Stream", ctx, filter, fn)}
+}
+
+func (_c *ProductRepository_Stream_Call) Run(run func(ctx context.Context, filter request.ProductFilter, fn func(product entity.Product) error)) *ProductRepository_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 request.ProductFilter
+		if args[1] != nil {
+			arg1 = args[1].(request.ProductFilter)
+		}
+		var arg2 func(product entity.Product) error
+		if args[2] != nil {
+			arg2 = args[2].(func(product entity.Product) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_Stream_Call) Return(err error) *ProductRepository_Stream_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ProductRepository_Stream_Call) RunAndReturn(run func(ctx context.Context, filter request.ProductFilter, fn func(product entity.Product) error) error) *ProductRepository_Stream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Suggest provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) Suggest(ctx context.Context, term string, limit int) ([]entity.ProductSuggestion, error) {
+	ret := _mock.Called(ctx, term, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Suggest")
+	}
+
+	var r0 []entity.ProductSuggestion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]entity.ProductSuggestion, error)); ok {
+		return returnFunc(ctx, term, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []entity.ProductSuggestion); ok {
+		r0 = returnFunc(ctx, term, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ProductSuggestion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, term, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductRepository_Suggest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suggest'
+type ProductRepository_Suggest_Call struct {
+	*mock.Call
+}
+
+// Suggest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - term string
+//   - limit int
+func (_e *ProductRepository_Expecter) Suggest(ctx interface{}, term interface{}, limit interface{}) *ProductRepository_Suggest_Call {
+	return &ProductRepository_Suggest_Call{Call: _e.mock.On("Suggest", ctx, term, limit)}
+}
+
+func (_c *ProductRepository_Suggest_Call) Run(run func(ctx context.Context, term string, limit int)) *ProductRepository_Suggest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_Suggest_Call) Return(productSuggestions []entity.ProductSuggestion, err error) *ProductRepository_Suggest_Call {
+	_c.Call.Return(productSuggestions, err)
+	return _c
+}
+
+func (_c *ProductRepository_Suggest_Call) RunAndReturn(run func(ctx context.Context, term string, limit int) ([]entity.ProductSuggestion, error)) *ProductRepository_Suggest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type ProductRepository
+func (_mock *ProductRepository) Update(ctx context.Context, product *entity.Product) error {
+	ret := _mock.Called(ctx, product)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.Product) error); ok {
+		r0 = returnFunc(ctx, product)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ProductRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ProductRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product *entity.Product
+func (_e *ProductRepository_Expecter) Update(ctx interface{}, product interface{}) *ProductRepository_Update_Call {
+	return &ProductRepository_Update_Call{Call: _e.mock.On("Update", ctx, product)}
+}
+
+func (_c *ProductRepository_Update_Call) Run(run func(ctx context.Context, product *entity.Product)) *ProductRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entity.Product
+		if args[1] != nil {
+			arg1 = args[1].(*entity.Product)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductRepository_Update_Call) Return(err error) *ProductRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ProductRepository_Update_Call) RunAndReturn(run func(ctx context.Context, product *entity.Product) error) *ProductRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
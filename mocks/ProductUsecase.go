@@ -9,6 +9,7 @@ import (
 	"erajaya-test/internal/models/entity"
 	"erajaya-test/internal/models/request"
 	"erajaya-test/shared/response"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -40,6 +41,80 @@ func (_m *ProductUsecase) EXPECT() *ProductUsecase_Expecter {
 	return &ProductUsecase_Expecter{mock: &_m.Mock}
 }
 
+// AutocompleteProducts provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) AutocompleteProducts(ctx context.Context, prefix string, limit int) ([]entity.ProductAutocomplete, error) {
+	ret := _mock.Called(ctx, prefix, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AutocompleteProducts")
+	}
+
+	var r0 []entity.ProductAutocomplete
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]entity.ProductAutocomplete, error)); ok {
+		return returnFunc(ctx, prefix, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []entity.ProductAutocomplete); ok {
+		r0 = returnFunc(ctx, prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ProductAutocomplete)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductUsecase_AutocompleteProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutocompleteProducts'
+type ProductUsecase_AutocompleteProducts_Call struct {
+	*mock.Call
+}
+
+// AutocompleteProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - limit int
+func (_e *ProductUsecase_Expecter) AutocompleteProducts(ctx interface{}, prefix interface{}, limit interface{}) *ProductUsecase_AutocompleteProducts_Call {
+	return &ProductUsecase_AutocompleteProducts_Call{Call: _e.mock.On("AutocompleteProducts", ctx, prefix, limit)}
+}
+
+func (_c *ProductUsecase_AutocompleteProducts_Call) Run(run func(ctx context.Context, prefix string, limit int)) *ProductUsecase_AutocompleteProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_AutocompleteProducts_Call) Return(productAutocompletes []entity.ProductAutocomplete, err error) *ProductUsecase_AutocompleteProducts_Call {
+	_c.Call.Return(productAutocompletes, err)
+	return _c
+}
+
+func (_c *ProductUsecase_AutocompleteProducts_Call) RunAndReturn(run func(ctx context.Context, prefix string, limit int) ([]entity.ProductAutocomplete, error)) *ProductUsecase_AutocompleteProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateProduct provides a mock function for the type ProductUsecase
 func (_mock *ProductUsecase) CreateProduct(ctx context.Context, req *request.Product) error {
 	ret := _mock.Called(ctx, req)
@@ -97,6 +172,204 @@ func (_c *ProductUsecase_CreateProduct_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// CreateProductsBulk provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) CreateProductsBulk(ctx context.Context, req *request.ProductBulk) (response.BulkResult, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProductsBulk")
+	}
+
+	var r0 response.BulkResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *request.ProductBulk) (response.BulkResult, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *request.ProductBulk) response.BulkResult); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Get(0).(response.BulkResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *request.ProductBulk) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductUsecase_CreateProductsBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProductsBulk'
+type ProductUsecase_CreateProductsBulk_Call struct {
+	*mock.Call
+}
+
+// CreateProductsBulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *request.ProductBulk
+func (_e *ProductUsecase_Expecter) CreateProductsBulk(ctx interface{}, req interface{}) *ProductUsecase_CreateProductsBulk_Call {
+	return &ProductUsecase_CreateProductsBulk_Call{Call: _e.mock.On("CreateProductsBulk", ctx, req)}
+}
+
+func (_c *ProductUsecase_CreateProductsBulk_Call) Run(run func(ctx context.Context, req *request.ProductBulk)) *ProductUsecase_CreateProductsBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *request.ProductBulk
+		if args[1] != nil {
+			arg1 = args[1].(*request.ProductBulk)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_CreateProductsBulk_Call) Return(bulkResult response.BulkResult, err error) *ProductUsecase_CreateProductsBulk_Call {
+	_c.Call.Return(bulkResult, err)
+	return _c
+}
+
+func (_c *ProductUsecase_CreateProductsBulk_Call) RunAndReturn(run func(ctx context.Context, req *request.ProductBulk) (response.BulkResult, error)) *ProductUsecase_CreateProductsBulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProduct provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) DeleteProduct(ctx context.Context, id int64, version int64, req *request.ProductDelete) error {
+	ret := _mock.Called(ctx, id, version, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, *request.ProductDelete) error); ok {
+		r0 = returnFunc(ctx, id, version, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ProductUsecase_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
+type ProductUsecase_DeleteProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - version int64
+//   - req *request.ProductDelete
+func (_e *ProductUsecase_Expecter) DeleteProduct(ctx interface{}, id interface{}, version interface{}, req interface{}) *ProductUsecase_DeleteProduct_Call {
+	return &ProductUsecase_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, id, version, req)}
+}
+
+func (_c *ProductUsecase_DeleteProduct_Call) Run(run func(ctx context.Context, id int64, version int64, req *request.ProductDelete)) *ProductUsecase_DeleteProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 *request.ProductDelete
+		if args[3] != nil {
+			arg3 = args[3].(*request.ProductDelete)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_DeleteProduct_Call) Return(err error) *ProductUsecase_DeleteProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ProductUsecase_DeleteProduct_Call) RunAndReturn(run func(ctx context.Context, id int64, version int64, req *request.ProductDelete) error) *ProductUsecase_DeleteProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportProducts provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) ExportProducts(ctx context.Context, filter request.ProductFilter, fn func(product entity.Product) error) error {
+	ret := _mock.Called(ctx, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportProducts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter, func(product entity.Product) error) error); ok {
+		r0 = returnFunc(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ProductUsecase_ExportProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportProducts'
+type ProductUsecase_ExportProducts_Call struct {
+	*mock.Call
+}
+
+// ExportProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter request.ProductFilter
+//   - fn func(product entity.Product) error
+func (_e *ProductUsecase_Expecter) ExportProducts(ctx interface{}, filter interface{}, fn interface{}) *ProductUsecase_ExportProducts_Call {
+	return &ProductUsecase_ExportProducts_Call{Call: _e.mock.On("ExportProducts", ctx, filter, fn)}
+}
+
+func (_c *ProductUsecase_ExportProducts_Call) Run(run func(ctx context.Context, filter request.ProductFilter, fn func(product entity.Product) error)) *ProductUsecase_ExportProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 request.ProductFilter
+		if args[1] != nil {
+			arg1 = args[1].(request.ProductFilter)
+		}
+		var arg2 func(product entity.Product) error
+		if args[2] != nil {
+			arg2 = args[2].(func(product entity.Product) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_ExportProducts_Call) Return(err error) *ProductUsecase_ExportProducts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ProductUsecase_ExportProducts_Call) RunAndReturn(run func(ctx context.Context, filter request.ProductFilter, fn func(product entity.Product) error) error) *ProductUsecase_ExportProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProductByID provides a mock function for the type ProductUsecase
 func (_mock *ProductUsecase) GetProductByID(ctx context.Context, id int64) (*entity.Product, error) {
 	ret := _mock.Called(ctx, id)
@@ -165,61 +438,55 @@ func (_c *ProductUsecase_GetProductByID_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
-// ListProducts provides a mock function for the type ProductUsecase
-func (_mock *ProductUsecase) ListProducts(ctx context.Context, filter request.ProductFilter) ([]entity.Product, response.StdPagination, error) {
-	ret := _mock.Called(ctx, filter)
+// GetProductBySKU provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) GetProductBySKU(ctx context.Context, sku string) (*entity.Product, error) {
+	ret := _mock.Called(ctx, sku)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListProducts")
+		panic("no return value specified for GetProductBySKU")
 	}
 
-	var r0 []entity.Product
-	var r1 response.StdPagination
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter) ([]entity.Product, response.StdPagination, error)); ok {
-		return returnFunc(ctx, filter)
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entity.Product, error)); ok {
+		return returnFunc(ctx, sku)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter) []entity.Product); ok {
-		r0 = returnFunc(ctx, filter)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entity.Product); ok {
+		r0 = returnFunc(ctx, sku)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entity.Product)
+			r0 = ret.Get(0).(*entity.Product)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, request.ProductFilter) response.StdPagination); ok {
-		r1 = returnFunc(ctx, filter)
-	} else {
-		r1 = ret.Get(1).(response.StdPagination)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, request.ProductFilter) error); ok {
-		r2 = returnFunc(ctx, filter)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, sku)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// ProductUsecase_ListProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProducts'
-type ProductUsecase_ListProducts_Call struct {
+// ProductUsecase_GetProductBySKU_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductBySKU'
+type ProductUsecase_GetProductBySKU_Call struct {
 	*mock.Call
 }
 
-// ListProducts is a helper method to define mock.On call
+// GetProductBySKU is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter request.ProductFilter
-func (_e *ProductUsecase_Expecter) ListProducts(ctx interface{}, filter interface{}) *ProductUsecase_ListProducts_Call {
-	return &ProductUsecase_ListProducts_Call{Call: _e.mock.On("ListProducts", ctx, filter)}
+//   - sku string
+func (_e *ProductUsecase_Expecter) GetProductBySKU(ctx interface{}, sku interface{}) *ProductUsecase_GetProductBySKU_Call {
+	return &ProductUsecase_GetProductBySKU_Call{Call: _e.mock.On("GetProductBySKU", ctx, sku)}
 }
 
-func (_c *ProductUsecase_ListProducts_Call) Run(run func(ctx context.Context, filter request.ProductFilter)) *ProductUsecase_ListProducts_Call {
+func (_c *ProductUsecase_GetProductBySKU_Call) Run(run func(ctx context.Context, sku string)) *ProductUsecase_GetProductBySKU_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 request.ProductFilter
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(request.ProductFilter)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -229,12 +496,832 @@ func (_c *ProductUsecase_ListProducts_Call) Run(run func(ctx context.Context, fi
 	return _c
 }
 
-func (_c *ProductUsecase_ListProducts_Call) Return(products []entity.Product, stdPagination response.StdPagination, err error) *ProductUsecase_ListProducts_Call {
-	_c.Call.Return(products, stdPagination, err)
+func (_c *ProductUsecase_GetProductBySKU_Call) Return(product *entity.Product, err error) *ProductUsecase_GetProductBySKU_Call {
+	_c.Call.Return(product, err)
 	return _c
 }
 
-func (_c *ProductUsecase_ListProducts_Call) RunAndReturn(run func(ctx context.Context, filter request.ProductFilter) ([]entity.Product, response.StdPagination, error)) *ProductUsecase_ListProducts_Call {
+func (_c *ProductUsecase_GetProductBySKU_Call) RunAndReturn(run func(ctx context.Context, sku string) (*entity.Product, error)) *ProductUsecase_GetProductBySKU_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductBySlug provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) GetProductBySlug(ctx context.Context, slug string) (*entity.Product, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductBySlug")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entity.Product, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entity.Product); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductUsecase_GetProductBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductBySlug'
+type ProductUsecase_GetProductBySlug_Call struct {
+	*mock.Call
+}
+
+// GetProductBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *ProductUsecase_Expecter) GetProductBySlug(ctx interface{}, slug interface{}) *ProductUsecase_GetProductBySlug_Call {
+	return &ProductUsecase_GetProductBySlug_Call{Call: _e.mock.On("GetProductBySlug", ctx, slug)}
+}
+
+func (_c *ProductUsecase_GetProductBySlug_Call) Run(run func(ctx context.Context, slug string)) *ProductUsecase_GetProductBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_GetProductBySlug_Call) Return(product *entity.Product, err error) *ProductUsecase_GetProductBySlug_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *ProductUsecase_GetProductBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*entity.Product, error)) *ProductUsecase_GetProductBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductHistory provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) ListProductHistory(ctx context.Context, id int64, page int, limit int) ([]entity.AuditEvent, response.StdPagination, error) {
+	ret := _mock.Called(ctx, id, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProductHistory")
+	}
+
+	var r0 []entity.AuditEvent
+	var r1 response.StdPagination
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]entity.AuditEvent, response.StdPagination, error)); ok {
+		return returnFunc(ctx, id, page, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) []entity.AuditEvent); ok {
+		r0 = returnFunc(ctx, id, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int, int) response.StdPagination); ok {
+		r1 = returnFunc(ctx, id, page, limit)
+	} else {
+		r1 = ret.Get(1).(response.StdPagination)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
+		r2 = returnFunc(ctx, id, page, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// ProductUsecase_ListProductHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductHistory'
+type ProductUsecase_ListProductHistory_Call struct {
+	*mock.Call
+}
+
+// ListProductHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - page int
+//   - limit int
+func (_e *ProductUsecase_Expecter) ListProductHistory(ctx interface{}, id interface{}, page interface{}, limit interface{}) *ProductUsecase_ListProductHistory_Call {
+	return &ProductUsecase_ListProductHistory_Call{Call: _e.mock.On("ListProductHistory", ctx, id, page, limit)}
+}
+
+func (_c *ProductUsecase_ListProductHistory_Call) Run(run func(ctx context.Context, id int64, page int, limit int)) *ProductUsecase_ListProductHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_ListProductHistory_Call) Return(auditEvents []entity.AuditEvent, stdPagination response.StdPagination, err error) *ProductUsecase_ListProductHistory_Call {
+	_c.Call.Return(auditEvents, stdPagination, err)
+	return _c
+}
+
+func (_c *ProductUsecase_ListProductHistory_Call) RunAndReturn(run func(ctx context.Context, id int64, page int, limit int) ([]entity.AuditEvent, response.StdPagination, error)) *ProductUsecase_ListProductHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProducts provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) ListProducts(ctx context.Context, filter request.ProductFilter) ([]entity.Product, response.StdPagination, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProducts")
+	}
+
+	var r0 []entity.Product
+	var r1 response.StdPagination
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter) ([]entity.Product, response.StdPagination, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, request.ProductFilter) []entity.Product); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, request.ProductFilter) response.StdPagination); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(response.StdPagination)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, request.ProductFilter) error); ok {
+		r2 = returnFunc(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// ProductUsecase_ListProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProducts'
+type ProductUsecase_ListProducts_Call struct {
+	*mock.Call
+}
+
+// ListProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter request.ProductFilter
+func (_e *ProductUsecase_Expecter) ListProducts(ctx interface{}, filter interface{}) *ProductUsecase_ListProducts_Call {
+	return &ProductUsecase_ListProducts_Call{Call: _e.mock.On("ListProducts", ctx, filter)}
+}
+
+func (_c *ProductUsecase_ListProducts_Call) Run(run func(ctx context.Context, filter request.ProductFilter)) *ProductUsecase_ListProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 request.ProductFilter
+		if args[1] != nil {
+			arg1 = args[1].(request.ProductFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_ListProducts_Call) Return(products []entity.Product, stdPagination response.StdPagination, err error) *ProductUsecase_ListProducts_Call {
+	_c.Call.Return(products, stdPagination, err)
+	return _c
+}
+
+func (_c *ProductUsecase_ListProducts_Call) RunAndReturn(run func(ctx context.Context, filter request.ProductFilter) ([]entity.Product, response.StdPagination, error)) *ProductUsecase_ListProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchProduct provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) PatchProduct(ctx context.Context, id int64, version int64, patch map[string]interface{}) (*entity.Product, error) {
+	ret := _mock.Called(ctx, id, version, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchProduct")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, map[string]interface{}) (*entity.Product, error)); ok {
+		return returnFunc(ctx, id, version, patch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, map[string]interface{}) *entity.Product); ok {
+		r0 = returnFunc(ctx, id, version, patch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, map[string]interface{}) error); ok {
+		r1 = returnFunc(ctx, id, version, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductUsecase_PatchProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchProduct'
+type ProductUsecase_PatchProduct_Call struct {
+	*mock.Call
+}
+
+// PatchProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - version int64
+//   - patch map[string]interface{}
+func (_e *ProductUsecase_Expecter) PatchProduct(ctx interface{}, id interface{}, version interface{}, patch interface{}) *ProductUsecase_PatchProduct_Call {
+	return &ProductUsecase_PatchProduct_Call{Call: _e.mock.On("PatchProduct", ctx, id, version, patch)}
+}
+
+func (_c *ProductUsecase_PatchProduct_Call) Run(run func(ctx context.Context, id int64, version int64, patch map[string]interface{})) *ProductUsecase_PatchProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 map[string]interface{}
+		if args[3] != nil {
+			arg3 = args[3].(map[string]interface{})
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_PatchProduct_Call) Return(product *entity.Product, err error) *ProductUsecase_PatchProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *ProductUsecase_PatchProduct_Call) RunAndReturn(run func(ctx context.Context, id int64, version int64, patch map[string]interface{}) (*entity.Product, error)) *ProductUsecase_PatchProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedProducts provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) PurgeDeletedProducts(ctx context.Context, retention time.Duration) (int64, error) {
+	ret := _mock.Called(ctx, retention)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedProducts")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) (int64, error)); ok {
+		return returnFunc(ctx, retention)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) int64); ok {
+		r0 = returnFunc(ctx, retention)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = returnFunc(ctx, retention)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductUsecase_PurgeDeletedProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedProducts'
+type ProductUsecase_PurgeDeletedProducts_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retention time.Duration
+func (_e *ProductUsecase_Expecter) PurgeDeletedProducts(ctx interface{}, retention interface{}) *ProductUsecase_PurgeDeletedProducts_Call {
+	return &ProductUsecase_PurgeDeletedProducts_Call{Call: _e.mock.On("PurgeDeletedProducts", ctx, retention)}
+}
+
+func (_c *ProductUsecase_PurgeDeletedProducts_Call) Run(run func(ctx context.Context, retention time.Duration)) *ProductUsecase_PurgeDeletedProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_PurgeDeletedProducts_Call) Return(n int64, err error) *ProductUsecase_PurgeDeletedProducts_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *ProductUsecase_PurgeDeletedProducts_Call) RunAndReturn(run func(ctx context.Context, retention time.Duration) (int64, error)) *ProductUsecase_PurgeDeletedProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebuildAutocomplete provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) RebuildAutocomplete(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebuildAutocomplete")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductUsecase_RebuildAutocomplete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebuildAutocomplete'
+type ProductUsecase_RebuildAutocomplete_Call struct {
+	*mock.Call
+}
+
+// RebuildAutocomplete is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ProductUsecase_Expecter) RebuildAutocomplete(ctx interface{}) *ProductUsecase_RebuildAutocomplete_Call {
+	return &ProductUsecase_RebuildAutocomplete_Call{Call: _e.mock.On("RebuildAutocomplete", ctx)}
+}
+
+func (_c *ProductUsecase_RebuildAutocomplete_Call) Run(run func(ctx context.Context)) *ProductUsecase_RebuildAutocomplete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_RebuildAutocomplete_Call) Return(n int64, err error) *ProductUsecase_RebuildAutocomplete_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *ProductUsecase_RebuildAutocomplete_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *ProductUsecase_RebuildAutocomplete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreProduct provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) RestoreProduct(ctx context.Context, id int64, version int64, req *request.ProductRestore) (*entity.Product, error) {
+	ret := _mock.Called(ctx, id, version, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreProduct")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, *request.ProductRestore) (*entity.Product, error)); ok {
+		return returnFunc(ctx, id, version, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, *request.ProductRestore) *entity.Product); ok {
+		r0 = returnFunc(ctx, id, version, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, *request.ProductRestore) error); ok {
+		r1 = returnFunc(ctx, id, version, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductUsecase_RestoreProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProduct'
+type ProductUsecase_RestoreProduct_Call struct {
+	*mock.Call
+}
+
+// RestoreProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - version int64
+//   - req *request.ProductRestore
+func (_e *ProductUsecase_Expecter) RestoreProduct(ctx interface{}, id interface{}, version interface{}, req interface{}) *ProductUsecase_RestoreProduct_Call {
+	return &ProductUsecase_RestoreProduct_Call{Call: _e.mock.On("RestoreProduct", ctx, id, version, req)}
+}
+
+func (_c *ProductUsecase_RestoreProduct_Call) Run(run func(ctx context.Context, id int64, version int64, req *request.ProductRestore)) *ProductUsecase_RestoreProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 *request.ProductRestore
+		if args[3] != nil {
+			arg3 = args[3].(*request.ProductRestore)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_RestoreProduct_Call) Return(product *entity.Product, err error) *ProductUsecase_RestoreProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *ProductUsecase_RestoreProduct_Call) RunAndReturn(run func(ctx context.Context, id int64, version int64, req *request.ProductRestore) (*entity.Product, error)) *ProductUsecase_RestoreProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SuggestProducts provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) SuggestProducts(ctx context.Context, term string, limit int) ([]entity.ProductSuggestion, error) {
+	ret := _mock.Called(ctx, term, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuggestProducts")
+	}
+
+	var r0 []entity.ProductSuggestion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]entity.ProductSuggestion, error)); ok {
+		return returnFunc(ctx, term, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []entity.ProductSuggestion); ok {
+		r0 = returnFunc(ctx, term, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ProductSuggestion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, term, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductUsecase_SuggestProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuggestProducts'
+type ProductUsecase_SuggestProducts_Call struct {
+	*mock.Call
+}
+
+// SuggestProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - term string
+//   - limit int
+func (_e *ProductUsecase_Expecter) SuggestProducts(ctx interface{}, term interface{}, limit interface{}) *ProductUsecase_SuggestProducts_Call {
+	return &ProductUsecase_SuggestProducts_Call{Call: _e.mock.On("SuggestProducts", ctx, term, limit)}
+}
+
+func (_c *ProductUsecase_SuggestProducts_Call) Run(run func(ctx context.Context, term string, limit int)) *ProductUsecase_SuggestProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_SuggestProducts_Call) Return(productSuggestions []entity.ProductSuggestion, err error) *ProductUsecase_SuggestProducts_Call {
+	_c.Call.Return(productSuggestions, err)
+	return _c
+}
+
+func (_c *ProductUsecase_SuggestProducts_Call) RunAndReturn(run func(ctx context.Context, term string, limit int) ([]entity.ProductSuggestion, error)) *ProductUsecase_SuggestProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncPublishWindows provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) SyncPublishWindows(ctx context.Context, from time.Time, to time.Time) (int64, error) {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncPublishWindows")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) int64); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductUsecase_SyncPublishWindows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncPublishWindows'
+type ProductUsecase_SyncPublishWindows_Call struct {
+	*mock.Call
+}
+
+// SyncPublishWindows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *ProductUsecase_Expecter) SyncPublishWindows(ctx interface{}, from interface{}, to interface{}) *ProductUsecase_SyncPublishWindows_Call {
+	return &ProductUsecase_SyncPublishWindows_Call{Call: _e.mock.On("SyncPublishWindows", ctx, from, to)}
+}
+
+func (_c *ProductUsecase_SyncPublishWindows_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *ProductUsecase_SyncPublishWindows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_SyncPublishWindows_Call) Return(n int64, err error) *ProductUsecase_SyncPublishWindows_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *ProductUsecase_SyncPublishWindows_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) (int64, error)) *ProductUsecase_SyncPublishWindows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionProductStatus provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) TransitionProductStatus(ctx context.Context, id int64, version int64, req *request.ProductStatus) (*entity.Product, error) {
+	ret := _mock.Called(ctx, id, version, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionProductStatus")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, *request.ProductStatus) (*entity.Product, error)); ok {
+		return returnFunc(ctx, id, version, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, *request.ProductStatus) *entity.Product); ok {
+		r0 = returnFunc(ctx, id, version, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, *request.ProductStatus) error); ok {
+		r1 = returnFunc(ctx, id, version, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductUsecase_TransitionProductStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionProductStatus'
+type ProductUsecase_TransitionProductStatus_Call struct {
+	*mock.Call
+}
+
+// TransitionProductStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - version int64
+//   - req *request.ProductStatus
+func (_e *ProductUsecase_Expecter) TransitionProductStatus(ctx interface{}, id interface{}, version interface{}, req interface{}) *ProductUsecase_TransitionProductStatus_Call {
+	return &ProductUsecase_TransitionProductStatus_Call{Call: _e.mock.On("TransitionProductStatus", ctx, id, version, req)}
+}
+
+func (_c *ProductUsecase_TransitionProductStatus_Call) Run(run func(ctx context.Context, id int64, version int64, req *request.ProductStatus)) *ProductUsecase_TransitionProductStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 *request.ProductStatus
+		if args[3] != nil {
+			arg3 = args[3].(*request.ProductStatus)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_TransitionProductStatus_Call) Return(product *entity.Product, err error) *ProductUsecase_TransitionProductStatus_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *ProductUsecase_TransitionProductStatus_Call) RunAndReturn(run func(ctx context.Context, id int64, version int64, req *request.ProductStatus) (*entity.Product, error)) *ProductUsecase_TransitionProductStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProduct provides a mock function for the type ProductUsecase
+func (_mock *ProductUsecase) UpdateProduct(ctx context.Context, id int64, version int64, req *request.ProductUpdate) (*entity.Product, error) {
+	ret := _mock.Called(ctx, id, version, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProduct")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, *request.ProductUpdate) (*entity.Product, error)); ok {
+		return returnFunc(ctx, id, version, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, *request.ProductUpdate) *entity.Product); ok {
+		r0 = returnFunc(ctx, id, version, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, *request.ProductUpdate) error); ok {
+		r1 = returnFunc(ctx, id, version, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProductUsecase_UpdateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProduct'
+type ProductUsecase_UpdateProduct_Call struct {
+	*mock.Call
+}
+
+// UpdateProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - version int64
+//   - req *request.ProductUpdate
+func (_e *ProductUsecase_Expecter) UpdateProduct(ctx interface{}, id interface{}, version interface{}, req interface{}) *ProductUsecase_UpdateProduct_Call {
+	return &ProductUsecase_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, id, version, req)}
+}
+
+func (_c *ProductUsecase_UpdateProduct_Call) Run(run func(ctx context.Context, id int64, version int64, req *request.ProductUpdate)) *ProductUsecase_UpdateProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 *request.ProductUpdate
+		if args[3] != nil {
+			arg3 = args[3].(*request.ProductUpdate)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ProductUsecase_UpdateProduct_Call) Return(product *entity.Product, err error) *ProductUsecase_UpdateProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *ProductUsecase_UpdateProduct_Call) RunAndReturn(run func(ctx context.Context, id int64, version int64, req *request.ProductUpdate) (*entity.Product, error)) *ProductUsecase_UpdateProduct_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4,11 +4,25 @@ import "errors"
 
 var (
 	// Error
-	ErrValidation = errors.New("validation error")
-	ErrInternal   = errors.New("internal server error")
-	ErrNotFound   = errors.New("record not found")
+	ErrValidation      = errors.New("validation error")
+	ErrInternal        = errors.New("internal server error")
+	ErrNotFound        = errors.New("record not found")
+	ErrConflict        = errors.New("conflict")
+	ErrVersionMismatch = errors.New("product has been modified since it was read")
+	ErrIfMatchRequired = errors.New("If-Match header is required")
+
+	// BaseCurrency is the currency exchange rates are quoted in
+	BaseCurrency = "IDR"
 
 	// Redis Key
 	RedisKeyProductDetail = "products:detail"
 	RedisKeyProductList   = "products:list"
+	// Facet counts are shared by every page of a listing
+	RedisKeyProductFacets = "products:facets"
+	// Alternate keys map to the product id, whose detail entry holds the data
+	RedisKeyProductSKU  = "products:sku"
+	RedisKeyProductSlug = "products:slug"
+	// Kept outside the products prefix so cache invalidation never drops it
+	RedisKeyProductAutocomplete = "autocomplete:products"
+	RedisKeyExchangeRates       = "exchange_rates"
 )
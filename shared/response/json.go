@@ -23,36 +23,65 @@ type ApiResponse struct {
 }
 
 type StdPagination struct {
-	NextPage bool `json:"next_page"`
-	PrevPage bool `json:"prev_page"`
-	Limit    int  `json:"limit"`
-	Page     int  `json:"page"`
-	Total    int  `json:"total"`
+	NextPage   bool   `json:"next_page"`
+	PrevPage   bool   `json:"prev_page"`
+	Limit      int    `json:"limit"`
+	Page       int    `json:"page"`
+	Total      int    `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Facets     any    `json:"facets,omitempty"`
+}
+
+// BulkItem reports the outcome of one item of a bulk request by its position
+// in the request.
+type BulkItem struct {
+	Index int   `json:"index"`
+	ID    int64 `json:"id,omitempty"`
+	Error any   `json:"error,omitempty"`
+}
+
+type BulkResult struct {
+	Created int        `json:"created"`
+	Failed  int        `json:"failed"`
+	Items   []BulkItem `json:"items"`
 }
 
 type StdMessage string
 
 const (
-	InsertSuccess    StdMessage = "data successfully inserted"
-	GetSuccess       StdMessage = "data successfully retrieved"
-	BadRequest       StdMessage = "your data validation is incorrect please check again"
-	NotFound         StdMessage = "data not found"
-	MethodNotAllowed StdMessage = "method not allowed"
-	RequestTimeout   StdMessage = "the request has exceeded the time limit please try again"
-	TooManyRequests  StdMessage = "too many requests please try again in a moment"
-	InternalError    StdMessage = "internal server error"
+	InsertSuccess        StdMessage = "data successfully inserted"
+	GetSuccess           StdMessage = "data successfully retrieved"
+	UpdateSuccess        StdMessage = "data successfully updated"
+	DeleteSuccess        StdMessage = "data successfully deleted"
+	BulkPartialSuccess   StdMessage = "some data could not be inserted please check the error of each item"
+	BulkRejected         StdMessage = "no data was inserted please check the error of each item"
+	ImportAccepted       StdMessage = "import has been queued"
+	BadRequest           StdMessage = "your data validation is incorrect please check again"
+	NotFound             StdMessage = "data not found"
+	MethodNotAllowed     StdMessage = "method not allowed"
+	RequestTimeout       StdMessage = "the request has exceeded the time limit please try again"
+	TooManyRequests      StdMessage = "too many requests please try again in a moment"
+	Conflict             StdMessage = "the data conflicts with an existing record"
+	PreconditionFailed   StdMessage = "the data has been changed by another request please reload and try again"
+	PreconditionRequired StdMessage = "this request must be conditional please send the If-Match header"
+	InternalError        StdMessage = "internal server error"
 )
 
 const (
-	CodeBadRequest          = "PRD-ERA-400"
-	CodeErrorBind           = "PRD-ERA-410"
-	CodeSuccess             = "PRD-ERA-200"
-	CodeCreated             = "PRD-ERA-201"
-	CodeNotFound            = "PRD-ERA-404"
-	CodeMethodNotAllowed    = "PRD-ERA-405"
-	CodeRequestTimeout      = "PRD-ERA-408"
-	CodeTooManyRequests     = "PRD-ERA-429"
-	CodeInternalServerError = "PRD-ERA-500"
+	CodeBadRequest           = "PRD-ERA-400"
+	CodeErrorBind            = "PRD-ERA-410"
+	CodeSuccess              = "PRD-ERA-200"
+	CodeCreated              = "PRD-ERA-201"
+	CodeAccepted             = "PRD-ERA-202"
+	CodeMultiStatus          = "PRD-ERA-207"
+	CodeNotFound             = "PRD-ERA-404"
+	CodeMethodNotAllowed     = "PRD-ERA-405"
+	CodeRequestTimeout       = "PRD-ERA-408"
+	CodeConflict             = "PRD-ERA-409"
+	CodePreconditionFailed   = "PRD-ERA-412"
+	CodePreconditionRequired = "PRD-ERA-428"
+	CodeTooManyRequests      = "PRD-ERA-429"
+	CodeInternalServerError  = "PRD-ERA-500"
 )
 
 type StdResponse struct {
@@ -81,6 +110,27 @@ func (s *StdResponse) SuccessResponse(ctx context.Context, message StdMessage, d
 			Code:     code,
 			HTTPCode: http.StatusCreated,
 		}
+	case ImportAccepted:
+		return &ApiResponse{
+			Message:  message,
+			Data:     data,
+			Code:     code,
+			HTTPCode: http.StatusAccepted,
+		}
+	case BulkPartialSuccess:
+		return &ApiResponse{
+			Message:  message,
+			Data:     data,
+			Code:     code,
+			HTTPCode: http.StatusMultiStatus,
+		}
+	case BulkRejected:
+		return &ApiResponse{
+			Message:  message,
+			Data:     data,
+			Code:     code,
+			HTTPCode: http.StatusBadRequest,
+		}
 	default:
 		return &ApiResponse{
 			Message:  message,
@@ -138,6 +188,27 @@ func (s *StdResponse) ErrorResponse(ctx context.Context, message StdMessage, err
 			Code:     code,
 			HTTPCode: http.StatusRequestTimeout,
 		}
+	case Conflict:
+		return &ApiResponse{
+			Message:  message,
+			Error:    err.Error(),
+			Code:     code,
+			HTTPCode: http.StatusConflict,
+		}
+	case PreconditionFailed:
+		return &ApiResponse{
+			Message:  message,
+			Error:    err.Error(),
+			Code:     code,
+			HTTPCode: http.StatusPreconditionFailed,
+		}
+	case PreconditionRequired:
+		return &ApiResponse{
+			Message:  message,
+			Error:    err.Error(),
+			Code:     code,
+			HTTPCode: http.StatusPreconditionRequired,
+		}
 	case TooManyRequests:
 		return &ApiResponse{
 			Message:  message,
@@ -292,3 +363,16 @@ func StandardPagination(page, limit int, total int64) StdPagination {
 		PrevPage: page > 1,
 	}
 }
+
+// KeysetPagination describes a page fetched with one extra row to learn whether
+// more rows follow, so it does not depend on a total count.
+func KeysetPagination(page, limit int, total int64, hasMore, hasPrev bool, nextCursor string) StdPagination {
+	return StdPagination{
+		Page:       page,
+		Limit:      limit,
+		Total:      int(total),
+		NextPage:   hasMore,
+		PrevPage:   hasPrev,
+		NextCursor: nextCursor,
+	}
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LocalStorageSuite struct {
+	suite.Suite
+	root  string
+	store *LocalStorage
+}
+
+func (s *LocalStorageSuite) SetupTest() {
+	s.root = s.T().TempDir()
+
+	var err error
+	s.store, err = NewLocalStorage(Config{Root: s.root, BaseURL: "http://localhost:8080/api/media/"})
+	s.Require().NoError(err)
+}
+
+func (s *LocalStorageSuite) TestPut() {
+	s.NoError(s.store.Put(context.Background(), "products/1/a.jpg", []byte("jpeg"), "image/jpeg"))
+	s.NoError(s.store.Put(context.Background(), "products/1/a.jpg", []byte("jpeg v2"), "image/jpeg"))
+
+	data, err := os.ReadFile(filepath.Join(s.root, "products", "1", "a.jpg"))
+	s.NoError(err)
+	s.Equal("jpeg v2", string(data))
+
+	entries, err := os.ReadDir(filepath.Join(s.root, "products", "1"))
+	s.NoError(err)
+	s.Len(entries, 1, "No temporary file is left behind")
+}
+
+func (s *LocalStorageSuite) TestDelete() {
+	s.Require().NoError(s.store.Put(context.Background(), "products/1/a.jpg", []byte("jpeg"), "image/jpeg"))
+
+	s.NoError(s.store.Delete(context.Background(), "products/1/a.jpg"))
+	s.NoFileExists(filepath.Join(s.root, "products", "1", "a.jpg"))
+
+	s.NoError(s.store.Delete(context.Background(), "products/1/a.jpg"), "Deleting a missing object is not an error")
+}
+
+func (s *LocalStorageSuite) TestInvalidKey() {
+	for _, key := range []string{"", "../secret", "products/../../secret", "/products/1/a.jpg", "products//a.jpg"} {
+		s.Error(s.store.Put(context.Background(), key, []byte("jpeg"), "image/jpeg"), key)
+	}
+}
+
+func (s *LocalStorageSuite) TestURL() {
+	s.Equal("http://localhost:8080/api/media/products/1/a.jpg", s.store.URL("products/1/a.jpg"))
+}
+
+func TestLocalStorageSuite(t *testing.T) {
+	suite.Run(t, new(LocalStorageSuite))
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+)
+
+type DriverType string
+
+const (
+	Local DriverType = "local"
+	S3    DriverType = "s3"
+)
+
+// Storage keeps uploaded files under keys such as "products/1/a1b2.jpg".
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Delete(ctx context.Context, key string) error
+	// URL is where clients download the object under key.
+	URL(key string) string
+}
+
+type Config struct {
+	// Root is the directory of the local driver.
+	Root string
+	// BaseURL prefixes the keys in the object URLs. The S3 driver falls back
+	// to Endpoint/Bucket.
+	BaseURL   string
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+func NewStorage(driver DriverType, cfg Config) (Storage, error) {
+
+	switch driver {
+	case Local:
+		return NewLocalStorage(cfg)
+	case S3:
+		return NewS3Storage(cfg)
+	default:
+		return nil, fmt.Errorf("unsupported storage driver: %s", driver)
+	}
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FormatETag renders the versions of a row as a strong entity tag,
+// "<version>-<stock_version>". The catalog version guards writes, the stock
+// version only tells readers that the stock they saw has moved.
+func FormatETag(version int64, stockVersion int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10) + "-" + strconv.FormatInt(stockVersion, 10))
+}
+
+// ParseETag returns the catalog version carried by a single entity tag. Weak
+// tags are accepted because the version is the only thing compared, and so are
+// tags without a stock version.
+func ParseETag(tag string) (int64, bool) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil {
+		return 0, false
+	}
+	unquoted, _, _ = strings.Cut(unquoted, "-")
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return version, true
+}
+
+// MatchETag reports whether a comma separated If-None-Match style header
+// contains the tag of the given versions or the wildcard.
+func MatchETag(header string, version int64, stockVersion int64) bool {
+	current := FormatETag(version, stockVersion)
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == current {
+			return true
+		}
+	}
+	return false
+}
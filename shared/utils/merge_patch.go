@@ -0,0 +1,27 @@
+package utils
+
+// MergePatch applies a JSON merge patch (RFC 7386) to target and returns the
+// merged document. A null value in patch removes the key from target.
+func MergePatch(target, patch map[string]interface{}) map[string]interface{} {
+	if target == nil {
+		target = map[string]interface{}{}
+	}
+
+	for key, value := range patch {
+		if value == nil {
+			delete(target, key)
+			continue
+		}
+
+		patchObj, ok := value.(map[string]interface{})
+		if !ok {
+			target[key] = value
+			continue
+		}
+
+		targetObj, _ := target[key].(map[string]interface{})
+		target[key] = MergePatch(targetObj, patchObj)
+	}
+
+	return target
+}
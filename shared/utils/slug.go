@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Slugify turns a name into a URL path segment: lower case ASCII letters and
+// digits, runs of anything else collapsed into a single dash. Accents are
+// dropped ("Café" becomes "cafe"). A name with nothing to keep gives
+// "product".
+func Slugify(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range norm.NFKD.String(strings.ToLower(name)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			dash = false
+			b.WriteRune(r)
+		case unicode.Is(unicode.Mn, r):
+			// combining accent left over from the decomposition
+		default:
+			dash = true
+		}
+	}
+
+	if b.Len() == 0 {
+		return "product"
+	}
+	slug := b.String()
+	if len(slug) > 200 {
+		slug = strings.TrimRight(slug[:200], "-")
+	}
+	return slug
+}
@@ -28,8 +28,14 @@ func ParseValidationErrors(err error) []ValidationError {
 				message = field + " must be at least " + fe.Param()
 			case "max":
 				message = field + " must be at most " + fe.Param()
+			case "oneof":
+				message = field + " must be one of " + fe.Param()
 			case "email":
 				message = field + " must be a valid email"
+			case "barcode":
+				message = field + " must be a valid EAN-13 or UPC-A barcode"
+			case "iso4217":
+				message = field + " must be an ISO 4217 currency code"
 			default:
 				message = field + " failed validation on tag '" + tag + "'"
 			}
@@ -64,5 +70,35 @@ func NewValidator() *CustomValidator {
 		return name
 	})
 
+	_ = v.RegisterValidation("barcode", func(fl validator.FieldLevel) bool {
+		return IsValidBarcode(fl.Field().String())
+	})
+
 	return &CustomValidator{Validator: v}
 }
+
+// IsValidBarcode reports whether code is a 13 digit EAN-13 or 12 digit UPC-A
+// barcode with a correct check digit. Counting from the check digit, the
+// digits are weighted 3 and 1 alternately.
+func IsValidBarcode(code string) bool {
+	if len(code) != 12 && len(code) != 13 {
+		return false
+	}
+
+	sum := 0
+	for i := len(code) - 1; i >= 0; i-- {
+		if code[i] < '0' || code[i] > '9' {
+			return false
+		}
+		if i == len(code)-1 {
+			continue
+		}
+		digit := int(code[i] - '0')
+		if (len(code)-1-i)%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	return (10-sum%10)%10 == int(code[len(code)-1]-'0')
+}
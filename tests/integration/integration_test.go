@@ -1,8 +1,12 @@
 package integration
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"image"
+	"image/png"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -11,7 +15,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/redis/go-redis/v9"
@@ -20,12 +23,16 @@ import (
 
 	"erajaya-test/app"
 	productHandler "erajaya-test/internal/delivery/http"
+	"erajaya-test/internal/interfaces"
+	"erajaya-test/internal/models/entity"
 	"erajaya-test/internal/repository"
 	"erajaya-test/internal/usecase"
 
 	"encoding/json"
 	"erajaya-test/shared/datastore"
+	"erajaya-test/shared/middlewares"
 	"erajaya-test/shared/response"
+	"erajaya-test/shared/storage"
 	"erajaya-test/shared/utils"
 )
 
@@ -59,6 +66,7 @@ type ProductTestSuite struct {
 	echo     *echo.Echo
 	db       *gorm.DB
 	redis    *redis.Client
+	prices   interfaces.ProductPriceUsecase
 	cleanups []func()
 }
 
@@ -82,22 +90,85 @@ func (s *ProductTestSuite) SetupSuite() {
 	}
 
 	// Setup Application Logic
-	productRepository := repository.NewProductRepository(s.db)
+	productRepository := repository.NewProductRepository(s.db, "simple")
 	redisRepo := repository.NewRedisRepository(s.redis)
-	productUsecase := usecase.NewProductUsecase(productRepository, redisRepo)
+	autocompleteRepo := repository.NewAutocompleteRepository(s.redis)
+	attributeRepo := repository.NewAttributeRepository(s.db)
+	mediaRoot := s.T().TempDir()
+	mediaStorage, err := storage.NewLocalStorage(storage.Config{Root: mediaRoot, BaseURL: "/api/media"})
+	s.Require().NoError(err)
+	productUsecase := usecase.NewProductUsecase(productRepository, redisRepo, autocompleteRepo, attributeRepo, mediaStorage)
 
 	// Setup Echo
 	s.echo = echo.New()
-	s.echo.Validator = &utils.CustomValidator{Validator: validator.New()}
+	s.echo.Validator = utils.NewValidator()
+	s.echo.Use((&middlewares.DefaultCtx{}).ContextMiddleware())
 
 	logger := app.InitZapLogger()
-	h := productHandler.NewHandler(productUsecase, response.NewStdResponse(logger))
+	rateUsecase := usecase.NewExchangeRateUsecase(repository.NewExchangeRateRepository(s.db), redisRepo, entity.VATRule{Rate: 11, Inclusive: true})
+	h := productHandler.NewHandler(productUsecase, rateUsecase, response.NewStdResponse(logger))
 
 	v1 := s.echo.Group("/api/v1")
 
 	v1.POST("/products", h.CreateProduct)
+	v1.POST("/products/bulk", h.CreateProductsBulk)
 	v1.GET("/products", h.ListProducts)
+	v1.GET("/products/suggest", h.SuggestProducts)
+	v1.GET("/products/autocomplete", h.AutocompleteProducts)
+	v1.GET("/products/sku/:sku", h.GetProductBySKU)
+	v1.GET("/products/slug/:slug", h.GetProductBySlug)
 	v1.GET("/products/:id", h.GetProductByID)
+	v1.PUT("/products/:id", h.UpdateProduct)
+	v1.PATCH("/products/:id", h.PatchProduct)
+	v1.DELETE("/products/:id", h.DeleteProduct)
+	v1.POST("/products/:id/restore", h.RestoreProduct)
+	v1.POST("/products/:id/status", h.TransitionProductStatus)
+	v1.GET("/products/:id/history", h.ListProductHistory)
+
+	stockUsecase := usecase.NewStockMovementUsecase(repository.NewStockMovementRepository(s.db), redisRepo)
+	stockHandler := productHandler.NewStockMovementHandler(stockUsecase, response.NewStdResponse(logger))
+	v1.GET("/products/:id/stock-movements", stockHandler.ListMovements)
+	v1.POST("/products/:id/stock-movements", stockHandler.PostMovement)
+
+	reservationUsecase := usecase.NewStockReservationUsecase(repository.NewStockReservationRepository(s.db), redisRepo)
+	reservationHandler := productHandler.NewStockReservationHandler(reservationUsecase, response.NewStdResponse(logger))
+	v1.POST("/products/:id/reservations", reservationHandler.Reserve)
+	v1.POST("/reservations/:id/commit", reservationHandler.CommitReservation)
+	v1.POST("/reservations/:id/release", reservationHandler.ReleaseReservation)
+
+	locationUsecase := usecase.NewLocationUsecase(repository.NewLocationRepository(s.db))
+	locationHandler := productHandler.NewLocationHandler(locationUsecase, response.NewStdResponse(logger))
+	v1.POST("/locations", locationHandler.CreateLocation)
+	v1.DELETE("/locations/:id", locationHandler.DeleteLocation)
+	v1.GET("/products/:id/stocks", locationHandler.ListProductStocks)
+
+	s.prices = usecase.NewProductPriceUsecase(repository.NewProductPriceRepository(s.db), redisRepo)
+	priceHandler := productHandler.NewProductPriceHandler(s.prices, response.NewStdResponse(logger))
+	v1.POST("/products/:id/prices", priceHandler.SchedulePrice)
+	v1.GET("/products/:id/price-history", priceHandler.ListPriceHistory)
+
+	rateHandler := productHandler.NewExchangeRateHandler(rateUsecase, response.NewStdResponse(logger))
+	v1.PUT("/exchange-rates", rateHandler.LoadRates)
+
+	mediaUsecase := usecase.NewProductMediaUsecase(repository.NewProductMediaRepository(s.db), redisRepo, mediaStorage, usecase.MediaConfig{MaxSize: 5 << 20, ThumbnailSize: 320})
+	mediaHandler := productHandler.NewProductMediaHandler(mediaUsecase, response.NewStdResponse(logger))
+	s.echo.Group("/api").Static("/media", mediaRoot)
+	v1.POST("/products/:id/media", mediaHandler.UploadMedia)
+	v1.POST("/products/:id/media/:media_id/primary", mediaHandler.SetPrimaryMedia)
+	v1.DELETE("/products/:id/media/:media_id", mediaHandler.DeleteMedia)
+
+	categoryUsecase := usecase.NewCategoryUsecase(repository.NewCategoryRepository(s.db), redisRepo)
+	categoryHandler := productHandler.NewCategoryHandler(categoryUsecase, response.NewStdResponse(logger))
+	v1.POST("/categories", categoryHandler.CreateCategory)
+	v1.PUT("/products/:id/categories", categoryHandler.SetProductCategories)
+
+	attributeUsecase := usecase.NewAttributeUsecase(attributeRepo, redisRepo)
+	attributeHandler := productHandler.NewAttributeHandler(attributeUsecase, response.NewStdResponse(logger))
+	v1.POST("/attributes", attributeHandler.CreateAttribute)
+	v1.PUT("/attributes/:id", attributeHandler.UpdateAttribute)
+	v1.DELETE("/attributes/:id", attributeHandler.DeleteAttribute)
+	v1.PUT("/categories/:id/attributes", attributeHandler.SetCategoryAttributes)
+	v1.PUT("/products/:id/attributes", attributeHandler.SetProductAttributes)
 
 	rateLimitConfig := middleware.RateLimiterConfig{
 		Skipper: middleware.DefaultSkipper,
@@ -290,6 +361,492 @@ func (s *ProductTestSuite) TestProduct() {
 	s.Contains(searchRec.Body.String(), "LG TV 42 inch")
 }
 
+func (s *ProductTestSuite) sendConditionalRequest(method, target, body, contentType, ifMatch string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, contentType)
+	req.Header.Set("If-Match", ifMatch)
+
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *ProductTestSuite) TestUpdateAndDeleteProduct() {
+
+	price := int64(3000000)
+	qty := 3
+	product := entity.Product{Name: "Xiaomi 14", Price: &price, Description: "Leica camera", Quantity: &qty, CreatedBy: "arya"}
+	s.Require().NoError(s.db.Create(&product).Error)
+
+	target := fmt.Sprintf("/api/v1/products/%d", product.ID)
+
+	getRec := s.sendRequest(http.MethodGet, target, "", "application/json")
+	s.Equal(http.StatusOK, getRec.Code)
+	etag := getRec.Header().Get("ETag")
+	s.NotEmpty(etag)
+
+	putBody := `{"name":"Xiaomi 14 Pro","price":3500000,"description":"Leica camera","quantity":3,"updated_by":"arya"}`
+	missingRec := s.sendRequest(http.MethodPut, target, putBody, "application/json")
+	s.Equal(http.StatusPreconditionRequired, missingRec.Code)
+
+	putRec := s.sendConditionalRequest(http.MethodPut, target, putBody, "application/json", etag)
+	s.Equal(http.StatusOK, putRec.Code)
+	s.Contains(putRec.Body.String(), "Xiaomi 14 Pro")
+
+	staleRec := s.sendConditionalRequest(http.MethodPut, target, putBody, "application/json", etag)
+	s.Equal(http.StatusPreconditionFailed, staleRec.Code, "Writing with a stale ETag must be rejected")
+	s.Contains(staleRec.Body.String(), response.CodePreconditionFailed)
+
+	etag = putRec.Header().Get("ETag")
+	patchRec := s.sendConditionalRequest(http.MethodPatch, target, `{"price":3200000,"updated_by":"budi"}`, "application/merge-patch+json", etag)
+	s.Equal(http.StatusOK, patchRec.Code)
+	s.Contains(patchRec.Body.String(), `"price":3200000`)
+	s.Contains(patchRec.Body.String(), "Xiaomi 14 Pro")
+
+	etag = patchRec.Header().Get("ETag")
+	notModifiedReq := httptest.NewRequest(http.MethodGet, target, nil)
+	notModifiedReq.Header.Set("If-None-Match", etag)
+	notModifiedRec := httptest.NewRecorder()
+	s.echo.ServeHTTP(notModifiedRec, notModifiedReq)
+	s.Equal(http.StatusNotModified, notModifiedRec.Code)
+
+	deleteRec := s.sendConditionalRequest(http.MethodDelete, target+"?deleted_by=arya", "", "", etag)
+	s.Equal(http.StatusOK, deleteRec.Code)
+
+	getRec = s.sendRequest(http.MethodGet, target, "", "application/json")
+	s.Equal(http.StatusNotFound, getRec.Code, "Soft-deleted product must be hidden from detail")
+
+	staleRestoreRec := s.sendConditionalRequest(http.MethodPost, target+"/restore", `{"updated_by":"arya"}`, "application/json", etag)
+	s.Equal(http.StatusPreconditionFailed, staleRestoreRec.Code, "The delete changed the version")
+
+	var deleted entity.Product
+	s.Require().NoError(s.db.Unscoped().First(&deleted, product.ID).Error)
+	restoreRec := s.sendConditionalRequest(http.MethodPost, target+"/restore", `{"updated_by":"arya"}`, "application/json", utils.FormatETag(deleted.Version, deleted.StockVersion))
+	s.Equal(http.StatusOK, restoreRec.Code)
+	s.Equal(utils.FormatETag(deleted.Version+1, deleted.StockVersion), restoreRec.Header().Get("ETag"))
+
+	getRec = s.sendRequest(http.MethodGet, target, "", "application/json")
+	s.Equal(http.StatusOK, getRec.Code)
+}
+
+func (s *ProductTestSuite) TestConcurrentSalesNeverOversell() {
+
+	createRec := s.sendRequest(http.MethodPost, "/api/v1/products", `{"name":"Pixel 9","price":12000000,"description":"Obsidian","quantity":5,"created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, createRec.Code)
+
+	var product entity.Product
+	s.Require().NoError(s.db.Where("name = ?", "Pixel 9").Order("id DESC").First(&product).Error)
+	target := fmt.Sprintf("/api/v1/products/%d/stock-movements", product.ID)
+
+	var wg sync.WaitGroup
+	codes := make(chan int, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec := s.sendRequest(http.MethodPost, target, `{"type":"sale","quantity":1,"created_by":"arya"}`, "application/json")
+			codes <- rec.Code
+		}()
+	}
+	wg.Wait()
+	close(codes)
+
+	sold := 0
+	for code := range codes {
+		if code == http.StatusCreated {
+			sold++
+		} else {
+			s.Equal(http.StatusConflict, code)
+		}
+	}
+	s.Equal(5, sold, "Only the stock on hand can be sold")
+
+	s.Require().NoError(s.db.First(&product, product.ID).Error)
+	s.Equal(0, *product.Quantity)
+
+	listRec := s.sendRequest(http.MethodGet, target+"?limit=100", "", "application/json")
+	s.Equal(http.StatusOK, listRec.Code)
+	s.Contains(listRec.Body.String(), `"reason":"opening balance"`)
+}
+
+func (s *ProductTestSuite) TestReservationsHoldStock() {
+
+	createRec := s.sendRequest(http.MethodPost, "/api/v1/products", `{"name":"Xiaomi 14","price":11000000,"description":"Leica","quantity":5,"created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, createRec.Code)
+
+	var product entity.Product
+	s.Require().NoError(s.db.Where("name = ?", "Xiaomi 14").Order("id DESC").First(&product).Error)
+	target := fmt.Sprintf("/api/v1/products/%d", product.ID)
+
+	reserveRec := s.sendRequest(http.MethodPost, target+"/reservations", `{"quantity":3,"created_by":"checkout"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, reserveRec.Code)
+
+	var reservation entity.StockReservation
+	s.Require().NoError(s.db.Where("product_id = ?", product.ID).First(&reservation).Error)
+
+	detailRec := s.sendRequest(http.MethodGet, target, "", "application/json")
+	s.Contains(detailRec.Body.String(), `"available":2`)
+
+	saleRec := s.sendRequest(http.MethodPost, target+"/stock-movements", `{"type":"sale","quantity":3,"created_by":"arya"}`, "application/json")
+	s.Equal(http.StatusConflict, saleRec.Code, "Reserved stock cannot be sold elsewhere")
+
+	commitRec := s.sendRequest(http.MethodPost, fmt.Sprintf("/api/v1/reservations/%d/commit", reservation.ID), `{"updated_by":"checkout"}`, "application/json")
+	s.Equal(http.StatusOK, commitRec.Code)
+
+	releaseRec := s.sendRequest(http.MethodPost, fmt.Sprintf("/api/v1/reservations/%d/release", reservation.ID), `{"updated_by":"checkout"}`, "application/json")
+	s.Equal(http.StatusConflict, releaseRec.Code)
+
+	s.Require().NoError(s.db.First(&product, product.ID).Error)
+	s.Equal(2, *product.Quantity)
+	s.Equal(0, product.Reserved)
+}
+
+func (s *ProductTestSuite) TestStockPerLocation() {
+
+	code := fmt.Sprintf("BDG-%d", time.Now().UnixNano()%100000)
+	locationRec := s.sendRequest(http.MethodPost, "/api/v1/locations", `{"code":"`+code+`","name":"Bandung store","type":"store","created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, locationRec.Code)
+
+	var location entity.Location
+	s.Require().NoError(s.db.Where("code = ?", code).First(&location).Error)
+
+	createRec := s.sendRequest(http.MethodPost, "/api/v1/products", `{"name":"Vivo X200","price":13000000,"description":"Zeiss","quantity":5,"created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, createRec.Code)
+
+	var product entity.Product
+	s.Require().NoError(s.db.Where("name = ?", "Vivo X200").Order("id DESC").First(&product).Error)
+	target := fmt.Sprintf("/api/v1/products/%d", product.ID)
+
+	receiptRec := s.sendRequest(http.MethodPost, target+"/stock-movements", fmt.Sprintf(`{"type":"receipt","quantity":3,"location_id":%d,"created_by":"arya"}`, location.ID), "application/json")
+	s.Require().Equal(http.StatusCreated, receiptRec.Code)
+
+	saleRec := s.sendRequest(http.MethodPost, target+"/stock-movements", fmt.Sprintf(`{"type":"sale","quantity":4,"location_id":%d,"created_by":"arya"}`, location.ID), "application/json")
+	s.Equal(http.StatusConflict, saleRec.Code, "Stock of another location cannot be sold here")
+
+	s.Require().NoError(s.db.First(&product, product.ID).Error)
+	s.Equal(8, *product.Quantity, "The product quantity is the total over all locations")
+
+	stocksRec := s.sendRequest(http.MethodGet, target+"/stocks", "", "application/json")
+	s.Equal(http.StatusOK, stocksRec.Code)
+	s.Contains(stocksRec.Body.String(), fmt.Sprintf(`{"product_id":%d,"location_id":%d,"quantity":3,"reserved":0,"available":3}`, product.ID, location.ID))
+
+	listRec := s.sendRequest(http.MethodGet, fmt.Sprintf("/api/v1/products?location_id=%d&in_stock=true&limit=100", location.ID), "", "application/json")
+	s.Equal(http.StatusOK, listRec.Code)
+	s.Contains(listRec.Body.String(), `"quantity":8`)
+	s.Contains(listRec.Body.String(), `"location_stock":{"product_id":`)
+
+	deleteRec := s.sendRequest(http.MethodDelete, fmt.Sprintf("/api/v1/locations/%d", location.ID), "", "application/json")
+	s.Equal(http.StatusConflict, deleteRec.Code, "A location with stock cannot be deleted")
+}
+
+func (s *ProductTestSuite) TestScheduledPrice() {
+
+	createRec := s.sendRequest(http.MethodPost, "/api/v1/products", `{"name":"Oppo Find X8","price":12000000,"description":"Hasselblad","created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, createRec.Code)
+
+	var product entity.Product
+	s.Require().NoError(s.db.Where("name = ?", "Oppo Find X8").Order("id DESC").First(&product).Error)
+	target := fmt.Sprintf("/api/v1/products/%d", product.ID)
+
+	pastRec := s.sendRequest(http.MethodPost, target+"/prices", `{"price":10000000,"valid_from":"2020-01-01T00:00:00Z","created_by":"arya"}`, "application/json")
+	s.Equal(http.StatusBadRequest, pastRec.Code, "A price cannot be scheduled in the past")
+
+	validFrom := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	scheduleRec := s.sendRequest(http.MethodPost, target+"/prices", `{"price":10000000,"valid_from":"`+validFrom+`","created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, scheduleRec.Code)
+
+	detailRec := s.sendRequest(http.MethodGet, target, "", "application/json")
+	s.Contains(detailRec.Body.String(), `"price":12000000`, "A scheduled price waits until it is due")
+
+	// Bring the scheduled price due instead of waiting for it.
+	s.Require().NoError(s.db.Model(&entity.ProductPrice{}).
+		Where("product_id = ? AND applied_at IS NULL", product.ID).
+		Update("valid_from", time.Now().Add(-time.Second)).Error)
+
+	applied, err := s.prices.ApplyDuePrices(context.Background())
+	s.Require().NoError(err)
+	s.GreaterOrEqual(applied, 1)
+
+	detailRec = s.sendRequest(http.MethodGet, target, "", "application/json")
+	s.Contains(detailRec.Body.String(), `"price":10000000`)
+
+	historyRec := s.sendRequest(http.MethodGet, target+"/price-history", "", "application/json")
+	s.Equal(http.StatusOK, historyRec.Code)
+	s.Contains(historyRec.Body.String(), `"price":10000000`)
+	s.Contains(historyRec.Body.String(), `"status":"current"`)
+	s.Contains(historyRec.Body.String(), `"status":"past"`)
+}
+
+func (s *ProductTestSuite) TestCurrencyConversion() {
+
+	loadRec := s.sendRequest(http.MethodPut, "/api/v1/exchange-rates", `{"rates":[{"currency":"USD","rate":16000,"minor_units":2}],"updated_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusOK, loadRec.Code)
+
+	unknownRec := s.sendRequest(http.MethodPost, "/api/v1/products", `{"name":"Vivo X200","price":11100000,"currency":"XAU","description":"Zeiss","created_by":"arya"}`, "application/json")
+	s.Equal(http.StatusBadRequest, unknownRec.Code, "A price needs a currency with an exchange rate")
+
+	createRec := s.sendRequest(http.MethodPost, "/api/v1/products", `{"name":"Vivo X200","price":11100000,"description":"Zeiss","created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, createRec.Code)
+
+	var product entity.Product
+	s.Require().NoError(s.db.Where("name = ?", "Vivo X200").Order("id DESC").First(&product).Error)
+	s.Equal("IDR", product.Currency)
+	target := fmt.Sprintf("/api/v1/products/%d", product.ID)
+
+	detailRec := s.sendRequest(http.MethodGet, target, "", "application/json")
+	s.Contains(detailRec.Body.String(), `"pricing":{"currency":"IDR","net":10000000,"tax":1100000,"gross":11100000,"vat_rate":11}`)
+
+	usdRec := s.sendRequest(http.MethodGet, target+"?currency=USD", "", "application/json")
+	s.Equal(http.StatusOK, usdRec.Code)
+	s.Contains(usdRec.Body.String(), `"price":11100000`, "The stored price is left as is")
+	s.Contains(usdRec.Body.String(), `"currency":"USD","net":62500,"tax":6875,"gross":69375`)
+
+	unknownReadRec := s.sendRequest(http.MethodGet, target+"?currency=XAU", "", "application/json")
+	s.Equal(http.StatusBadRequest, unknownReadRec.Code)
+
+	// 699.00 USD is 11,184,000 IDR, just above the IDR priced product.
+	usdCreateRec := s.sendRequest(http.MethodPost, "/api/v1/products", `{"name":"Pixel 9","price":69900,"currency":"USD","description":"Tensor","created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, usdCreateRec.Code)
+
+	var usdProduct entity.Product
+	s.Require().NoError(s.db.Where("name = ?", "Pixel 9").Order("id DESC").First(&usdProduct).Error)
+	s.Require().NotNil(usdProduct.BasePrice)
+	s.Equal(int64(11184000), *usdProduct.BasePrice)
+
+	ids := fmt.Sprintf("ids=%d,%d", product.ID, usdProduct.ID)
+	sortedRec := s.sendRequest(http.MethodGet, "/api/v1/products?"+ids+"&sort=-price", "", "application/json")
+	s.Require().Equal(http.StatusOK, sortedRec.Code)
+	body := sortedRec.Body.String()
+	s.Less(strings.Index(body, `"name":"Pixel 9"`), strings.Index(body, `"name":"Vivo X200"`), "Sorted by the price in IDR")
+
+	rangeRec := s.sendRequest(http.MethodGet, "/api/v1/products?"+ids+"&max_price=11150000", "", "application/json")
+	s.Contains(rangeRec.Body.String(), `"name":"Vivo X200"`)
+	s.NotContains(rangeRec.Body.String(), `"name":"Pixel 9"`, "69900 cents are not 69900 rupiah")
+}
+
+func (s *ProductTestSuite) uploadImage(target string, isPrimary bool) *httptest.ResponseRecorder {
+	var img bytes.Buffer
+	s.Require().NoError(png.Encode(&img, image.NewNRGBA(image.Rect(0, 0, 640, 480))))
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	s.Require().NoError(writer.WriteField("created_by", "arya"))
+	s.Require().NoError(writer.WriteField("is_primary", fmt.Sprint(isPrimary)))
+	part, err := writer.CreateFormFile("file", "front.png")
+	s.Require().NoError(err)
+	_, _ = part.Write(img.Bytes())
+	s.Require().NoError(writer.Close())
+
+	return s.sendRequest(http.MethodPost, target, body.String(), writer.FormDataContentType())
+}
+
+func (s *ProductTestSuite) TestProductMedia() {
+
+	createRec := s.sendRequest(http.MethodPost, "/api/v1/products", `{"name":"Nothing Phone 3","price":9000000,"description":"Glyph","created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, createRec.Code)
+
+	var product entity.Product
+	s.Require().NoError(s.db.Where("name = ?", "Nothing Phone 3").Order("id DESC").First(&product).Error)
+	target := fmt.Sprintf("/api/v1/products/%d", product.ID)
+
+	s.Require().Equal(http.StatusCreated, s.uploadImage(target+"/media", false).Code)
+	s.Require().Equal(http.StatusCreated, s.uploadImage(target+"/media", false).Code)
+
+	var media []entity.ProductMedia
+	s.Require().NoError(s.db.Where("product_id = ?", product.ID).Order("position").Find(&media).Error)
+	s.Require().Len(media, 2)
+	s.True(media[0].IsPrimary, "The first image is the primary image")
+	s.False(media[1].IsPrimary)
+
+	detailRec := s.sendRequest(http.MethodGet, target, "", "application/json")
+	s.Contains(detailRec.Body.String(), `"url":"`+media[0].URL+`"`)
+	s.Contains(detailRec.Body.String(), `"thumbnail_url":"`+media[1].ThumbnailURL+`"`)
+
+	fileRec := s.sendRequest(http.MethodGet, media[1].ThumbnailURL, "", "")
+	s.Equal(http.StatusOK, fileRec.Code, "Local media is served by the API")
+
+	primaryRec := s.sendRequest(http.MethodPost, fmt.Sprintf("%s/media/%d/primary", target, media[1].ID), `{"updated_by":"arya"}`, "application/json")
+	s.Equal(http.StatusOK, primaryRec.Code)
+
+	deleteRec := s.sendRequest(http.MethodDelete, fmt.Sprintf("%s/media/%d", target, media[1].ID), "", "application/json")
+	s.Equal(http.StatusOK, deleteRec.Code)
+
+	var left entity.ProductMedia
+	s.Require().NoError(s.db.Where("product_id = ?", product.ID).First(&left).Error)
+	s.Equal(media[0].ID, left.ID)
+	s.True(left.IsPrimary, "The image left takes over as the primary image")
+
+	fileRec = s.sendRequest(http.MethodGet, media[1].ThumbnailURL, "", "")
+	s.Equal(http.StatusNotFound, fileRec.Code, "The files of a deleted image are removed")
+}
+
+func (s *ProductTestSuite) TestProductAttributes() {
+
+	suffix := time.Now().UnixNano() % 100000
+	ram := fmt.Sprintf("ram_gb_%d", suffix)
+	color := fmt.Sprintf("color_%d", suffix)
+
+	ramRec := s.sendRequest(http.MethodPost, "/api/v1/attributes", `{"code":"`+ram+`","name":"RAM","type":"unit","unit":"GB","created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, ramRec.Code)
+	colorRec := s.sendRequest(http.MethodPost, "/api/v1/attributes", `{"code":"`+color+`","name":"Color","type":"enum","options":["Black","White"],"created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, colorRec.Code)
+
+	var ramAttribute, colorAttribute entity.Attribute
+	s.Require().NoError(s.db.Where("code = ?", ram).First(&ramAttribute).Error)
+	s.Require().NoError(s.db.Where("code = ?", color).First(&colorAttribute).Error)
+
+	parentRec := s.sendRequest(http.MethodPost, "/api/v1/categories", fmt.Sprintf(`{"name":"Phones %d","created_by":"arya"}`, suffix), "application/json")
+	s.Require().Equal(http.StatusCreated, parentRec.Code)
+	var parent entity.Category
+	s.Require().NoError(s.db.Where("name = ?", fmt.Sprintf("Phones %d", suffix)).First(&parent).Error)
+
+	childRec := s.sendRequest(http.MethodPost, "/api/v1/categories", fmt.Sprintf(`{"name":"Android %d","parent_id":%d,"created_by":"arya"}`, suffix, parent.ID), "application/json")
+	s.Require().Equal(http.StatusCreated, childRec.Code)
+	var child entity.Category
+	s.Require().NoError(s.db.Where("name = ?", fmt.Sprintf("Android %d", suffix)).First(&child).Error)
+
+	s.Require().Equal(http.StatusOK, s.sendRequest(http.MethodPut, fmt.Sprintf("/api/v1/categories/%d/attributes", parent.ID), fmt.Sprintf(`{"attribute_ids":[%d]}`, colorAttribute.ID), "application/json").Code)
+	s.Require().Equal(http.StatusOK, s.sendRequest(http.MethodPut, fmt.Sprintf("/api/v1/categories/%d/attributes", child.ID), fmt.Sprintf(`{"attribute_ids":[%d]}`, ramAttribute.ID), "application/json").Code)
+
+	createRec := s.sendRequest(http.MethodPost, "/api/v1/products", `{"name":"Pixel 10","price":14000000,"description":"Tensor","created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, createRec.Code)
+	var product entity.Product
+	s.Require().NoError(s.db.Where("name = ?", "Pixel 10").Order("id DESC").First(&product).Error)
+	target := fmt.Sprintf("/api/v1/products/%d", product.ID)
+
+	s.Require().Equal(http.StatusOK, s.sendRequest(http.MethodPut, target+"/categories", fmt.Sprintf(`{"category_ids":[%d],"updated_by":"dewi"}`, child.ID), "application/json").Code)
+
+	etag := utils.FormatETag(product.Version, product.StockVersion)
+	wrongRec := s.sendConditionalRequest(http.MethodPut, target+"/attributes", `{"attributes":{"`+ram+`":"twelve"},"updated_by":"arya"}`, "application/json", etag)
+	s.Equal(http.StatusBadRequest, wrongRec.Code, "A value must match the type of its attribute")
+
+	missingRec := s.sendRequest(http.MethodPut, target+"/attributes", `{"attributes":{},"updated_by":"arya"}`, "application/json")
+	s.Equal(http.StatusPreconditionRequired, missingRec.Code)
+
+	setRec := s.sendConditionalRequest(http.MethodPut, target+"/attributes", `{"attributes":{"`+ram+`":12,"`+color+`":"black"},"updated_by":"arya"}`, "application/json", etag)
+	s.Require().Equal(http.StatusOK, setRec.Code, "Attributes of ancestor categories apply too")
+	s.Equal(utils.FormatETag(product.Version+1, product.StockVersion), setRec.Header().Get("ETag"))
+	s.Contains(setRec.Body.String(), `"`+color+`":"Black"`, "Enum values take the spelling of the option")
+
+	detailRec := s.sendRequest(http.MethodGet, target, "", "application/json")
+	s.Contains(detailRec.Body.String(), `"`+ram+`":12`)
+
+	matchRec := s.sendRequest(http.MethodGet, "/api/v1/products?limit=100&attr."+ram+">=8&attr."+color+"=White,Black", "", "application/json")
+	s.Equal(http.StatusOK, matchRec.Code)
+	s.Contains(matchRec.Body.String(), `"name":"Pixel 10"`)
+
+	missRec := s.sendRequest(http.MethodGet, "/api/v1/products?limit=100&attr."+ram+">16", "", "application/json")
+	s.Equal(http.StatusOK, missRec.Code)
+	s.NotContains(missRec.Body.String(), `"name":"Pixel 10"`)
+
+	removeRec := s.sendRequest(http.MethodPut, fmt.Sprintf("/api/v1/attributes/%d", colorAttribute.ID), `{"name":"Color","options":["White"],"updated_by":"arya"}`, "application/json")
+	s.Equal(http.StatusConflict, removeRec.Code, "An option a product holds cannot be removed")
+
+	deleteRec := s.sendRequest(http.MethodDelete, fmt.Sprintf("/api/v1/attributes/%d", ramAttribute.ID), "", "application/json")
+	s.Equal(http.StatusConflict, deleteRec.Code, "An attribute a product holds cannot be deleted")
+}
+
+func (s *ProductTestSuite) TestProductLifecycle() {
+
+	name := fmt.Sprintf("Galaxy Tab %d", time.Now().UnixNano()%100000)
+	createRec := s.sendRequest(http.MethodPost, "/api/v1/products", `{"name":"`+name+`","price":9000000,"description":"S Pen","status":"draft","created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, createRec.Code)
+	var product entity.Product
+	s.Require().NoError(s.db.Where("name = ?", name).First(&product).Error)
+	target := fmt.Sprintf("/api/v1/products/%d", product.ID)
+	search := "/api/v1/products?limit=100&search=" + url.QueryEscape(name)
+
+	publicRec := s.sendRequest(http.MethodGet, search, "", "application/json")
+	s.Equal(http.StatusOK, publicRec.Code)
+	s.NotContains(publicRec.Body.String(), name, "Drafts are hidden from public listings")
+
+	suggestRec := s.sendRequest(http.MethodGet, "/api/v1/products/suggest?limit=10&q="+url.QueryEscape(name), "", "application/json")
+	s.Equal(http.StatusOK, suggestRec.Code)
+	s.NotContains(suggestRec.Body.String(), name, "Drafts are not suggested")
+
+	adminRec := s.sendRequest(http.MethodGet, search+"&include_unpublished=true&status=draft", "", "application/json")
+	s.Contains(adminRec.Body.String(), name)
+
+	etag := s.sendRequest(http.MethodGet, target, "", "application/json").Header().Get("ETag")
+	discontinueRec := s.sendConditionalRequest(http.MethodPost, target+"/status", `{"status":"discontinued","updated_by":"budi"}`, "application/json", etag)
+	s.Equal(http.StatusConflict, discontinueRec.Code, "A draft cannot be discontinued")
+
+	publishAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	scheduleRec := s.sendConditionalRequest(http.MethodPost, target+"/status", `{"status":"active","publish_at":"`+publishAt+`","updated_by":"budi"}`, "application/json", etag)
+	s.Require().Equal(http.StatusOK, scheduleRec.Code)
+	s.Contains(scheduleRec.Body.String(), `"updated_by":"budi"`)
+
+	publicRec = s.sendRequest(http.MethodGet, search, "", "application/json")
+	s.NotContains(publicRec.Body.String(), name, "Products are hidden until their publish_at")
+
+	etag = scheduleRec.Header().Get("ETag")
+	publishRec := s.sendConditionalRequest(http.MethodPost, target+"/status", `{"status":"active","updated_by":"budi"}`, "application/json", etag)
+	s.Require().Equal(http.StatusOK, publishRec.Code)
+
+	publicRec = s.sendRequest(http.MethodGet, search, "", "application/json")
+	s.Contains(publicRec.Body.String(), name)
+	suggestRec = s.sendRequest(http.MethodGet, "/api/v1/products/suggest?limit=10&q="+url.QueryEscape(name), "", "application/json")
+	s.Contains(suggestRec.Body.String(), name)
+
+	patchRec := s.sendConditionalRequest(http.MethodPatch, target, `{"status":"archived","updated_by":"budi"}`, "application/merge-patch+json", publishRec.Header().Get("ETag"))
+	s.Equal(http.StatusBadRequest, patchRec.Code, "Status only changes through a transition")
+}
+
+func (s *ProductTestSuite) TestProductHistory() {
+
+	name := fmt.Sprintf("Redmi Note %d", time.Now().UnixNano()%100000)
+	createRec := s.sendRequest(http.MethodPost, "/api/v1/products", `{"name":"`+name+`","price":3000000,"description":"AMOLED","created_by":"arya"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, createRec.Code)
+	var product entity.Product
+	s.Require().NoError(s.db.Where("name = ?", name).First(&product).Error)
+	target := fmt.Sprintf("/api/v1/products/%d", product.ID)
+
+	patchReq := httptest.NewRequest(http.MethodPatch, target, strings.NewReader(`{"price":2800000,"updated_by":"budi"}`))
+	patchReq.Header.Set(echo.HeaderContentType, "application/merge-patch+json")
+	patchReq.Header.Set("If-Match", utils.FormatETag(product.Version, product.StockVersion))
+	patchReq.Header.Set(echo.HeaderXRequestID, "history-test")
+	patchRec := httptest.NewRecorder()
+	s.echo.ServeHTTP(patchRec, patchReq)
+	s.Require().Equal(http.StatusOK, patchRec.Code)
+
+	receiptRec := s.sendRequest(http.MethodPost, target+"/stock-movements", `{"type":"receipt","quantity":5,"created_by":"dewi"}`, "application/json")
+	s.Require().Equal(http.StatusCreated, receiptRec.Code)
+	detailRec := s.sendRequest(http.MethodGet, target, "", "application/json")
+	s.Require().Equal(http.StatusOK, detailRec.Code)
+
+	deleteRec := s.sendConditionalRequest(http.MethodDelete, target+"?deleted_by=citra", "", "", detailRec.Header().Get("ETag"))
+	s.Require().Equal(http.StatusOK, deleteRec.Code)
+
+	historyRec := s.sendRequest(http.MethodGet, target+"/history", "", "application/json")
+	s.Equal(http.StatusOK, historyRec.Code, "The history of a deleted product stays readable")
+
+	var history struct {
+		Data struct {
+			Data     []entity.AuditEvent    `json:"data"`
+			Metadata response.StdPagination `json:"metadata"`
+		} `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal(historyRec.Body.Bytes(), &history))
+	s.Require().Len(history.Data.Data, 4)
+	s.Equal(4, history.Data.Metadata.Total)
+
+	deleted, received, patched, created := history.Data.Data[0], history.Data.Data[1], history.Data.Data[2], history.Data.Data[3]
+	s.Equal(entity.AuditDelete, deleted.Action)
+	s.Equal("citra", deleted.Actor)
+	s.Equal(entity.AuditUpdate, received.Action)
+	s.Equal("dewi", received.Actor)
+	s.Equal(entity.AuditValues{"quantity": float64(0)}, received.Before, "Stock movements are recorded too")
+	s.Equal(entity.AuditValues{"quantity": float64(5)}, received.After)
+	s.Equal(entity.AuditUpdate, patched.Action)
+	s.Equal("budi", patched.Actor)
+	s.Equal("history-test", patched.RequestID)
+	s.Equal(entity.AuditValues{"price": float64(3000000)}, patched.Before, "Only the changed fields are recorded")
+	s.Equal(entity.AuditValues{"price": float64(2800000)}, patched.After)
+	s.Equal(entity.AuditCreate, created.Action)
+	s.Nil(created.Before)
+	s.Equal(name, created.After["name"])
+}
+
 func (s *ProductTestSuite) TestRateLimit() {
 	for i := 0; i < 10; i++ {
 		rec := s.sendRequest(http.MethodGet, "/rate-limit", "", "application/json")
@@ -350,7 +907,7 @@ func customTimeout(next echo.HandlerFunc) echo.HandlerFunc {
 func (s *ProductTestSuite) TestTimeout() {
 
 	isolatedEcho := echo.New()
-	isolatedEcho.Validator = &utils.CustomValidator{Validator: validator.New()}
+	isolatedEcho.Validator = utils.NewValidator()
 
 	isolatedEcho.GET("/test-timeout", func(c echo.Context) error {
 		time.Sleep(300 * time.Millisecond)